@@ -0,0 +1,118 @@
+// Package cache implements a small on-disk store for API responses that
+// rarely change, such as work item descriptions
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Disk stores one entry per key in a directory. Each entry carries a
+// version; reading with a different version is a miss, and storing a new
+// version replaces the old one so stale bodies do not pile up.
+type Disk struct {
+	dir string
+}
+
+// entry is the on-disk representation of a cached response
+type entry struct {
+	Key     string          `json:"key"`
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// Open returns a disk cache rooted at dir, creating the directory if needed
+func Open(dir string) (*Disk, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory %s: %w", dir, err)
+	}
+	return &Disk{dir: dir}, nil
+}
+
+// Dir returns the directory holding the cache
+func (d *Disk) Dir() string {
+	return d.dir
+}
+
+// Get returns the data stored for key if it was stored with version
+func (d *Disk) Get(key, version string) ([]byte, bool) {
+	raw, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var e entry
+	if err := json.Unmarshal(raw, &e); err != nil || e.Key != key || e.Version != version {
+		return nil, false
+	}
+	return e.Data, true
+}
+
+// Put stores data for key, replacing any other version. data must be JSON.
+func (d *Disk) Put(key, version string, data []byte) error {
+	raw, err := json.Marshal(entry{Key: key, Version: version, Data: data})
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+
+	// Write to a temporary file first so readers never see a partial entry
+	tmp, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), d.path(key)); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	return nil
+}
+
+// Stats returns the number of entries and their total size in bytes
+func (d *Disk) Stats() (int, int64, error) {
+	files, err := os.ReadDir(d.dir)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read cache directory: %w", err)
+	}
+	count := 0
+	var size int64
+	for _, f := range files {
+		if f.IsDir() || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+		info, err := f.Info()
+		if err != nil {
+			continue
+		}
+		count++
+		size += info.Size()
+	}
+	return count, size, nil
+}
+
+// Clear removes every entry
+func (d *Disk) Clear() error {
+	if err := os.RemoveAll(d.dir); err != nil {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+	if err := os.MkdirAll(d.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory %s: %w", d.dir, err)
+	}
+	return nil
+}
+
+func (d *Disk) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,236 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/config"
+)
+
+var aliasCmd = &cobra.Command{
+	Use:   "alias",
+	Short: "Manage saved command aliases",
+	Long: `Aliases are saved commands that can be run by name. Interactive flows
+such as bulk update offer to save their equivalent command as an alias, so a
+workflow clicked through once can be replayed from scripts.
+
+Examples:
+  # Save a command as an alias
+  plane-cli alias set triage -- list --project <project-id> -q 'state:Triage'
+
+  # Run it
+  plane-cli triage
+
+  # List and delete aliases
+  plane-cli alias list
+  plane-cli alias delete triage`,
+}
+
+var aliasListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved aliases",
+	RunE:  runAliasList,
+}
+
+var aliasSetCmd = &cobra.Command{
+	Use:   "set <name> -- <command> [flags...]",
+	Short: "Save a command as an alias",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runAliasSet,
+}
+
+var aliasDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a saved alias",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runAliasDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(aliasCmd)
+	aliasCmd.AddCommand(aliasListCmd)
+	aliasCmd.AddCommand(aliasSetCmd)
+	aliasCmd.AddCommand(aliasDeleteCmd)
+}
+
+func runAliasList(cmd *cobra.Command, args []string) error {
+	aliases, err := loadAliases()
+	if err != nil {
+		return err
+	}
+
+	if len(aliases) == 0 {
+		fmt.Println("No aliases saved.")
+		return nil
+	}
+
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Printf("%-20s plane-cli %s\n", name, shellJoin(aliases[name]))
+	}
+	return nil
+}
+
+func runAliasSet(cmd *cobra.Command, args []string) error {
+	return saveAlias(args[0], args[1:])
+}
+
+func runAliasDelete(cmd *cobra.Command, args []string) error {
+	aliases, err := loadAliases()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := aliases[args[0]]; !ok {
+		return fmt.Errorf("alias '%s' not found", args[0])
+	}
+	delete(aliases, args[0])
+
+	if err := writeAliases(aliases); err != nil {
+		return err
+	}
+	fmt.Printf("✅ Alias '%s' deleted\n", args[0])
+	return nil
+}
+
+// aliasesPath returns the location of the alias file
+func aliasesPath() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aliases.yaml"), nil
+}
+
+// loadAliases reads saved aliases; a missing file means no aliases
+func loadAliases() (map[string][]string, error) {
+	path, err := aliasesPath()
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := make(map[string][]string)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return aliases, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aliases: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, &aliases); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return aliases, nil
+}
+
+func writeAliases(aliases map[string][]string) error {
+	path, err := aliasesPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(aliases)
+	if err != nil {
+		return fmt.Errorf("failed to encode aliases: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write aliases: %w", err)
+	}
+	return nil
+}
+
+// saveAlias stores a command under a name that must not shadow a built-in command
+func saveAlias(name string, args []string) error {
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, " \t") {
+		return fmt.Errorf("invalid alias name '%s'", name)
+	}
+	if c, _, err := rootCmd.Find([]string{name}); err == nil && c != rootCmd {
+		return fmt.Errorf("'%s' is a built-in command and cannot be used as an alias", name)
+	}
+
+	aliases, err := loadAliases()
+	if err != nil {
+		return err
+	}
+	aliases[name] = args
+
+	if err := writeAliases(aliases); err != nil {
+		return err
+	}
+	fmt.Printf("✅ Alias '%s' saved - run it with: plane-cli %s\n", name, name)
+	return nil
+}
+
+// expandAlias replaces a leading alias name in args with its saved command.
+// Built-in commands always win over aliases.
+func expandAlias(args []string) []string {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return args
+	}
+	if c, _, err := rootCmd.Find(args[:1]); err == nil && c != rootCmd {
+		return args
+	}
+
+	aliases, err := loadAliases()
+	if err != nil {
+		return args
+	}
+	expansion, ok := aliases[args[0]]
+	if !ok {
+		return args
+	}
+
+	expanded := append([]string{}, expansion...)
+	return append(expanded, args[1:]...)
+}
+
+// offerSavedCommand prints the non-interactive equivalent of a completed
+// interactive flow and offers to save it as an alias
+func offerSavedCommand(args []string) {
+	fmt.Println("\n💡 Equivalent command:")
+	fmt.Printf("   plane-cli %s\n", shellJoin(args))
+
+	save, err := confirm("Save this command as an alias?")
+	if err != nil || !save {
+		return
+	}
+
+	name, err := input("Alias name:")
+	if err != nil || strings.TrimSpace(name) == "" {
+		return
+	}
+
+	if err := saveAlias(strings.TrimSpace(name), args); err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+	}
+}
+
+// shellJoin joins arguments into a command line, quoting where needed
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func shellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+	if !strings.ContainsAny(s, " \t\n'\"\\$`!*?&|;<>()[]{}#~") {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,654 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/plane"
+)
+
+// applyExternalSource is stored on work items created from a manifest key
+const applyExternalSource = "apply"
+
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Reconcile a project with a YAML manifest of work items",
+	Long: `Read a YAML manifest of work items and make the project match it: missing
+items are created, items whose fields drifted are updated and the rest are
+reported as unchanged. Running it again is safe, which makes it a
+reproducible way to seed new projects.
+
+  items:
+    - title: Checkout redesign
+      key: checkout            # optional stable key, stored as external ID
+      description: |
+        Rework the checkout flow.
+      state: Backlog
+      priority: high
+      labels: [frontend]
+      module: Payments
+      assignees: [ana@example.com]
+      start_date: 2024-06-01
+      target_date: 2024-06-30
+      children:
+        - title: Cart page
+        - title: Payment step
+
+Items are matched by key when they have one, otherwise by title under the
+same parent. Only the fields given in the manifest are managed; labels and
+modules that don't exist yet are created. Work items missing from the
+manifest are left alone.
+
+Examples:
+  plane-cli apply --project <project-id> -f backlog.yaml --dry-run
+  plane-cli apply --project <project-id> -f backlog.yaml --yes`,
+	RunE: runApply,
+}
+
+// Manifest is the desired set of work items of a project
+type Manifest struct {
+	Items []ManifestItem `yaml:"items"`
+}
+
+// ManifestItem is a work item of a manifest and its sub-items
+type ManifestItem struct {
+	Key         string         `yaml:"key,omitempty"`
+	Title       string         `yaml:"title"`
+	Description string         `yaml:"description,omitempty"`
+	State       string         `yaml:"state,omitempty"`
+	Priority    string         `yaml:"priority,omitempty"`
+	Labels      []string       `yaml:"labels,omitempty"`
+	Assignees   []string       `yaml:"assignees,omitempty"`
+	Module      string         `yaml:"module,omitempty"`
+	StartDate   string         `yaml:"start_date,omitempty"`
+	TargetDate  string         `yaml:"target_date,omitempty"`
+	Children    []ManifestItem `yaml:"children,omitempty"`
+}
+
+// applyAction is the planned change for one manifest item
+type applyAction struct {
+	Kind    string // create, update, unchanged
+	Spec    *ManifestItem
+	Item    *plane.WorkItem
+	Parent  *applyAction
+	Depth   int
+	Changes []fieldChange
+	// ID is the work item ID once it exists
+	ID string
+}
+
+// applyContext holds the project data a manifest is resolved against
+type applyContext struct {
+	states      []plane.State
+	labels      map[string]plane.Label  // by lower-case name
+	modules     map[string]plane.Module // by lower-case name
+	members     []plane.Member
+	itemModules map[string]string // work item ID to module ID
+	newLabels   []string
+	newModules  []string
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+
+	applyCmd.Flags().StringP("project", "p", "", "Project identifier (required unless defaults.project is set)")
+	applyCmd.Flags().StringP("file", "f", "", "YAML manifest of work items (required)")
+	applyCmd.Flags().Bool("dry-run", false, "Show the plan without changing anything")
+	applyCmd.Flags().Bool("yes", false, "Apply without confirmation")
+	applyCmd.MarkFlagRequired("project")
+	applyCmd.MarkFlagRequired("file")
+}
+
+func runApply(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	file, _ := cmd.Flags().GetString("file")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	manifest, err := loadManifest(file)
+	if err != nil {
+		return err
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx, err := loadApplyContext(client, projectID, manifest)
+	if err != nil {
+		return err
+	}
+	if err := ctx.validate(manifest.Items); err != nil {
+		return err
+	}
+
+	fmt.Printf("📥 Fetching work items from project '%s'...\n", projectID)
+	items, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	actions := planApply(manifest.Items, items, ctx)
+	counts := printApplyPlan(file, actions, ctx)
+
+	if counts["create"]+counts["update"]+len(ctx.newLabels)+len(ctx.newModules) == 0 {
+		fmt.Println("\n✅ The project already matches the manifest.")
+		return nil
+	}
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no changes made.")
+		return nil
+	}
+	if !yes {
+		confirmed, err := confirm("\nApply these changes?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("\n❌ Apply cancelled.")
+			return nil
+		}
+	}
+
+	fmt.Println()
+	if err := ctx.createMissing(client, projectID); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, a := range actions {
+		if a.Kind == "unchanged" {
+			continue
+		}
+		if err := applyManifestAction(client, projectID, a, ctx); err != nil {
+			fmt.Printf("  ❌ Failed to %s '%s': %v\n", a.Kind, a.Spec.Title, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  ✅ %s %s\n", syncActionPastTense[a.Kind], a.Spec.Title)
+	}
+
+	fmt.Printf("\n%s\n", strings.Repeat("-", 70))
+	if failed > 0 {
+		return fmt.Errorf("%d work item(s) could not be applied", failed)
+	}
+	fmt.Printf("✅ Applied: %d created, %d updated, %d unchanged\n", counts["create"], counts["update"], counts["unchanged"])
+	return nil
+}
+
+// loadManifest reads a manifest and checks titles and keys
+func loadManifest(file string) (*Manifest, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if len(manifest.Items) == 0 {
+		return nil, fmt.Errorf("manifest %s defines no items", file)
+	}
+
+	keys := make(map[string]bool)
+	var check func(items []ManifestItem) error
+	check = func(items []ManifestItem) error {
+		for _, item := range items {
+			if strings.TrimSpace(item.Title) == "" {
+				return fmt.Errorf("manifest %s has an item without a title", file)
+			}
+			if item.Key != "" {
+				if keys[item.Key] {
+					return fmt.Errorf("manifest %s uses key '%s' more than once", file, item.Key)
+				}
+				keys[item.Key] = true
+			}
+			if err := check(item.Children); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := check(manifest.Items); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// loadApplyContext fetches the states, labels, modules and members the
+// manifest refers to
+func loadApplyContext(client *plane.Client, projectID string, manifest *Manifest) (*applyContext, error) {
+	ctx := &applyContext{
+		labels:      make(map[string]plane.Label),
+		modules:     make(map[string]plane.Module),
+		itemModules: make(map[string]string),
+	}
+
+	var err error
+	if ctx.states, err = client.GetProjectStates(projectID); err != nil {
+		return nil, fmt.Errorf("failed to get states: %w", err)
+	}
+
+	labels, err := client.GetLabels(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+	for _, l := range labels {
+		ctx.labels[strings.ToLower(l.Name)] = l
+	}
+
+	modules, err := client.GetModules(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get modules: %w", err)
+	}
+	used := manifestModules(manifest.Items)
+	for _, m := range modules {
+		ctx.modules[strings.ToLower(m.Name)] = m
+		if !used[strings.ToLower(m.Name)] {
+			continue
+		}
+		items, err := client.GetModuleWorkItems(projectID, m.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get work items of module '%s': %w", m.Name, err)
+		}
+		for _, item := range items {
+			ctx.itemModules[item.ID] = m.ID
+		}
+	}
+
+	if manifestUsesAssignees(manifest.Items) {
+		if ctx.members, err = client.GetProjectMembers(projectID); err != nil {
+			return nil, fmt.Errorf("failed to get members: %w", err)
+		}
+	}
+	return ctx, nil
+}
+
+// validate checks every state, priority and assignee of the manifest and
+// records the labels and modules to create
+func (ctx *applyContext) validate(items []ManifestItem) error {
+	var errs []error
+	newLabels := make(map[string]bool)
+	newModules := make(map[string]bool)
+
+	var walk func(items []ManifestItem)
+	walk = func(items []ManifestItem) {
+		for _, item := range items {
+			if item.State != "" {
+				if _, err := stateIDByName(ctx.states, item.State); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", item.Title, err))
+				}
+			}
+			if item.Priority != "" && !slices.Contains(validPriorities, strings.ToLower(item.Priority)) {
+				errs = append(errs, fmt.Errorf("%s: invalid priority '%s'", item.Title, item.Priority))
+			}
+			for _, a := range item.Assignees {
+				if _, err := matchMember(ctx.members, a); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", item.Title, err))
+				}
+			}
+			for _, l := range item.Labels {
+				if _, ok := ctx.labels[strings.ToLower(l)]; !ok && !newLabels[strings.ToLower(l)] {
+					newLabels[strings.ToLower(l)] = true
+					ctx.newLabels = append(ctx.newLabels, l)
+				}
+			}
+			if m := item.Module; m != "" {
+				if _, ok := ctx.modules[strings.ToLower(m)]; !ok && !newModules[strings.ToLower(m)] {
+					newModules[strings.ToLower(m)] = true
+					ctx.newModules = append(ctx.newModules, m)
+				}
+			}
+			walk(item.Children)
+		}
+	}
+	walk(items)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("manifest does not match the project:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// createMissing creates the labels and modules the manifest needs
+func (ctx *applyContext) createMissing(client *plane.Client, projectID string) error {
+	for _, name := range ctx.newLabels {
+		label, err := client.CreateLabel(projectID, &plane.LabelCreate{Name: name})
+		if err != nil {
+			return fmt.Errorf("failed to create label '%s': %w", name, err)
+		}
+		ctx.labels[strings.ToLower(name)] = *label
+		fmt.Printf("  ✅ Created label %s\n", name)
+	}
+	for _, name := range ctx.newModules {
+		module, err := client.CreateModule(projectID, &plane.ModuleCreate{Name: name})
+		if err != nil {
+			return fmt.Errorf("failed to create module '%s': %w", name, err)
+		}
+		ctx.modules[strings.ToLower(name)] = *module
+		fmt.Printf("  ✅ Created module %s\n", name)
+	}
+	return nil
+}
+
+// planApply pairs manifest items with work items and computes their changes.
+// Parents always come before their children.
+func planApply(specs []ManifestItem, items []plane.WorkItem, ctx *applyContext) []*applyAction {
+	byKey := make(map[string]*plane.WorkItem)
+	for i := range items {
+		if items[i].ExternalID != "" {
+			byKey[items[i].ExternalID] = &items[i]
+		}
+	}
+	claimed := make(map[string]bool)
+
+	var actions []*applyAction
+	var walk func(specs []ManifestItem, parent *applyAction, depth int)
+	walk = func(specs []ManifestItem, parent *applyAction, depth int) {
+		for i := range specs {
+			spec := &specs[i]
+			a := &applyAction{Spec: spec, Parent: parent, Depth: depth}
+
+			if item, ok := byKey[spec.Key]; ok && spec.Key != "" && !claimed[item.ID] {
+				a.Item = item
+			} else {
+				parentID := ""
+				if parent != nil {
+					parentID = parent.ID
+				}
+				a.Item = findManifestMatch(items, claimed, spec.Title, parent != nil, parentID)
+			}
+
+			if a.Item == nil {
+				a.Kind = "create"
+			} else {
+				claimed[a.Item.ID] = true
+				a.ID = a.Item.ID
+				a.Changes = ctx.diff(a)
+				a.Kind = "unchanged"
+				if len(a.Changes) > 0 {
+					a.Kind = "update"
+				}
+			}
+			actions = append(actions, a)
+			walk(spec.Children, a, depth+1)
+		}
+	}
+	walk(specs, nil, 0)
+	return actions
+}
+
+// findManifestMatch finds an unclaimed work item by title, preferring one
+// under the expected parent
+func findManifestMatch(items []plane.WorkItem, claimed map[string]bool, title string, hasParent bool, parentID string) *plane.WorkItem {
+	var fallback *plane.WorkItem
+	for i := range items {
+		item := &items[i]
+		if claimed[item.ID] || !strings.EqualFold(strings.TrimSpace(item.Name), strings.TrimSpace(title)) {
+			continue
+		}
+		if !hasParent || (parentID != "" && item.ParentID == parentID) {
+			return item
+		}
+		if fallback == nil {
+			fallback = item
+		}
+	}
+	return fallback
+}
+
+// diff lists the fields of a matched work item that differ from its
+// manifest entry
+func (ctx *applyContext) diff(a *applyAction) []fieldChange {
+	spec, item := a.Spec, a.Item
+	var changes []fieldChange
+	add := func(field, from, to string) {
+		changes = append(changes, fieldChange{Field: field, From: from, To: to})
+	}
+
+	if spec.Key != "" && item.Name != spec.Title {
+		add("name", item.Name, spec.Title)
+	}
+	if d := normalizeDescription(spec.Description); d != "" {
+		if current := descriptionText(item.DescriptionHTML); current != d {
+			add("description", current, d)
+		}
+	}
+	if spec.State != "" {
+		current := ""
+		for _, s := range ctx.states {
+			if s.ID == itemStateID(item) {
+				current = s.Name
+			}
+		}
+		if !strings.EqualFold(current, spec.State) {
+			add("state", current, spec.State)
+		}
+	}
+	if p := strings.ToLower(spec.Priority); p != "" && p != item.Priority {
+		add("priority", item.Priority, p)
+	}
+	if spec.StartDate != "" && dateValue(item.StartDate) != spec.StartDate {
+		add("start_date", dateValue(item.StartDate), spec.StartDate)
+	}
+	if spec.TargetDate != "" && dateValue(item.TargetDate) != spec.TargetDate {
+		add("target_date", dateValue(item.TargetDate), spec.TargetDate)
+	}
+	if len(spec.Labels) > 0 {
+		ids := itemLabelIDs(item)
+		var current []string
+		for _, l := range ctx.labels {
+			if slices.Contains(ids, l.ID) {
+				current = append(current, l.Name)
+			}
+		}
+		if !sameNames(current, spec.Labels) {
+			add("labels", strings.Join(slices.Sorted(slices.Values(current)), ", "), strings.Join(spec.Labels, ", "))
+		}
+	}
+	if len(spec.Assignees) > 0 {
+		ids := itemAssigneeIDs(item)
+		want := ctx.memberIDs(spec.Assignees)
+		if !sameNames(ids, want) {
+			add("assignees", fmt.Sprintf("%d", len(ids)), strings.Join(spec.Assignees, ", "))
+		}
+	}
+	if spec.Module != "" {
+		current := ""
+		for _, m := range ctx.modules {
+			if m.ID == ctx.itemModules[item.ID] {
+				current = m.Name
+			}
+		}
+		if !strings.EqualFold(current, spec.Module) {
+			add("module", current, spec.Module)
+		}
+	}
+	if a.Parent != nil && (a.Parent.ID == "" || item.ParentID != a.Parent.ID) {
+		add("parent", "", a.Parent.Spec.Title)
+	}
+	return changes
+}
+
+// memberIDs resolves member references to IDs
+func (ctx *applyContext) memberIDs(refs []string) []string {
+	var ids []string
+	for _, ref := range refs {
+		if m, err := matchMember(ctx.members, ref); err == nil {
+			ids = append(ids, m.ID)
+		}
+	}
+	return ids
+}
+
+// labelIDs resolves label names to IDs
+func (ctx *applyContext) labelIDs(names []string) []string {
+	var ids []string
+	for _, name := range names {
+		if l, ok := ctx.labels[strings.ToLower(name)]; ok {
+			ids = append(ids, l.ID)
+		}
+	}
+	return ids
+}
+
+// applyManifestAction creates or updates the work item of an action
+func applyManifestAction(client *plane.Client, projectID string, a *applyAction, ctx *applyContext) error {
+	spec := a.Spec
+	parentID := ""
+	if a.Parent != nil {
+		if a.Parent.ID == "" {
+			return fmt.Errorf("parent '%s' was not created", a.Parent.Spec.Title)
+		}
+		parentID = a.Parent.ID
+	}
+
+	switch a.Kind {
+	case "create":
+		create := &plane.WorkItemCreate{
+			Name:       spec.Title,
+			Priority:   strings.ToLower(spec.Priority),
+			Assignees:  ctx.memberIDs(spec.Assignees),
+			Labels:     ctx.labelIDs(spec.Labels),
+			StartDate:  spec.StartDate,
+			TargetDate: spec.TargetDate,
+			Parent:     parentID,
+		}
+		if spec.Description != "" {
+			create.DescriptionHTML = markdownToHTML(spec.Description)
+		}
+		if spec.State != "" {
+			create.State, _ = stateIDByName(ctx.states, spec.State)
+		}
+		if spec.Key != "" {
+			create.ExternalID = spec.Key
+			create.ExternalSource = applyExternalSource
+		}
+		item, err := client.CreateWorkItem(projectID, create)
+		if err != nil {
+			return err
+		}
+		a.ID = item.ID
+		if spec.Module != "" {
+			return client.AddWorkItemsToModule(projectID, ctx.modules[strings.ToLower(spec.Module)].ID, []string{item.ID})
+		}
+		return nil
+
+	case "update":
+		update := &plane.WorkItemUpdate{}
+		fields := 0
+		for _, c := range a.Changes {
+			if c.Field != "module" {
+				fields++
+			}
+			switch c.Field {
+			case "name":
+				update.Name = c.To
+			case "description":
+				update.DescriptionHTML = markdownToHTML(c.To)
+			case "state":
+				update.State, _ = stateIDByName(ctx.states, c.To)
+			case "priority":
+				update.Priority = c.To
+			case "start_date":
+				update.StartDate = c.To
+			case "target_date":
+				update.TargetDate = c.To
+			case "labels":
+				update.Labels = ctx.labelIDs(spec.Labels)
+			case "assignees":
+				update.Assignees = ctx.memberIDs(spec.Assignees)
+			case "parent":
+				update.Parent = parentID
+			case "module":
+				if err := client.AddWorkItemsToModule(projectID, ctx.modules[strings.ToLower(spec.Module)].ID, []string{a.ID}); err != nil {
+					return fmt.Errorf("failed to set module: %w", err)
+				}
+			}
+		}
+		if fields == 0 {
+			return nil
+		}
+		_, err := client.UpdateWorkItem(projectID, a.ID, update)
+		return err
+	}
+	return nil
+}
+
+// printApplyPlan prints the planned changes and returns the number of
+// actions of each kind
+func printApplyPlan(file string, actions []*applyAction, ctx *applyContext) map[string]int {
+	fmt.Printf("\n📋 Apply plan for %s\n", file)
+	fmt.Println(strings.Repeat("=", 70))
+	for _, l := range ctx.newLabels {
+		fmt.Printf("+ label %s\n", l)
+	}
+	for _, m := range ctx.newModules {
+		fmt.Printf("+ module %s\n", m)
+	}
+
+	counts := make(map[string]int)
+	symbols := map[string]string{"create": "+", "update": "~", "unchanged": "="}
+	for _, a := range actions {
+		counts[a.Kind]++
+		indent := strings.Repeat("  ", a.Depth)
+		fmt.Printf("%s%s %s\n", indent, symbols[a.Kind], truncate(a.Spec.Title, 60))
+		for _, c := range a.Changes {
+			fmt.Printf("%s    %-12s %s → %s\n", indent, c.Field, emptyAsDash(syncDisplayValue(c.Field, c.From)), syncDisplayValue(c.Field, c.To))
+		}
+	}
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("Create: %d | Update: %d | Unchanged: %d\n", counts["create"], counts["update"], counts["unchanged"])
+	return counts
+}
+
+// manifestModules returns the lower-case module names a manifest uses
+func manifestModules(items []ManifestItem) map[string]bool {
+	used := make(map[string]bool)
+	var walk func(items []ManifestItem)
+	walk = func(items []ManifestItem) {
+		for _, item := range items {
+			if item.Module != "" {
+				used[strings.ToLower(item.Module)] = true
+			}
+			walk(item.Children)
+		}
+	}
+	walk(items)
+	return used
+}
+
+// manifestUsesAssignees reports whether any manifest item has assignees
+func manifestUsesAssignees(items []ManifestItem) bool {
+	for _, item := range items {
+		if len(item.Assignees) > 0 || manifestUsesAssignees(item.Children) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameNames compares two lists as case-insensitive sets
+func sameNames(a, b []string) bool {
+	return slices.Equal(sortedNames(a), sortedNames(b))
+}
+
+func sortedNames(names []string) []string {
+	result := make([]string, 0, len(names))
+	for _, n := range names {
+		n = strings.ToLower(n)
+		if !slices.Contains(result, n) {
+			result = append(result, n)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"plane-cli/internal/markdown"
+	"plane-cli/internal/plane"
+)
+
+var (
+	htmlImagePattern = regexp.MustCompile(`(<img\b[^>]*?\bsrc\s*=\s*["'])([^"']+)(["'])`)
+	urlSchemePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+)
+
+// uploadLocalImages uploads the local images referenced by content, as
+// Markdown images or <img> tags, and rewrites their URLs to the uploaded
+// assets. Relative paths are resolved against baseDir. It returns the
+// rewritten content and the number of images uploaded.
+func uploadLocalImages(client *plane.Client, projectID, content, baseDir string) (string, int, error) {
+	refs := markdown.ImageURLs(content)
+	for _, m := range htmlImagePattern.FindAllStringSubmatch(content, -1) {
+		refs = append(refs, m[2])
+	}
+
+	mapping := make(map[string]string)
+	for _, ref := range refs {
+		if _, done := mapping[ref]; done || !isLocalImage(ref) {
+			continue
+		}
+
+		path := strings.TrimPrefix(ref, "file://")
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to read image %s: %w", ref, err)
+		}
+
+		contentType := mime.TypeByExtension(strings.ToLower(filepath.Ext(path)))
+		if contentType == "" {
+			contentType = http.DetectContentType(data)
+		}
+
+		assetURL, err := client.UploadAsset(projectID, filepath.Base(path), contentType, data)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to upload image %s: %w", ref, err)
+		}
+		fmt.Printf("🖼️  Uploaded %s\n", ref)
+		mapping[ref] = assetURL
+	}
+
+	if len(mapping) == 0 {
+		return content, 0, nil
+	}
+
+	content = markdown.ReplaceImageURLs(content, mapping)
+	content = htmlImagePattern.ReplaceAllStringFunc(content, func(m string) string {
+		parts := htmlImagePattern.FindStringSubmatch(m)
+		if replacement, ok := mapping[parts[2]]; ok {
+			return parts[1] + replacement + parts[3]
+		}
+		return m
+	})
+	return content, len(mapping), nil
+}
+
+// isLocalImage reports whether an image reference points to a local file
+func isLocalImage(ref string) bool {
+	if strings.HasPrefix(ref, "file://") {
+		return true
+	}
+	if strings.HasPrefix(ref, "/api/") || strings.HasPrefix(ref, "//") {
+		return false
+	}
+	return !urlSchemePattern.MatchString(ref)
+}
+
+// contentDir returns the directory relative image paths are resolved
+// against: the directory of the content file, or the working directory
+func contentDir(file string) string {
+	if file == "" {
+		return "."
+	}
+	return filepath.Dir(file)
+}
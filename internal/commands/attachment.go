@@ -0,0 +1,249 @@
+package commands
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var attachmentCmd = &cobra.Command{
+	Use:   "attachment",
+	Short: "Attach files to work items and download them",
+	Long: `Upload screenshots, logs and other files to a work item, list its
+attachments and download them again.
+
+Attachments are referenced by ID, by the short ID shown by 'attachment list'
+or by file name.
+
+Examples:
+  plane-cli attachment add --item PROJ-123 screenshot.png build.log
+  plane-cli attachment list --item PROJ-123
+  plane-cli attachment download --item PROJ-123 screenshot.png
+  plane-cli attachment download --item PROJ-123 --dir ./PROJ-123`,
+}
+
+var attachmentAddCmd = &cobra.Command{
+	Use:   "add <file>...",
+	Short: "Attach files to a work item",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runAttachmentAdd,
+}
+
+var attachmentListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the attachments of a work item",
+	Args:  cobra.NoArgs,
+	RunE:  runAttachmentList,
+}
+
+var attachmentDownloadCmd = &cobra.Command{
+	Use:   "download [attachment]...",
+	Short: "Download attachments of a work item (all when none are named)",
+	RunE:  runAttachmentDownload,
+}
+
+func init() {
+	rootCmd.AddCommand(attachmentCmd)
+	attachmentCmd.AddCommand(attachmentAddCmd)
+	attachmentCmd.AddCommand(attachmentListCmd)
+	attachmentCmd.AddCommand(attachmentDownloadCmd)
+
+	for _, c := range []*cobra.Command{attachmentAddCmd, attachmentListCmd, attachmentDownloadCmd} {
+		c.Flags().String("item", "", "Work item identifier, e.g. PROJ-123 (required)")
+		c.MarkFlagRequired("item")
+	}
+
+	attachmentDownloadCmd.Flags().String("dir", ".", "Directory to save files in")
+	attachmentDownloadCmd.Flags().Bool("force", false, "Overwrite existing files")
+}
+
+func runAttachmentAdd(cmd *cobra.Command, args []string) error {
+	identifier, _ := cmd.Flags().GetString("item")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, projectID, err := itemByIdentifier(client, identifier)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, path := range args {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			fmt.Printf("❌ %s: %v\n", path, err)
+			failed++
+			continue
+		}
+
+		contentType := mime.TypeByExtension(strings.ToLower(filepath.Ext(path)))
+		if contentType == "" {
+			contentType = http.DetectContentType(data)
+		}
+
+		attachment, err := client.UploadAttachment(projectID, item.ID, filepath.Base(path), contentType, data)
+		if err != nil {
+			fmt.Printf("❌ %s: %v\n", path, err)
+			failed++
+			continue
+		}
+		fmt.Printf("📎 Attached %s (%s, %s)\n", attachment.Attributes.Name, formatFileSize(int64(len(data))), shortID(attachment.ID))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d file(s) could not be attached", failed, len(args))
+	}
+	fmt.Printf("\n✅ %d file(s) attached to %s\n", len(args), strings.ToUpper(identifier))
+	return nil
+}
+
+func runAttachmentList(cmd *cobra.Command, args []string) error {
+	identifier, _ := cmd.Flags().GetString("item")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, projectID, err := itemByIdentifier(client, identifier)
+	if err != nil {
+		return err
+	}
+
+	attachments, err := uploadedAttachments(client, projectID, item.ID)
+	if err != nil {
+		return err
+	}
+	if len(attachments) == 0 {
+		fmt.Printf("No attachments on %s.\n", strings.ToUpper(identifier))
+		return nil
+	}
+
+	fmt.Printf("\n📎 %s: %s (%d attachments)\n\n", strings.ToUpper(identifier), item.Name, len(attachments))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSIZE\tTYPE\tADDED")
+	for _, a := range attachments {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", shortID(a.ID), a.Attributes.Name, formatFileSize(a.Attributes.Size), emptyAsDash(a.Attributes.Type), a.CreatedAt.Local().Format("2006-01-02 15:04"))
+	}
+	w.Flush()
+	return nil
+}
+
+func runAttachmentDownload(cmd *cobra.Command, args []string) error {
+	identifier, _ := cmd.Flags().GetString("item")
+	dir, _ := cmd.Flags().GetString("dir")
+	force, _ := cmd.Flags().GetBool("force")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, projectID, err := itemByIdentifier(client, identifier)
+	if err != nil {
+		return err
+	}
+
+	attachments, err := uploadedAttachments(client, projectID, item.ID)
+	if err != nil {
+		return err
+	}
+
+	selected := attachments
+	if len(args) > 0 {
+		selected = nil
+		for _, ref := range args {
+			a, err := findAttachment(attachments, ref)
+			if err != nil {
+				return err
+			}
+			selected = append(selected, *a)
+		}
+	}
+	if len(selected) == 0 {
+		fmt.Printf("No attachments on %s.\n", strings.ToUpper(identifier))
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	for _, a := range selected {
+		// Only keep the base name so attachment names cannot escape dir
+		path := filepath.Join(dir, filepath.Base(a.Attributes.Name))
+		if _, err := os.Stat(path); err == nil && !force {
+			return fmt.Errorf("%s already exists (use --force to overwrite)", path)
+		}
+
+		data, _, err := client.DownloadAttachment(projectID, item.ID, a.ID)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		fmt.Printf("📥 %s (%s)\n", path, formatFileSize(int64(len(data))))
+	}
+
+	fmt.Printf("\n✅ Downloaded %d attachment(s)\n", len(selected))
+	return nil
+}
+
+// uploadedAttachments lists the attachments whose upload completed
+func uploadedAttachments(client *plane.Client, projectID, workItemID string) ([]plane.Attachment, error) {
+	all, err := client.ListAttachments(projectID, workItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	var attachments []plane.Attachment
+	for _, a := range all {
+		if a.IsUploaded {
+			attachments = append(attachments, a)
+		}
+	}
+	return attachments, nil
+}
+
+// findAttachment resolves an attachment by ID, unique ID prefix or file name
+func findAttachment(attachments []plane.Attachment, ref string) (*plane.Attachment, error) {
+	var match *plane.Attachment
+	for i := range attachments {
+		a := &attachments[i]
+		if a.ID == ref {
+			return a, nil
+		}
+		if strings.HasPrefix(a.ID, ref) || a.Attributes.Name == ref {
+			if match != nil {
+				return nil, fmt.Errorf("attachment '%s' is ambiguous", ref)
+			}
+			match = a
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("attachment '%s' not found", ref)
+	}
+	return match, nil
+}
+
+// formatFileSize renders a byte count for humans
+func formatFileSize(size int64) string {
+	switch {
+	case size >= 1024*1024:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1024*1024))
+	case size >= 1024:
+		return fmt.Sprintf("%.1f KB", float64(size)/1024)
+	}
+	return fmt.Sprintf("%d B", size)
+}
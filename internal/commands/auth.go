@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+	"plane-cli/internal/plane"
+)
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Inspect the API token in use",
+}
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show who the API token belongs to and its roles",
+	Long: `Show the user the configured API token belongs to, its role in the
+workspace and its role in every project it can see, by asking the user and
+member endpoints. Useful when switching between several tokens (see
+'plane-cli profile').
+
+Plane's API doesn't expose token metadata, so the creation date shown is
+the one of the account; the token's own creation and last use are listed
+under Profile Settings → API Tokens in the web app.
+
+Examples:
+  plane-cli auth status
+  plane-cli auth status --profile ci`,
+	Args: cobra.NoArgs,
+	RunE: runAuthStatus,
+}
+
+func init() {
+	rootCmd.AddCommand(authCmd)
+	authCmd.AddCommand(authStatusCmd)
+}
+
+// projectRole is the role of the token owner in a project; Err is set when
+// the project's members can't be read
+type projectRole struct {
+	Project plane.Project
+	Member  bool
+	Role    int
+	Err     error
+}
+
+func runAuthStatus(cmd *cobra.Command, args []string) error {
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	workspace := resolveWorkspace(cmd, cfg)
+
+	user, err := client.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	workspaceRole := "-"
+	if role, err := currentWorkspaceRole(client); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: could not determine your workspace role: %v\n", err)
+	} else if name := plane.RoleName(role); name != "" {
+		workspaceRole = name
+	}
+
+	projects, err := client.GetProjects()
+	if err != nil {
+		return fmt.Errorf("failed to fetch projects: %w", err)
+	}
+	roles := make([]projectRole, len(projects))
+	for i, p := range projects {
+		roles[i] = projectRole{Project: p}
+		members, err := client.GetProjectMembers(p.ID)
+		if err != nil {
+			roles[i].Err = err
+			continue
+		}
+		for _, m := range members {
+			if m.ID == user.ID || (m.Email != "" && m.Email == user.Email) {
+				roles[i].Member, roles[i].Role = true, m.Role
+				break
+			}
+		}
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Println("                    🔐 AUTH STATUS")
+	fmt.Println(strings.Repeat("=", 70))
+	if name := config.ActiveProfile(); name != "" {
+		fmt.Printf("Profile:         %s\n", name)
+	}
+	fmt.Printf("Instance:        %s\n", cfg.PlaneBaseURL)
+	fmt.Printf("Token:           %s\n", maskToken(cfg.PlaneAPIToken))
+	fmt.Printf("User:            %s <%s>\n", user.GetDisplayName(), emptyAsDash(user.Email))
+	fmt.Printf("User ID:         %s\n", user.ID)
+	fmt.Printf("Account created: %s\n", formatJoined(user.DateJoined))
+	fmt.Printf("Workspace:       %s (%s)\n", workspace, workspaceRole)
+
+	fmt.Printf("\nProjects (%d):\n\n", len(projects))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "IDENTIFIER\tNAME\tROLE")
+	for _, r := range roles {
+		role := plane.RoleName(r.Role)
+		switch {
+		case r.Err != nil && plane.StatusCode(r.Err) == http.StatusForbidden:
+			role = "no access"
+		case r.Err != nil:
+			role = "unknown"
+		case !r.Member:
+			role = "not a member"
+		case r.Role == 0:
+			// Some Plane versions list project members without roles
+			role = "member (role not returned)"
+		case role == "":
+			role = fmt.Sprintf("role %d", r.Role)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", r.Project.Identifier, truncate(r.Project.Name, 40), role)
+	}
+	w.Flush()
+	fmt.Println(strings.Repeat("=", 70))
+	return nil
+}
+
+// formatJoined formats the account creation time returned by the API
+func formatJoined(s string) string {
+	if s == "" {
+		return "-"
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Local().Format("2006-01-02")
+	}
+	return s
+}
@@ -0,0 +1,375 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/plane"
+	"plane-cli/internal/query"
+)
+
+var autoOrganizeCmd = &cobra.Command{
+	Use:   "auto-organize",
+	Short: "Assign work items to modules and cycles using title/label rules",
+	Long: `Assign work items to modules and cycles based on rules matching their
+titles and labels. Proposed moves are previewed before anything is changed.
+
+Rules are read from a YAML file. Each rule matches on a title regular
+expression, a list of labels (any of them), and/or a quick-filter query, and
+names the module and/or cycle to assign. The first matching rule wins.
+
+  rules:
+    - name: Auth work
+      title: '^\[Auth\]'
+      module: Auth
+    - name: Bugs go to the current sprint
+      labels: [bug]
+      query: '-group:completed'
+      cycle: Sprint 12
+
+Examples:
+  # Preview proposed moves
+  plane-cli auto-organize --project <project-id> --rules rules.yaml --dry-run
+
+  # Apply them, also moving items that already belong to another cycle
+  plane-cli auto-organize --project <project-id> --rules rules.yaml --move`,
+	RunE: runAutoOrganize,
+}
+
+// OrganizeRules is the rules file read by auto-organize
+type OrganizeRules struct {
+	Rules []OrganizeRule `yaml:"rules"`
+}
+
+// OrganizeRule assigns matching work items to a module and/or cycle
+type OrganizeRule struct {
+	Name   string   `yaml:"name"`
+	Title  string   `yaml:"title,omitempty"`
+	Labels []string `yaml:"labels,omitempty"`
+	Query  string   `yaml:"query,omitempty"`
+	Module string   `yaml:"module,omitempty"`
+	Cycle  string   `yaml:"cycle,omitempty"`
+
+	title *regexp.Regexp
+	query *query.Query
+}
+
+// organizeMove is a proposed assignment for one work item
+type organizeMove struct {
+	Item     plane.WorkItem
+	Rule     string
+	ModuleID string
+	CycleID  string
+}
+
+func init() {
+	rootCmd.AddCommand(autoOrganizeCmd)
+
+	autoOrganizeCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+	autoOrganizeCmd.Flags().String("rules", "", "Rules YAML file (required)")
+	autoOrganizeCmd.Flags().Bool("move", false, "Move items that already belong to a different cycle")
+	autoOrganizeCmd.Flags().Bool("dry-run", false, "Preview proposed moves without applying")
+	autoOrganizeCmd.Flags().Bool("yes", false, "Apply without asking for confirmation")
+	autoOrganizeCmd.MarkFlagRequired("project")
+	autoOrganizeCmd.MarkFlagRequired("rules")
+}
+
+func runAutoOrganize(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	rulesFile, _ := cmd.Flags().GetString("rules")
+	move, _ := cmd.Flags().GetBool("move")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	skipConfirm, _ := cmd.Flags().GetBool("yes")
+
+	rules, err := loadOrganizeRules(rulesFile)
+	if err != nil {
+		return err
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	modules, err := client.GetModules(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get modules: %w", err)
+	}
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get cycles: %w", err)
+	}
+	labels, err := client.GetLabels(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	moduleIDs := make(map[string]string)
+	moduleNames := make(map[string]string)
+	for _, m := range modules {
+		moduleIDs[strings.ToLower(m.Name)] = m.ID
+		moduleIDs[m.ID] = m.ID
+		moduleNames[m.ID] = m.Name
+	}
+	cycleIDs := make(map[string]string)
+	cycleNames := make(map[string]string)
+	for _, c := range cycles {
+		cycleIDs[strings.ToLower(c.Name)] = c.ID
+		cycleIDs[c.ID] = c.ID
+		cycleNames[c.ID] = c.Name
+	}
+
+	// Resolve rule targets up front so typos fail before anything is fetched
+	for _, r := range rules.Rules {
+		if r.Module != "" && moduleIDs[strings.ToLower(r.Module)] == "" {
+			return fmt.Errorf("rule '%s': module '%s' not found", r.Name, r.Module)
+		}
+		if r.Cycle != "" && cycleIDs[strings.ToLower(r.Cycle)] == "" {
+			return fmt.Errorf("rule '%s': cycle '%s' not found", r.Name, r.Cycle)
+		}
+	}
+
+	var ctx *query.Context
+	for _, r := range rules.Rules {
+		if r.query != nil {
+			states, err := client.GetProjectStates(projectID)
+			if err != nil {
+				return fmt.Errorf("failed to get states: %w", err)
+			}
+			members, err := client.GetProjectMembers(projectID)
+			if err != nil {
+				return fmt.Errorf("failed to get members: %w", err)
+			}
+			ctx = query.NewContext(states, labels, members)
+			break
+		}
+	}
+
+	// Load the custom properties referenced by rule queries
+	var properties []string
+	for _, r := range rules.Rules {
+		if r.query != nil {
+			properties = mergeSlices(properties, r.query.Properties())
+		}
+	}
+	if len(properties) > 0 {
+		if err := loadQueryProperties(client, projectID, properties, ctx); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("📥 Fetching work items from project '%s'...\n", projectID)
+	items, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	labelNames := make(map[string]string)
+	for _, l := range labels {
+		labelNames[l.ID] = strings.ToLower(l.Name)
+	}
+
+	var moves []organizeMove
+	skipped := 0
+	for _, item := range items {
+		rule := matchOrganizeRule(rules.Rules, &item, labelNames, ctx)
+		if rule == nil {
+			continue
+		}
+
+		m := organizeMove{Item: item, Rule: rule.Name}
+		if rule.Module != "" {
+			target := moduleIDs[strings.ToLower(rule.Module)]
+			current := item.ModuleID
+			if current == "" {
+				current = item.Module
+			}
+			if current != target {
+				m.ModuleID = target
+			}
+		}
+		if rule.Cycle != "" {
+			target := cycleIDs[strings.ToLower(rule.Cycle)]
+			current := item.CycleID
+			if current == "" {
+				current = item.Cycle
+			}
+			if current != target {
+				if current != "" && !move {
+					skipped++
+				} else {
+					m.CycleID = target
+				}
+			}
+		}
+
+		if m.ModuleID != "" || m.CycleID != "" {
+			moves = append(moves, m)
+		}
+	}
+
+	if len(moves) == 0 {
+		fmt.Println("\n✅ Nothing to organize - all matching work items are already in place.")
+		if skipped > 0 {
+			fmt.Printf("   %d item(s) already belong to another cycle (use --move to move them)\n", skipped)
+		}
+		return nil
+	}
+
+	fmt.Printf("\n📋 Proposed moves (%d):\n", len(moves))
+	fmt.Println(strings.Repeat("-", 70))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ITEM\tTITLE\tRULE\tMODULE\tCYCLE")
+	for _, m := range moves {
+		module, cycle := "-", "-"
+		if m.ModuleID != "" {
+			module = "→ " + moduleNames[m.ModuleID]
+		}
+		if m.CycleID != "" {
+			cycle = "→ " + cycleNames[m.CycleID]
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", m.Item.SequenceID, truncate(m.Item.Name, 40), m.Rule, module, cycle)
+	}
+	w.Flush()
+	fmt.Println(strings.Repeat("-", 70))
+	if skipped > 0 {
+		fmt.Printf("⚠️  %d item(s) already belong to another cycle and were left alone (use --move)\n", skipped)
+	}
+
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no changes made.")
+		return nil
+	}
+
+	if !skipConfirm {
+		confirmed, err := confirm("\nApply these moves?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("\n❌ Cancelled.")
+			return nil
+		}
+	}
+
+	// Group by target so each module/cycle gets a single request
+	byModule := make(map[string][]string)
+	byCycle := make(map[string][]string)
+	for _, m := range moves {
+		if m.ModuleID != "" {
+			byModule[m.ModuleID] = append(byModule[m.ModuleID], m.Item.ID)
+		}
+		if m.CycleID != "" {
+			byCycle[m.CycleID] = append(byCycle[m.CycleID], m.Item.ID)
+		}
+	}
+
+	fmt.Println()
+	failed := 0
+	for _, id := range sortedKeys(byModule) {
+		if err := client.AddWorkItemsToModule(projectID, id, byModule[id]); err != nil {
+			fmt.Printf("  ❌ Module %s: %v\n", moduleNames[id], err)
+			failed++
+			continue
+		}
+		fmt.Printf("  ✅ Module %s: %d work item(s) added\n", moduleNames[id], len(byModule[id]))
+	}
+	for _, id := range sortedKeys(byCycle) {
+		if err := client.AddWorkItemsToCycle(projectID, id, byCycle[id]); err != nil {
+			fmt.Printf("  ❌ Cycle %s: %v\n", cycleNames[id], err)
+			failed++
+			continue
+		}
+		fmt.Printf("  ✅ Cycle %s: %d work item(s) added\n", cycleNames[id], len(byCycle[id]))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d assignment(s) failed", failed)
+	}
+	return nil
+}
+
+// loadOrganizeRules reads and compiles a rules file
+func loadOrganizeRules(filename string) (*OrganizeRules, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules: %w", err)
+	}
+
+	var rules OrganizeRules
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse rules: %w", err)
+	}
+	if len(rules.Rules) == 0 {
+		return nil, fmt.Errorf("no rules defined in %s", filename)
+	}
+
+	for i := range rules.Rules {
+		r := &rules.Rules[i]
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("rule %d", i+1)
+		}
+		if r.Module == "" && r.Cycle == "" {
+			return nil, fmt.Errorf("rule '%s' must set a module or a cycle", r.Name)
+		}
+		if r.Title == "" && len(r.Labels) == 0 && r.Query == "" {
+			return nil, fmt.Errorf("rule '%s' must match on title, labels or query", r.Name)
+		}
+		if r.Title != "" {
+			if r.title, err = regexp.Compile(r.Title); err != nil {
+				return nil, fmt.Errorf("rule '%s': invalid title pattern: %w", r.Name, err)
+			}
+		}
+		if r.Query != "" {
+			if r.query, err = query.Parse(r.Query); err != nil {
+				return nil, fmt.Errorf("rule '%s': invalid query: %w", r.Name, err)
+			}
+		}
+	}
+
+	return &rules, nil
+}
+
+// matchOrganizeRule returns the first rule whose conditions all match the item
+func matchOrganizeRule(rules []OrganizeRule, item *plane.WorkItem, labelNames map[string]string, ctx *query.Context) *OrganizeRule {
+	for i := range rules {
+		r := &rules[i]
+		if r.title != nil && !r.title.MatchString(item.Name) {
+			continue
+		}
+		if len(r.Labels) > 0 && !itemHasAnyLabel(item, r.Labels, labelNames) {
+			continue
+		}
+		if r.query != nil && !r.query.Match(item, ctx) {
+			continue
+		}
+		return r
+	}
+	return nil
+}
+
+func itemHasAnyLabel(item *plane.WorkItem, wanted []string, labelNames map[string]string) bool {
+	for _, id := range itemLabelIDs(item) {
+		for _, w := range wanted {
+			if id == w || labelNames[id] == strings.ToLower(w) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,740 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+	"plane-cli/internal/console"
+	"plane-cli/internal/plane"
+)
+
+var boardCmd = &cobra.Command{
+	Use:   "board",
+	Short: "Full-screen kanban board of a project",
+	Long: `Show the work items of a project as a kanban board, one column per
+state in workflow order, and change them from the keyboard:
+
+  ←/→ or h/l    move between columns
+  ↑/↓ or k/j    move between work items
+  space         pick up the work item; ←/→ carry it to another column,
+                space or enter drop it there, esc puts it back
+  H/L           move the work item to the previous or next state at once
+  e             edit the title
+  a             choose the assignees
+  o             open the work item in the browser
+  r             reload the work items
+  q             quit
+
+Changes are saved as soon as they are made; a change the server refuses is
+undone on the board. The board is also in the main menu of interactive mode.
+
+Examples:
+  plane-cli board --project <project-id>`,
+	RunE: runBoardCmd,
+}
+
+func init() {
+	rootCmd.AddCommand(boardCmd)
+
+	boardCmd.Flags().String("project", "", "Project ID (required unless defaults.project is set)")
+	boardCmd.MarkFlagRequired("project")
+}
+
+func runBoardCmd(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	return runBoard(client, project, webAppURL(cfg), resolveWorkspace(cmd, cfg), false)
+}
+
+// runBoardInteractive asks for a project and shows its board
+func runBoardInteractive(client *plane.Client, readOnly bool) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	project, err := selectProjectInteractive(client)
+	if err != nil {
+		return err
+	}
+	return runBoard(client, project, webAppURL(cfg), client.Workspace(), readOnly)
+}
+
+// runBoard loads a project and shows it as a kanban board until the user
+// quits. With readOnly the board can't change anything.
+func runBoard(client *plane.Client, project *plane.Project, webURL, workspace string, readOnly bool) error {
+	if !console.IsTerminal(os.Stdin) || !console.IsTerminal(os.Stdout) {
+		return fmt.Errorf("the board needs a terminal")
+	}
+
+	states, err := client.GetProjectStates(project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get states: %w", err)
+	}
+	if len(states) == 0 {
+		return fmt.Errorf("project %s has no states", project.Identifier)
+	}
+	slices.SortStableFunc(states, func(a, b plane.State) int {
+		return slices.Index(stateGroupOrder, a.Group) - slices.Index(stateGroupOrder, b.Group)
+	})
+	members, err := client.GetProjectMembers(project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get members: %w", err)
+	}
+	slices.SortFunc(members, func(a, b plane.Member) int {
+		return strings.Compare(strings.ToLower(a.GetDisplayName()), strings.ToLower(b.GetDisplayName()))
+	})
+	items, err := fetchAllWorkItemsForProject(client, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	m := &boardModel{
+		client:    client,
+		project:   project,
+		lookup:    buildItemLookup(project.ID, project, states, nil, members),
+		members:   members,
+		webURL:    webURL,
+		workspace: workspace,
+		readOnly:  readOnly,
+		title:     textinput.New(),
+	}
+	for _, s := range states {
+		m.columns = append(m.columns, boardColumn{state: s})
+	}
+	m.setItems(items)
+
+	if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+		return fmt.Errorf("board failed: %w", err)
+	}
+	return nil
+}
+
+// Layout of the board, in terminal cells
+const (
+	boardColumnMinWidth = 28
+	// boardCardHeight is the height of a card with the blank line after it
+	boardCardHeight = 4
+	// boardChromeHeight is the height of the header, column titles and
+	// footer around the cards
+	boardChromeHeight = 7
+)
+
+// boardMode is what the keys of the board currently do
+type boardMode int
+
+const (
+	boardBrowse boardMode = iota
+	// boardCarry moves a picked up work item between columns
+	boardCarry
+	boardEditTitle
+	boardPickAssignees
+)
+
+// boardColumn is a state and its work items, most pressing first
+type boardColumn struct {
+	state plane.State
+	items []plane.WorkItem
+	// cursor is the selected work item and offset the first one shown
+	cursor, offset int
+}
+
+// boardModel is the state of the kanban board program
+type boardModel struct {
+	client            *plane.Client
+	project           *plane.Project
+	lookup            *itemLookup
+	members           []plane.Member
+	webURL, workspace string
+	readOnly          bool
+
+	columns []boardColumn
+	// focus is the selected column and first the first one shown
+	focus, first  int
+	width, height int
+
+	mode boardMode
+	// carryFrom is the column a carried work item was picked up from
+	carryFrom int
+	title     textinput.Model
+	// pickCursor and picked are the selected member and the chosen
+	// assignees of the assignee picker
+	pickCursor int
+	picked     map[string]bool
+
+	status string
+	// pending counts the changes being saved; quitArmed is set when q was
+	// pressed while some were
+	pending   int
+	quitArmed bool
+}
+
+// boardSavedMsg reports a saved change; undo puts the board back as it was
+// when the server refused it
+type boardSavedMsg struct {
+	what string
+	err  error
+	undo func(m *boardModel)
+}
+
+// boardLoadedMsg carries the work items of a reload
+type boardLoadedMsg struct {
+	items []plane.WorkItem
+	err   error
+}
+
+func (m *boardModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m *boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.title.Width = max(m.width-20, 10)
+	case boardSavedMsg:
+		m.pending--
+		if msg.err != nil {
+			msg.undo(m)
+			m.status = fmt.Sprintf("❌ %s: %v", msg.what, msg.err)
+		} else {
+			m.status = "✓ " + msg.what
+		}
+		if m.quitArmed && m.pending == 0 {
+			return m, tea.Quit
+		}
+	case boardLoadedMsg:
+		if msg.err != nil {
+			m.status = fmt.Sprintf("❌ Reload failed: %v", msg.err)
+		} else {
+			m.setItems(msg.items)
+			m.status = fmt.Sprintf("✓ Reloaded %d work items", len(msg.items))
+		}
+	case tea.KeyMsg:
+		switch m.mode {
+		case boardBrowse:
+			cmd = m.browseKey(msg)
+		case boardCarry:
+			cmd = m.carryKey(msg)
+		case boardEditTitle:
+			cmd = m.editTitleKey(msg)
+		case boardPickAssignees:
+			cmd = m.pickAssigneesKey(msg)
+		}
+	}
+	m.scroll()
+	return m, cmd
+}
+
+func (m *boardModel) browseKey(msg tea.KeyMsg) tea.Cmd {
+	key := msg.String()
+	if key != "q" {
+		m.quitArmed = false
+	}
+	col := &m.columns[m.focus]
+	switch key {
+	case "q", "ctrl+c":
+		if m.pending > 0 && !m.quitArmed && key == "q" {
+			m.quitArmed = true
+			m.status = fmt.Sprintf("⏳ Saving %d change(s); quitting when done (q again to quit now)", m.pending)
+			return nil
+		}
+		return tea.Quit
+	case "left", "h":
+		m.focus = max(m.focus-1, 0)
+	case "right", "l":
+		m.focus = min(m.focus+1, len(m.columns)-1)
+	case "up", "k":
+		col.cursor = max(col.cursor-1, 0)
+	case "down", "j":
+		col.cursor = min(col.cursor+1, max(len(col.items)-1, 0))
+	case "home", "g":
+		col.cursor = 0
+	case "end", "G":
+		col.cursor = max(len(col.items)-1, 0)
+	case "r":
+		m.status = "📥 Reloading..."
+		client, projectID := m.client, m.project.ID
+		return func() tea.Msg {
+			items, err := client.WorkItemsPager(projectID, nil).All()
+			return boardLoadedMsg{items: items, err: err}
+		}
+	}
+
+	item := m.selected()
+	if item == nil {
+		return nil
+	}
+	switch key {
+	case "o":
+		u := projectWebURL(m.webURL, m.workspace, m.project.ID, "issues", item.ID)
+		if err := console.OpenURL(u); err != nil {
+			m.status = fmt.Sprintf("❌ Failed to open the browser: %v", err)
+		} else {
+			m.status = "🌐 Opened " + m.key(item)
+		}
+		return nil
+	case " ", "space", "H", "L", "shift+left", "shift+right", "e", "a":
+		if m.readOnly {
+			m.status = "🔒 Read-only access: the board can't change work items"
+			return nil
+		}
+	}
+	switch key {
+	case " ", "space":
+		m.mode = boardCarry
+		m.carryFrom = m.focus
+		m.status = fmt.Sprintf("✋ Carrying %s", m.key(item))
+	case "H", "shift+left":
+		if m.focus > 0 {
+			return m.moveSelected(m.focus, m.focus-1)
+		}
+	case "L", "shift+right":
+		if m.focus < len(m.columns)-1 {
+			return m.moveSelected(m.focus, m.focus+1)
+		}
+	case "e":
+		m.mode = boardEditTitle
+		m.title.SetValue(item.Name)
+		m.title.CursorEnd()
+		return m.title.Focus()
+	case "a":
+		m.mode = boardPickAssignees
+		m.pickCursor = 0
+		m.picked = make(map[string]bool)
+		for _, id := range itemAssigneeIDs(item) {
+			m.picked[id] = true
+		}
+	}
+	return nil
+}
+
+// carryKey moves a picked up work item between columns; it is only saved
+// when dropped
+func (m *boardModel) carryKey(msg tea.KeyMsg) tea.Cmd {
+	item := m.selected()
+	if item == nil {
+		m.mode = boardBrowse
+		return nil
+	}
+	switch msg.String() {
+	case "left", "h":
+		if m.focus > 0 {
+			m.moveItem(item.ID, m.focus-1, true)
+		}
+	case "right", "l":
+		if m.focus < len(m.columns)-1 {
+			m.moveItem(item.ID, m.focus+1, true)
+		}
+	case "esc":
+		m.moveItem(item.ID, m.carryFrom, true)
+		m.mode = boardBrowse
+		m.status = ""
+	case " ", "space", "enter":
+		m.mode = boardBrowse
+		m.status = ""
+		if m.focus != m.carryFrom {
+			return m.saveState(item.ID, m.carryFrom, m.focus)
+		}
+	case "ctrl+c":
+		return tea.Quit
+	}
+	return nil
+}
+
+func (m *boardModel) editTitleKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.mode = boardBrowse
+		m.title.Blur()
+		return nil
+	case "enter":
+		m.mode = boardBrowse
+		m.title.Blur()
+		item := m.selected()
+		name := strings.TrimSpace(m.title.Value())
+		if item == nil || name == "" || name == item.Name {
+			return nil
+		}
+		id, old, key := item.ID, item.Name, m.key(item)
+		item.Name = name
+		return m.save(fmt.Sprintf("Renamed %s", key), func(client *plane.Client, projectID string) error {
+			_, err := client.UpdateWorkItem(projectID, id, &plane.WorkItemUpdate{Name: name})
+			return err
+		}, func(m *boardModel) {
+			if item := m.item(id); item != nil {
+				item.Name = old
+			}
+		})
+	case "ctrl+c":
+		return tea.Quit
+	}
+	var cmd tea.Cmd
+	m.title, cmd = m.title.Update(msg)
+	return cmd
+}
+
+func (m *boardModel) pickAssigneesKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.mode = boardBrowse
+	case "up", "k":
+		m.pickCursor = max(m.pickCursor-1, 0)
+	case "down", "j":
+		m.pickCursor = min(m.pickCursor+1, max(len(m.members)-1, 0))
+	case " ", "space":
+		if len(m.members) > 0 {
+			id := m.members[m.pickCursor].ID
+			m.picked[id] = !m.picked[id]
+		}
+	case "enter":
+		m.mode = boardBrowse
+		item := m.selected()
+		if item == nil {
+			return nil
+		}
+		ids := []string{}
+		for _, member := range m.members {
+			if m.picked[member.ID] {
+				ids = append(ids, member.ID)
+			}
+		}
+		if sameNames(ids, itemAssigneeIDs(item)) {
+			return nil
+		}
+		id, key := item.ID, m.key(item)
+		oldIDs, oldAssignees := item.AssigneeIDs, item.Assignees
+		item.AssigneeIDs, item.Assignees = ids, ids
+		return m.save(fmt.Sprintf("Assigned %s to %s", key, strings.Join(m.lookup.assigneeNames(item), ", ")),
+			func(client *plane.Client, projectID string) error {
+				// A patch, as the update payload can't clear the assignees
+				_, err := client.PatchWorkItem(projectID, id, map[string]any{"assignees": ids})
+				return err
+			}, func(m *boardModel) {
+				if item := m.item(id); item != nil {
+					item.AssigneeIDs, item.Assignees = oldIDs, oldAssignees
+				}
+			})
+	case "ctrl+c":
+		return tea.Quit
+	}
+	return nil
+}
+
+// moveSelected moves the selected work item to another column and saves
+// its new state
+func (m *boardModel) moveSelected(from, to int) tea.Cmd {
+	id := m.selected().ID
+	m.moveItem(id, to, true)
+	return m.saveState(id, from, to)
+}
+
+// saveState saves the state of a work item moved from column from to
+// column to, moving it back if that fails
+func (m *boardModel) saveState(id string, from, to int) tea.Cmd {
+	state := m.columns[to].state
+	return m.save(fmt.Sprintf("Moved %s to %s", m.key(m.item(id)), state.Name), func(client *plane.Client, projectID string) error {
+		_, err := client.UpdateWorkItem(projectID, id, &plane.WorkItemUpdate{State: state.ID})
+		return err
+	}, func(m *boardModel) {
+		m.moveItem(id, from, false)
+	})
+}
+
+// save runs a change in the background; the board already shows it
+func (m *boardModel) save(what string, change func(client *plane.Client, projectID string) error, undo func(m *boardModel)) tea.Cmd {
+	m.pending++
+	m.status = "💾 " + what + "..."
+	client, projectID := m.client, m.project.ID
+	return func() tea.Msg {
+		return boardSavedMsg{what: what, err: change(client, projectID), undo: undo}
+	}
+}
+
+// setItems sorts work items into the columns of their states; those in an
+// unknown state are left out
+func (m *boardModel) setItems(items []plane.WorkItem) {
+	for i := range m.columns {
+		m.columns[i].items = nil
+	}
+	for _, item := range items {
+		if i := m.columnOf(itemStateID(&item)); i >= 0 {
+			m.columns[i].items = append(m.columns[i].items, item)
+		}
+	}
+	for i := range m.columns {
+		col := &m.columns[i]
+		sortBoardItems(col.items)
+		col.cursor = min(col.cursor, max(len(col.items)-1, 0))
+	}
+}
+
+// moveItem moves a work item to column to. With follow the selection moves
+// along with it; otherwise the selection stays where it was.
+func (m *boardModel) moveItem(id string, to int, follow bool) {
+	from, i := m.find(id)
+	if from < 0 || from == to {
+		return
+	}
+	var selectedID string
+	if item := m.selected(); item != nil && !follow {
+		selectedID = item.ID
+	}
+
+	item := m.columns[from].items[i]
+	item.State, item.StateID = m.columns[to].state.ID, ""
+	m.columns[from].items = slices.Delete(m.columns[from].items, i, i+1)
+	m.columns[to].items = append(m.columns[to].items, item)
+	sortBoardItems(m.columns[to].items)
+
+	if follow {
+		selectedID = id
+	}
+	for c := range m.columns {
+		col := &m.columns[c]
+		col.cursor = min(col.cursor, max(len(col.items)-1, 0))
+	}
+	if c, i := m.find(selectedID); c >= 0 {
+		m.focus, m.columns[c].cursor = c, i
+	}
+}
+
+// sortBoardItems orders the work items of a column by priority, then by
+// number
+func sortBoardItems(items []plane.WorkItem) {
+	rank := func(item *plane.WorkItem) int {
+		if r, ok := priorityRank[item.Priority]; ok {
+			return r
+		}
+		return len(priorityRank)
+	}
+	slices.SortStableFunc(items, func(a, b plane.WorkItem) int {
+		if ra, rb := rank(&a), rank(&b); ra != rb {
+			return ra - rb
+		}
+		return a.SequenceID - b.SequenceID
+	})
+}
+
+// columnOf returns the column of a state, or -1
+func (m *boardModel) columnOf(stateID string) int {
+	return slices.IndexFunc(m.columns, func(c boardColumn) bool { return c.state.ID == stateID })
+}
+
+// find returns the column and position of a work item, or -1
+func (m *boardModel) find(id string) (int, int) {
+	for c, col := range m.columns {
+		for i, item := range col.items {
+			if item.ID == id {
+				return c, i
+			}
+		}
+	}
+	return -1, -1
+}
+
+// item returns the work item with the given ID, or nil
+func (m *boardModel) item(id string) *plane.WorkItem {
+	if c, i := m.find(id); c >= 0 {
+		return &m.columns[c].items[i]
+	}
+	return nil
+}
+
+// selected returns the selected work item, or nil in an empty column
+func (m *boardModel) selected() *plane.WorkItem {
+	col := &m.columns[m.focus]
+	if col.cursor >= len(col.items) {
+		return nil
+	}
+	return &col.items[col.cursor]
+}
+
+func (m *boardModel) key(item *plane.WorkItem) string {
+	return fmt.Sprintf("%s-%d", m.project.Identifier, item.SequenceID)
+}
+
+// layout returns how many columns fit the terminal, their width and how
+// many cards fit a column
+func (m *boardModel) layout() (visible, width, cards int) {
+	visible = max(1, min(len(m.columns), m.width/boardColumnMinWidth))
+	width = max(m.width/visible-1, 10)
+	cards = max((m.height-boardChromeHeight)/boardCardHeight, 1)
+	return visible, width, cards
+}
+
+// scroll keeps the selected column and work items on screen
+func (m *boardModel) scroll() {
+	visible, _, cards := m.layout()
+	if m.focus < m.first {
+		m.first = m.focus
+	}
+	if m.focus >= m.first+visible {
+		m.first = m.focus - visible + 1
+	}
+	m.first = min(m.first, len(m.columns)-visible)
+	for i := range m.columns {
+		col := &m.columns[i]
+		if col.cursor < col.offset {
+			col.offset = col.cursor
+		}
+		if col.cursor >= col.offset+cards {
+			col.offset = col.cursor - cards + 1
+		}
+		col.offset = max(min(col.offset, len(col.items)-cards), 0)
+	}
+}
+
+var (
+	boardHeaderStyle   = lipgloss.NewStyle().Bold(true)
+	boardDimStyle      = lipgloss.NewStyle().Faint(true)
+	boardSelectedStyle = lipgloss.NewStyle().Reverse(true)
+)
+
+func (m *boardModel) View() string {
+	if m.width == 0 {
+		return ""
+	}
+	if m.mode == boardPickAssignees {
+		return m.assigneesView()
+	}
+
+	total := 0
+	for _, col := range m.columns {
+		total += len(col.items)
+	}
+	header := boardHeaderStyle.Render(fmt.Sprintf("🗂️  %s (%s)", m.project.Name, m.project.Identifier)) +
+		boardDimStyle.Render(fmt.Sprintf("  %d work items", total))
+	if m.readOnly {
+		header += boardDimStyle.Render("  🔒 read-only")
+	}
+
+	visible, width, cards := m.layout()
+	var columns []string
+	for c := m.first; c < min(m.first+visible, len(m.columns)); c++ {
+		columns = append(columns, m.columnView(c, width, cards))
+	}
+	body := lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+
+	var more []string
+	if m.first > 0 {
+		more = append(more, fmt.Sprintf("◀ %d more", m.first))
+	}
+	if rest := len(m.columns) - m.first - visible; rest > 0 {
+		more = append(more, fmt.Sprintf("%d more ▶", rest))
+	}
+	if len(more) > 0 {
+		header += boardDimStyle.Render("  columns: " + strings.Join(more, ", "))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, "", body, m.footerView())
+}
+
+// columnView renders a column with its title and the cards that fit
+func (m *boardModel) columnView(c, width, cards int) string {
+	col := &m.columns[c]
+	titleStyle := lipgloss.NewStyle().Bold(true)
+	if col.state.Color != "" {
+		titleStyle = titleStyle.Foreground(lipgloss.Color(col.state.Color))
+	}
+	title := titleStyle.Render(ansi.Truncate(col.state.Name, width-6, "…")) + boardDimStyle.Render(fmt.Sprintf(" (%d)", len(col.items)))
+	if c == m.focus {
+		title = "▸ " + title
+	}
+	lines := []string{title, boardDimStyle.Render(strings.Repeat("─", width-1))}
+
+	end := min(col.offset+cards, len(col.items))
+	if col.offset > 0 {
+		lines = append(lines, boardDimStyle.Render(fmt.Sprintf("  ↑ %d more", col.offset)))
+	}
+	for i := col.offset; i < end; i++ {
+		item := &col.items[i]
+		priority := ""
+		if item.Priority != "" && item.Priority != "none" {
+			priority = " · " + item.Priority
+		}
+		card := []string{
+			ansi.Truncate(m.key(item)+priority, width-2, "…"),
+			ansi.Truncate(item.Name, width-2, "…"),
+			boardDimStyle.Render(ansi.Truncate(strings.Join(m.lookup.assigneeNames(item), ", "), width-2, "…")),
+		}
+		selected := c == m.focus && i == col.cursor
+		for j, line := range card {
+			if selected {
+				marker := " "
+				if j == 0 && m.mode == boardCarry {
+					marker = "✋"
+				}
+				line = boardSelectedStyle.Width(width - 1).Render(ansi.Truncate(marker+ansi.Strip(line), width-1, "…"))
+			} else {
+				line = " " + line
+			}
+			lines = append(lines, line)
+		}
+		lines = append(lines, "")
+	}
+	if rest := len(col.items) - end; rest > 0 {
+		lines = append(lines, boardDimStyle.Render(fmt.Sprintf("  ↓ %d more", rest)))
+	}
+	return lipgloss.NewStyle().Width(width).MarginRight(1).Render(strings.Join(lines, "\n"))
+}
+
+func (m *boardModel) footerView() string {
+	var help string
+	switch m.mode {
+	case boardCarry:
+		help = "←/→ carry  space/enter drop  esc put back"
+	case boardEditTitle:
+		return "Title: " + m.title.View() + "\n" + boardDimStyle.Render("enter save  esc cancel")
+	default:
+		help = "←/→ columns  ↑/↓ items  space pick up  H/L move  e title  a assignees  o open  r reload  q quit"
+		if m.readOnly {
+			help = "←/→ columns  ↑/↓ items  o open  r reload  q quit"
+		}
+	}
+	status, _, _ := strings.Cut(m.status, "\n")
+	return ansi.Truncate(status, m.width, "…") + "\n" + boardDimStyle.Render(help)
+}
+
+// assigneesView renders the assignee picker of the selected work item
+func (m *boardModel) assigneesView() string {
+	item := m.selected()
+	if item == nil {
+		return ""
+	}
+	lines := []string{boardHeaderStyle.Render(fmt.Sprintf("👤 Assignees of %s %s", m.key(item), item.Name)), ""}
+	rows := max(m.height-5, 1)
+	start := max(0, min(m.pickCursor-rows/2, len(m.members)-rows))
+	for i := start; i < min(start+rows, len(m.members)); i++ {
+		check := "[ ]"
+		if m.picked[m.members[i].ID] {
+			check = "[x]"
+		}
+		line := fmt.Sprintf("%s %s", check, m.members[i].GetDisplayName())
+		if i == m.pickCursor {
+			line = boardSelectedStyle.Render(line)
+		}
+		lines = append(lines, "  "+line)
+	}
+	if len(m.members) == 0 {
+		lines = append(lines, boardDimStyle.Render("  No members in this project"))
+	}
+	lines = append(lines, "", boardDimStyle.Render("↑/↓ move  space toggle  enter save  esc cancel"))
+	return strings.Join(lines, "\n")
+}
@@ -2,10 +2,12 @@ package commands
 
 import (
 	"fmt"
+	"html"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"plane-cli/internal/config"
+	"plane-cli/internal/markdown"
 	"plane-cli/internal/plane"
 )
 
@@ -35,7 +37,7 @@ Examples:
   plane-cli bulk-create \
     --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 \
     --titles "[BE] Purchase Order,[BE] Sales Order,[BE] Inventory" \
-    --assignees user-id-1 \
+    --assignees jane@example.com \
     --estimate 5 \
     --state "Backlog"
 
@@ -43,8 +45,50 @@ Examples:
   plane-cli bulk-create \
     --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 \
     --titles-file work-items.txt \
-    --module module-id \
-    --labels label-1,label-2`,
+    --module "Purchasing" \
+    --labels bug,backend --create-labels
+
+Outline files:
+  Indentation in --titles-file defines a hierarchy: an indented line becomes
+  a sub-item of the closest less indented line above it. List markers
+  (-, *, +) are ignored, so a breakdown can be pasted from a planning doc:
+
+    - Checkout redesign
+      - Cart page
+        - Promo code field
+      - Payment step
+    - Order history
+
+  Parents are created first and their sub-items are linked to them.
+
+Plane pages:
+  With --from-page, the list items and checklist lines of a page of the
+  project become work items, nested the same way. --section limits them to
+  the list under one heading. Checked lines are skipped unless
+  --include-checked is given. Every work item links back to the page.
+
+    plane-cli bulk-create --project <project-id> --from-page <page-id> --section "Backlog"
+
+CSV files:
+  With --csv, each row becomes a work item with its own attributes. The
+  header names the columns; recognised columns are title (required),
+  description, state, priority, assignees (emails), labels (names), module
+  (name) and estimate. Several assignees or labels are separated by commas.
+
+    title,state,priority,assignees,labels,estimate
+    Purchase Order API,Backlog,high,ana@example.com,"backend,api",5
+
+  Every row is checked before anything is created. Rows with unknown
+  states, labels, members or modules are listed with their line number and
+  skipped.
+
+Failures:
+  Work items that can't be created are counted by class (auth, validation,
+  rate-limit, network). Rate-limit and network failures are retried at the
+  end of the run; what still fails, with the sub-items skipped because of
+  it, is saved to failed-items.json. Replay it once the cause is fixed:
+
+    plane-cli bulk-create --project <project-id> --retry-file failed-items.json`,
 	RunE: runBulkCreate,
 }
 
@@ -52,18 +96,23 @@ func init() {
 	rootCmd.AddCommand(bulkCreateCmd)
 
 	// Required flags
-	bulkCreateCmd.Flags().String("project", "", "Project identifier (required)")
+	bulkCreateCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	bulkCreateCmd.MarkFlagRequired("project")
 
 	// Titles input
 	bulkCreateCmd.Flags().StringSlice("titles", nil, "Work item titles (comma-separated)")
-	bulkCreateCmd.Flags().String("titles-file", "", "File containing titles (one per line)")
+	bulkCreateCmd.Flags().String("titles-file", "", "File containing titles (one per line, indent for sub-items)")
+	bulkCreateCmd.Flags().String("csv", "", "CSV file with one work item per row")
+	bulkCreateCmd.Flags().String("from-page", "", "Page ID whose list items become work items")
+	bulkCreateCmd.Flags().String("section", "", "With --from-page, only use the list under this heading")
+	bulkCreateCmd.Flags().Bool("include-checked", false, "With --from-page, also create work items for checked lines")
 
 	// Common attributes
-	bulkCreateCmd.Flags().StringSlice("assignees", nil, "Assignee user IDs (comma-separated)")
+	bulkCreateCmd.Flags().StringSlice("assignees", nil, "Assignees: member IDs, emails, names or me (comma-separated)")
 	bulkCreateCmd.Flags().Float64("estimate", 0, "Estimate points for all work items")
-	bulkCreateCmd.Flags().StringSlice("labels", nil, "Label IDs (comma-separated)")
-	bulkCreateCmd.Flags().String("module", "", "Module ID")
+	bulkCreateCmd.Flags().StringSlice("labels", nil, "Labels: IDs or names (comma-separated)")
+	bulkCreateCmd.Flags().Bool("create-labels", false, "Create labels given by name that don't exist yet")
+	bulkCreateCmd.Flags().String("module", "", "Module ID or name")
 	bulkCreateCmd.Flags().String("state", "Backlog", "Initial state (default: Backlog)")
 	bulkCreateCmd.Flags().String("priority", "medium", "Priority: urgent, high, medium, low (default: medium)")
 	bulkCreateCmd.Flags().String("description", "", "Description for all work items")
@@ -72,6 +121,7 @@ func init() {
 	// Behavior flags
 	bulkCreateCmd.Flags().Bool("dry-run", false, "Preview what would be created without actually creating")
 	bulkCreateCmd.Flags().Bool("interactive", false, "Force interactive mode")
+	bulkCreateCmd.Flags().String("retry-file", "", "Retry the work items saved in a failed-items.json file")
 }
 
 func runBulkCreate(cmd *cobra.Command, args []string) error {
@@ -83,9 +133,17 @@ func runBulkCreate(cmd *cobra.Command, args []string) error {
 	projectID, _ := cmd.Flags().GetString("project")
 	titlesFlag, _ := cmd.Flags().GetStringSlice("titles")
 	titlesFile, _ := cmd.Flags().GetString("titles-file")
+	csvFile, _ := cmd.Flags().GetString("csv")
+	fromPage, _ := cmd.Flags().GetString("from-page")
+	section, _ := cmd.Flags().GetString("section")
+	includeChecked, _ := cmd.Flags().GetBool("include-checked")
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
 	forceInteractive, _ := cmd.Flags().GetBool("interactive")
 
+	if retryFile, _ := cmd.Flags().GetString("retry-file"); retryFile != "" {
+		return runBulkRetryFile(cmd, retryFile, dryRun, false)
+	}
+
 	// Get common attributes
 	assignees, _ := cmd.Flags().GetStringSlice("assignees")
 	estimate, _ := cmd.Flags().GetFloat64("estimate")
@@ -105,12 +163,9 @@ func runBulkCreate(cmd *cobra.Command, args []string) error {
 		description = content
 	}
 
-	workspace := cfg.PlaneWorkspace
-	if workspace == "" {
-		workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -122,32 +177,58 @@ func runBulkCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get project: %w", err)
 	}
 
+	if csvFile != "" {
+		return runBulkCreateCSV(client, projectID, project, csvFile, dryRun)
+	}
+
+	// Assignees may be given by email or name
+	if assignees, err = newMemberResolver(client, projectID).resolve(assignees); err != nil {
+		return err
+	}
+	// Labels may be given by name
+	createLabels, _ := cmd.Flags().GetBool("create-labels")
+	if labels, err = newLabelResolver(client, projectID, createLabels, dryRun).resolve(labels); err != nil {
+		return err
+	}
+
+	// Module may be given by name
+	if moduleID, err = resolveModule(client, projectID, moduleID); err != nil {
+		return err
+	}
+
 	// Collect titles
-	var titles []string
+	var titles []outlineEntry
+	// pageLink links each work item to the page its title comes from
+	pageLink := ""
 
-	if len(titlesFlag) > 0 && !forceInteractive {
+	if fromPage != "" && !forceInteractive {
+		page, err := client.GetPage(projectID, fromPage)
+		if err != nil {
+			return fmt.Errorf("failed to get page: %w", err)
+		}
+		titles, err = pageOutline(page, section, includeChecked)
+		if err != nil {
+			return err
+		}
+		u := projectWebURL(webAppURL(cfg), workspace, projectID, "pages", page.ID)
+		pageLink = fmt.Sprintf(`<p>From page: <a href="%s">%s</a></p>`, html.EscapeString(u), html.EscapeString(page.Name))
+	} else if len(titlesFlag) > 0 && !forceInteractive {
 		// Use titles from command line
-		titles = titlesFlag
+		titles = flatOutline(titlesFlag)
 	} else if titlesFile != "" && !forceInteractive {
-		// Read titles from file
+		// Read titles from file; indentation defines sub-items
 		content, err := readFileContent(titlesFile)
 		if err != nil {
 			return fmt.Errorf("failed to read titles file: %w", err)
 		}
-		// Split by lines and filter empty ones
-		lines := strings.Split(content, "\n")
-		for _, line := range lines {
-			line = strings.TrimSpace(line)
-			if line != "" {
-				titles = append(titles, line)
-			}
-		}
+		titles = parseOutline(content)
 	} else {
 		// Interactive mode - collect titles
-		titles, err = collectTitlesInteractive()
+		collected, err := collectTitlesInteractive()
 		if err != nil {
 			return err
 		}
+		titles = flatOutline(collected)
 	}
 
 	if len(titles) == 0 {
@@ -186,6 +267,12 @@ func runBulkCreate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Convert the state name to its UUID once, for every work item
+	stateID, err := resolveState(client, projectID, state)
+	if err != nil {
+		fmt.Printf("⚠️  Warning: Could not convert state '%s': %v\n", state, err)
+	}
+
 	// Parse priority
 	priority := plane.ParsePriority(priorityStr)
 
@@ -198,7 +285,10 @@ func runBulkCreate(cmd *cobra.Command, args []string) error {
 
 	fmt.Println("Titles:")
 	for i, title := range titles {
-		fmt.Printf("  %d. %s\n", i+1, title)
+		fmt.Printf("  %s%d. %s\n", strings.Repeat("   ", title.Depth), i+1, title.Title)
+	}
+	if subItems := countSubItems(titles); subItems > 0 {
+		fmt.Printf("\n  (%d sub-item(s) will be linked to their parent)\n", subItems)
 	}
 
 	fmt.Println("\nCommon attributes:")
@@ -243,28 +333,24 @@ func runBulkCreate(cmd *cobra.Command, args []string) error {
 	fmt.Printf("\n🔄 Creating %d work items...\n", len(titles))
 
 	successCount := 0
-	failCount := 0
+	failures := newBulkFailures("bulk-create --project " + projectID)
+	// failureIndex is the index in failures of each title that failed
+	failureIndex := make([]int, len(titles))
 	var createdItems []plane.WorkItem
+	createdIDs := make([]string, len(titles))
 
-	for _, title := range titles {
+	for i, entry := range titles {
+		title := entry.Title
 		create := &plane.WorkItemCreate{
-			Name:        title,
-			Description: description,
-			Priority:    plane.ParsePriorityString(priorityStr),
-			Assignees:   assignees,
-			Labels:      labels,
-			Module:      moduleID,
+			Name:            title,
+			DescriptionHTML: markdownToHTML(description) + pageLink,
+			Priority:        plane.ParsePriorityString(priorityStr),
+			Assignees:       assignees,
+			Labels:          labels,
+			Module:          moduleID,
 		}
 
-		// Convert state name to UUID if provided
-		if state != "" {
-			stateID, err := client.GetStateByName(projectID, state)
-			if err == nil {
-				create.State = stateID
-			} else {
-				fmt.Printf("  ⚠️  Warning: Could not convert state '%s': %v\n", state, err)
-			}
-		}
+		create.State = stateID
 
 		// Convert estimate to UUID
 		if estimate > 0 {
@@ -276,10 +362,21 @@ func runBulkCreate(cmd *cobra.Command, args []string) error {
 			}
 		}
 
+		// Sub-items need their parent; skip them when it failed
+		if entry.Parent >= 0 {
+			if createdIDs[entry.Parent] == "" {
+				fmt.Printf("  ❌ Skipped: %s - parent '%s' was not created\n", title, titles[entry.Parent].Title)
+				err := fmt.Errorf("parent '%s' was not created", titles[entry.Parent].Title)
+				failureIndex[i] = failures.add(bulkOpCreate, projectID, "", title, create, failureIndex[entry.Parent], err)
+				continue
+			}
+			create.Parent = createdIDs[entry.Parent]
+		}
+
 		workItem, err := client.CreateWorkItem(projectID, create)
 		if err != nil {
 			fmt.Printf("  ❌ Failed: %s - %v\n", title, err)
-			failCount++
+			failureIndex[i] = failures.add(bulkOpCreate, projectID, "", title, create, -1, err)
 		} else {
 			fmt.Printf("  ✅ Created: [%d] %s\n", workItem.SequenceID, title)
 
@@ -296,16 +393,17 @@ func runBulkCreate(cmd *cobra.Command, args []string) error {
 				}
 			}
 
+			createdIDs[i] = workItem.ID
 			createdItems = append(createdItems, *workItem)
 			successCount++
 		}
 	}
 
+	successCount += failures.retryTransient(client)
+
 	fmt.Println("\n" + strings.Repeat("=", 70))
 	fmt.Printf("✅ Completed: %d/%d work items created successfully\n", successCount, len(titles))
-	if failCount > 0 {
-		fmt.Printf("❌ Failed: %d work items\n", failCount)
-	}
+	failures.report(failedItemsFile)
 
 	// Show summary of created items
 	if len(createdItems) > 0 {
@@ -318,6 +416,108 @@ func runBulkCreate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// outlineEntry is a title to create; Parent is the index of its parent
+// entry, or -1 for top-level items
+type outlineEntry struct {
+	Title  string
+	Depth  int
+	Parent int
+}
+
+// flatOutline turns plain titles into top-level entries
+func flatOutline(titles []string) []outlineEntry {
+	entries := make([]outlineEntry, 0, len(titles))
+	for _, t := range titles {
+		if t = strings.TrimSpace(t); t != "" {
+			entries = append(entries, outlineEntry{Title: t, Parent: -1})
+		}
+	}
+	return entries
+}
+
+// parseOutline reads one title per line. A line indented deeper than the
+// line above is its sub-item; tabs count as four spaces and list markers
+// are dropped. Parents always come before their children.
+func parseOutline(content string) []outlineEntry {
+	type level struct{ indent, index int }
+
+	var entries []outlineEntry
+	var stack []level
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		title := strings.TrimLeft(line, " \t")
+		if title == "" {
+			continue
+		}
+		indent := 0
+		for _, r := range line[:len(line)-len(title)] {
+			if r == '\t' {
+				indent += 4
+			} else {
+				indent++
+			}
+		}
+		for _, marker := range []string{"- ", "* ", "+ "} {
+			if strings.HasPrefix(title, marker) {
+				title = strings.TrimSpace(title[len(marker):])
+				break
+			}
+		}
+		if title == "" {
+			continue
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		parent := -1
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1].index
+		}
+		entries = append(entries, outlineEntry{Title: title, Depth: len(stack), Parent: parent})
+		stack = append(stack, level{indent: indent, index: len(entries) - 1})
+	}
+	return entries
+}
+
+// pageOutline returns the list items of a page as entries, limited to the
+// list under section when it isn't empty. Indented items become sub-items
+// of the item above them; checked items are left out unless includeChecked
+// is set, and their sub-items move up to the closest kept item.
+func pageOutline(page *plane.Page, section string, includeChecked bool) ([]outlineEntry, error) {
+	content := page.Description
+	if page.DescriptionHTML != "" {
+		content = markdown.FromHTML(page.DescriptionHTML)
+	}
+
+	items, found := parseSectionList(strings.Split(content, "\n"), section, listLine)
+	if section != "" && !found {
+		return nil, fmt.Errorf("page '%s' has no section '%s'", page.Name, section)
+	}
+
+	var lines []string
+	for _, item := range items {
+		if !item.Checked || includeChecked {
+			lines = append(lines, item.Indent+item.Title)
+		}
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("page '%s' has no list items to create", page.Name)
+	}
+	return parseOutline(strings.Join(lines, "\n")), nil
+}
+
+// countSubItems returns the number of entries that have a parent
+func countSubItems(entries []outlineEntry) int {
+	n := 0
+	for _, e := range entries {
+		if e.Parent >= 0 {
+			n++
+		}
+	}
+	return n
+}
+
 func collectTitlesInteractive() ([]string, error) {
 	fmt.Println("\n📝 Enter Work Item Titles")
 	fmt.Println(strings.Repeat("-", 70))
@@ -509,12 +709,12 @@ func selectCommonAttributes(client *plane.Client, projectID string, needAssignee
 			}
 
 		case "state":
-			state, err := selectState()
+			state, err := selectState(client, projectID)
 			if err != nil {
 				continue
 			}
-			attrs.State = state
-			fmt.Printf("✓ State set to: %s\n", state)
+			attrs.State = state.Name
+			fmt.Printf("✓ State set to: %s\n", state.Name)
 
 		case "priority":
 			priority, err := selectPriority()
@@ -545,7 +745,7 @@ func selectCommonAttributes(client *plane.Client, projectID string, needAssignee
 				fmt.Printf("✓ Description set (%d chars)\n", len(text))
 
 			case 1: // File
-				path, err := input("Enter file path:")
+				path, err := inputPath("Enter file path:")
 				if err != nil {
 					continue
 				}
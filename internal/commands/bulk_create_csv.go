@@ -0,0 +1,342 @@
+package commands
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"plane-cli/internal/plane"
+)
+
+// bulkCSVColumns maps accepted bulk-create CSV headers to canonical columns
+var bulkCSVColumns = map[string]string{
+	"title":       "title",
+	"name":        "title",
+	"description": "description",
+	"state":       "state",
+	"status":      "state",
+	"priority":    "priority",
+	"assignees":   "assignees",
+	"assignee":    "assignees",
+	"labels":      "labels",
+	"label":       "labels",
+	"module":      "module",
+	"estimate":    "estimate",
+	"points":      "estimate",
+}
+
+// bulkCSVRow is a CSV row resolved to a work item, or the reasons it can't be
+type bulkCSVRow struct {
+	Line   int
+	Cells  map[string]string
+	Create *plane.WorkItemCreate
+	Errors []string
+}
+
+// bulkCSVLookup holds the project metadata CSV values are resolved against
+type bulkCSVLookup struct {
+	states  []plane.State
+	labels  *labelResolver
+	members []plane.Member
+	modules []plane.Module
+	points  map[string]float64
+}
+
+// runBulkCreateCSV creates one work item per row of a CSV file
+func runBulkCreateCSV(client *plane.Client, projectID string, project *plane.Project, file string, dryRun bool) error {
+	rows, err := readBulkCSV(file)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("%s has no rows", file)
+	}
+
+	lookup, err := loadBulkCSVLookup(client, projectID, rows)
+	if err != nil {
+		return err
+	}
+
+	valid := 0
+	for _, row := range rows {
+		lookup.resolve(row)
+		if len(row.Errors) == 0 {
+			valid++
+		}
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Println("                    📋 BULK CREATE PREVIEW")
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("Project: %s (%s)\n", project.Name, project.Identifier)
+	fmt.Printf("Rows in %s: %d (%d valid)\n\n", file, len(rows), valid)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LINE\tTITLE\tSTATE\tPRIORITY\tASSIGNEES\tLABELS\tMODULE\tESTIMATE\t")
+	for _, row := range rows {
+		status := "✅"
+		if len(row.Errors) > 0 {
+			status = "❌"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", row.Line, truncate(row.Cells["title"], 40),
+			emptyAsDash(row.Cells["state"]), emptyAsDash(row.Cells["priority"]), emptyAsDash(row.Cells["assignees"]),
+			emptyAsDash(row.Cells["labels"]), emptyAsDash(row.Cells["module"]), emptyAsDash(row.Cells["estimate"]), status)
+	}
+	w.Flush()
+
+	if valid < len(rows) {
+		fmt.Println("\nRow errors:")
+		for _, row := range rows {
+			for _, e := range row.Errors {
+				fmt.Printf("  ❌ Line %d: %s\n", row.Line, e)
+			}
+		}
+	}
+	fmt.Println(strings.Repeat("=", 70))
+
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no work items created.")
+		return nil
+	}
+	if valid == 0 {
+		return fmt.Errorf("no valid rows to create")
+	}
+
+	message := fmt.Sprintf("\nCreate %d work items?", valid)
+	if valid < len(rows) {
+		message = fmt.Sprintf("\nCreate %d work items, skipping %d row(s) with errors?", valid, len(rows)-valid)
+	}
+	confirmed, err := confirm(message)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("\n❌ Creation cancelled.")
+		return nil
+	}
+
+	fmt.Printf("\n🔄 Creating %d work items...\n", valid)
+	successCount := 0
+	failures := newBulkFailures("bulk-create --project " + projectID)
+	for _, row := range rows {
+		if len(row.Errors) > 0 {
+			continue
+		}
+		workItem, err := client.CreateWorkItem(projectID, row.Create)
+		if err != nil {
+			fmt.Printf("  ❌ Line %d: %s - %v\n", row.Line, row.Create.Name, err)
+			failures.add(bulkOpCreate, projectID, "", row.Create.Name, row.Create, -1, err)
+			continue
+		}
+		fmt.Printf("  ✅ Line %d: %s-%d %s\n", row.Line, project.Identifier, workItem.SequenceID, row.Create.Name)
+		successCount++
+
+		// The module may not apply during creation, as in bulk-create
+		if row.Create.Module != "" && workItem.ModuleID == "" {
+			if _, err := client.UpdateWorkItem(projectID, workItem.ID, &plane.WorkItemUpdate{Module: row.Create.Module}); err != nil {
+				fmt.Printf("  ⚠️  Warning: Created but couldn't set module: %v\n", err)
+			}
+		}
+	}
+
+	successCount += failures.retryTransient(client)
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Printf("✅ Completed: %d/%d work items created successfully\n", successCount, valid)
+	if skipped := len(rows) - valid; skipped > 0 {
+		fmt.Printf("⚠️  Skipped: %d row(s) with errors\n", skipped)
+	}
+	failures.report(failedItemsFile)
+	if successCount < valid {
+		return fmt.Errorf("%d work item(s) could not be created", valid-successCount)
+	}
+	return nil
+}
+
+// readBulkCSV reads the rows of a bulk-create CSV file. Unknown columns
+// and a missing title column are errors.
+func readBulkCSV(path string) ([]*bulkCSVRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CSV file: %w", err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	columns := make([]string, len(header))
+	var unknown []string
+	for i, h := range header {
+		column, ok := bulkCSVColumns[normalizeCSVHeader(h)]
+		if !ok {
+			unknown = append(unknown, h)
+			continue
+		}
+		if slices.Contains(columns, column) {
+			return nil, fmt.Errorf("CSV header has more than one %s column", column)
+		}
+		columns[i] = column
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown CSV column(s): %s (use title, description, state, priority, assignees, labels, module, estimate)", strings.Join(unknown, ", "))
+	}
+	if !slices.Contains(columns, "title") {
+		return nil, fmt.Errorf("CSV header has no title column")
+	}
+
+	var rows []*bulkCSVRow
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV line %d: %w", line, err)
+		}
+
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		row := &bulkCSVRow{Line: line, Cells: make(map[string]string)}
+		for i, value := range record {
+			if i < len(columns) {
+				row.Cells[columns[i]] = strings.TrimSpace(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// loadBulkCSVLookup fetches the metadata the CSV columns refer to
+func loadBulkCSVLookup(client *plane.Client, projectID string, rows []*bulkCSVRow) (*bulkCSVLookup, error) {
+	used := func(column string) bool {
+		return slices.ContainsFunc(rows, func(r *bulkCSVRow) bool { return r.Cells[column] != "" })
+	}
+
+	// Labels are only fetched when a row names one
+	lookup := &bulkCSVLookup{labels: newLabelResolver(client, projectID, false, false)}
+	var err error
+	if used("state") {
+		if lookup.states, err = client.GetProjectStates(projectID); err != nil {
+			return nil, fmt.Errorf("failed to get states: %w", err)
+		}
+	}
+	if used("assignees") {
+		if lookup.members, err = client.GetProjectMembers(projectID); err != nil {
+			if lookup.members, err = client.GetWorkspaceMembers(); err != nil {
+				return nil, fmt.Errorf("failed to get members: %w", err)
+			}
+		}
+	}
+	if used("module") {
+		if lookup.modules, err = client.GetModules(projectID); err != nil {
+			return nil, fmt.Errorf("failed to get modules: %w", err)
+		}
+	}
+	if used("estimate") {
+		if lookup.points, err = estimatePoints(client, projectID); err != nil {
+			return nil, fmt.Errorf("failed to get estimates: %w", err)
+		}
+	}
+	return lookup, nil
+}
+
+// resolve builds the work item of a row, recording every value that can't
+// be resolved
+func (l *bulkCSVLookup) resolve(row *bulkCSVRow) {
+	c := row.Cells
+	create := &plane.WorkItemCreate{Name: c["title"], DescriptionHTML: markdownToHTML(c["description"])}
+	fail := func(format string, args ...any) {
+		row.Errors = append(row.Errors, fmt.Sprintf(format, args...))
+	}
+
+	if create.Name == "" {
+		fail("title is empty")
+	}
+
+	if c["state"] != "" {
+		if id, err := stateIDByName(l.states, c["state"]); err != nil {
+			fail("%v", err)
+		} else {
+			create.State = id
+		}
+	}
+
+	if p := strings.ToLower(c["priority"]); p != "" {
+		if !slices.Contains(validPriorities, p) {
+			fail("invalid priority '%s' (use %s)", c["priority"], strings.Join(validPriorities, ", "))
+		} else {
+			create.Priority = p
+		}
+	}
+
+	for _, email := range splitList(c["assignees"]) {
+		if m, err := matchMember(l.members, email); err == nil {
+			create.Assignees = append(create.Assignees, m.ID)
+		} else {
+			fail("%v", err)
+		}
+	}
+
+	for _, name := range splitList(c["labels"]) {
+		ids, err := l.labels.resolve([]string{name})
+		if err != nil {
+			fail("%v", err)
+			continue
+		}
+		create.Labels = append(create.Labels, ids...)
+	}
+
+	if c["module"] != "" {
+		i := slices.IndexFunc(l.modules, func(m plane.Module) bool { return m.ID == c["module"] || strings.EqualFold(m.Name, c["module"]) })
+		if i < 0 {
+			fail("module '%s' not found", c["module"])
+		} else {
+			create.Module = l.modules[i].ID
+		}
+	}
+
+	if c["estimate"] != "" {
+		value, err := strconv.ParseFloat(c["estimate"], 64)
+		if err != nil {
+			fail("invalid estimate '%s'", c["estimate"])
+		} else {
+			for id, v := range l.points {
+				if v == value {
+					create.EstimatePoint = id
+				}
+			}
+			if create.EstimatePoint == "" {
+				fail("no estimate point with value %s", c["estimate"])
+			}
+		}
+	}
+
+	row.Create = create
+}
+
+// splitList splits a comma or semicolon separated cell
+func splitList(s string) []string {
+	var values []string
+	for _, v := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' }) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,355 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+// Classes of bulk operation failures
+const (
+	failureAuth       = "auth"
+	failureValidation = "validation"
+	failureRateLimit  = "rate-limit"
+	failureNetwork    = "network"
+	failureOther      = "other"
+	// failureSkipped is a sub-item not attempted because its parent failed
+	failureSkipped = "skipped"
+)
+
+// failureClasses is the order classes are summarized in
+var failureClasses = []string{failureAuth, failureValidation, failureRateLimit, failureNetwork, failureOther, failureSkipped}
+
+// failedItemsFile is where bulk commands save the items they couldn't
+// create or update, for --retry-file
+const failedItemsFile = "failed-items.json"
+
+// Operations of failed bulk items
+const (
+	bulkOpCreate = "create"
+	bulkOpUpdate = "update"
+)
+
+// classifyFailure sorts an error into a failure class: rejected
+// credentials, a request the API refused, the rate limit, or a server or
+// connection problem
+func classifyFailure(err error) string {
+	var netErr net.Error
+	switch status := plane.StatusCode(err); {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return failureAuth
+	case status == http.StatusTooManyRequests:
+		return failureRateLimit
+	case status >= 500:
+		return failureNetwork
+	case status >= 400:
+		return failureValidation
+	case errors.As(err, &netErr):
+		return failureNetwork
+	}
+	return failureOther
+}
+
+// retryableFailure reports whether a failure class may succeed when the
+// same request is simply sent again
+func retryableFailure(class string) bool {
+	return class == failureRateLimit || class == failureNetwork
+}
+
+// bulkFailure is a work item a bulk command couldn't create or update, with
+// the request to send again
+type bulkFailure struct {
+	Op        string `json:"op"`
+	ProjectID string `json:"project_id"`
+	ItemID    string `json:"item_id,omitempty"`
+	Name      string `json:"name"`
+	// Parent is the index of the failed item creating this sub-item's
+	// parent
+	Parent  *int            `json:"parent,omitempty"`
+	Class   string          `json:"class"`
+	Error   string          `json:"error"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// bulkFailures collects the failures of a bulk run; it is the content of
+// failed-items.json
+type bulkFailures struct {
+	Command   string        `json:"command"`
+	CreatedAt time.Time     `json:"created_at"`
+	Items     []bulkFailure `json:"items"`
+}
+
+func newBulkFailures(command string) *bulkFailures {
+	return &bulkFailures{Command: command, CreatedAt: time.Now()}
+}
+
+// add records a failed create or update and returns its index. parent is
+// the index of the failure creating the parent of a sub-item, or -1; such
+// a sub-item failing for no API reason was skipped.
+func (f *bulkFailures) add(op, projectID, itemID, name string, payload any, parent int, err error) int {
+	data, _ := json.Marshal(payload)
+	failure := bulkFailure{
+		Op:        op,
+		ProjectID: projectID,
+		ItemID:    itemID,
+		Name:      name,
+		Class:     classifyFailure(err),
+		Error:     err.Error(),
+		Payload:   data,
+	}
+	if parent >= 0 {
+		failure.Parent = &parent
+		if failure.Class == failureOther {
+			failure.Class = failureSkipped
+		}
+	}
+	f.Items = append(f.Items, failure)
+	return len(f.Items) - 1
+}
+
+// apply sends the request of a failed item again and returns the ID of the
+// work item created or updated
+func (b *bulkFailure) apply(client *plane.Client, parentID string) (string, error) {
+	switch b.Op {
+	case bulkOpCreate:
+		var create plane.WorkItemCreate
+		if err := json.Unmarshal(b.Payload, &create); err != nil {
+			return "", fmt.Errorf("invalid payload: %w", err)
+		}
+		if parentID != "" {
+			create.Parent = parentID
+		}
+		item, err := client.CreateWorkItem(b.ProjectID, &create)
+		if err != nil {
+			return "", err
+		}
+		// The module may not apply during creation, as in bulk-create
+		if create.Module != "" && item.ModuleID == "" {
+			if _, err := client.UpdateWorkItem(b.ProjectID, item.ID, &plane.WorkItemUpdate{Module: create.Module}); err != nil {
+				fmt.Printf("  ⚠️  Warning: Created but couldn't set module: %v\n", err)
+			}
+		}
+		return item.ID, nil
+	case bulkOpUpdate:
+		var update plane.WorkItemUpdate
+		if err := json.Unmarshal(b.Payload, &update); err != nil {
+			return "", fmt.Errorf("invalid payload: %w", err)
+		}
+		if _, err := client.UpdateWorkItem(b.ProjectID, b.ItemID, &update); err != nil {
+			return "", err
+		}
+		return b.ItemID, nil
+	}
+	return "", fmt.Errorf("unknown operation '%s'", b.Op)
+}
+
+// retry sends the failed requests again, in order: all of them, or only
+// those whose class is retryable. Sub-items are attempted once their parent
+// is created. Items that succeed are removed; the number is returned.
+func (f *bulkFailures) retry(client *plane.Client, all bool) int {
+	done := make([]bool, len(f.Items))
+	created := make([]string, len(f.Items))
+	succeeded := 0
+	for i := range f.Items {
+		item := &f.Items[i]
+		if item.Parent != nil && !done[*item.Parent] {
+			continue
+		}
+		if !all && !retryableFailure(item.Class) && item.Class != failureSkipped {
+			continue
+		}
+
+		parentID := ""
+		if item.Parent != nil {
+			parentID = created[*item.Parent]
+		}
+		id, err := item.apply(client, parentID)
+		if err != nil {
+			item.Class = classifyFailure(err)
+			item.Error = err.Error()
+			fmt.Printf("  ❌ Failed again: %s - %v\n", item.Name, err)
+			continue
+		}
+		fmt.Printf("  ✅ Retried: %s\n", item.Name)
+		done[i] = true
+		created[i] = id
+		succeeded++
+	}
+
+	// Keep what still failed, renumbering the parents
+	index := make([]int, len(f.Items))
+	var remaining []bulkFailure
+	for i, item := range f.Items {
+		if done[i] {
+			continue
+		}
+		index[i] = len(remaining)
+		if item.Parent != nil {
+			if done[*item.Parent] {
+				// The parent exists now; name it in the payload
+				item.Payload = withParent(item.Payload, created[*item.Parent])
+				item.Parent = nil
+			} else {
+				p := index[*item.Parent]
+				item.Parent = &p
+			}
+		}
+		remaining = append(remaining, item)
+	}
+	f.Items = remaining
+	return succeeded
+}
+
+// withParent sets the parent of a create payload
+func withParent(payload json.RawMessage, parentID string) json.RawMessage {
+	var create plane.WorkItemCreate
+	if json.Unmarshal(payload, &create) != nil {
+		return payload
+	}
+	create.Parent = parentID
+	data, err := json.Marshal(create)
+	if err != nil {
+		return payload
+	}
+	return data
+}
+
+// retryTransient retries the failures that may succeed on a second attempt,
+// at the end of a bulk run, and returns how many did
+func (f *bulkFailures) retryTransient(client *plane.Client) int {
+	retryable := 0
+	for _, item := range f.Items {
+		if retryableFailure(item.Class) {
+			retryable++
+		}
+	}
+	if retryable == 0 {
+		return 0
+	}
+	fmt.Printf("\n🔁 Retrying %d work item(s) that failed with rate-limit or network errors...\n", retryable)
+	return f.retry(client, false)
+}
+
+// summary counts the failures by class, e.g. "auth: 1, validation: 2"
+func (f *bulkFailures) summary() string {
+	counts := make(map[string]int)
+	for _, item := range f.Items {
+		counts[item.Class]++
+	}
+	var parts []string
+	for _, class := range failureClasses {
+		if counts[class] > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %d", class, counts[class]))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// report prints the failures left by class and saves them to path for
+// --retry-file. Nothing is printed when every item succeeded.
+func (f *bulkFailures) report(path string) {
+	if len(f.Items) == 0 {
+		return
+	}
+	fmt.Printf("❌ Failed: %d work items (%s)\n", len(f.Items), f.summary())
+	if err := f.save(path); err != nil {
+		fmt.Printf("⚠️  Warning: %v\n", err)
+		return
+	}
+	fmt.Printf("💾 Failed items saved to %s\n", path)
+	fmt.Printf("   Retry them with: plane-cli %s --retry-file %s\n", f.Command, path)
+}
+
+// save writes the failures as JSON
+func (f *bulkFailures) save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode failed items: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// loadBulkFailures reads a failed-items file
+func loadBulkFailures(path string) (*bulkFailures, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read retry file: %w", err)
+	}
+	var f bulkFailures
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("invalid retry file %s: %w", path, err)
+	}
+	for i, item := range f.Items {
+		if item.Parent != nil && (*item.Parent < 0 || *item.Parent >= i) {
+			return nil, fmt.Errorf("invalid retry file %s: item %d has an invalid parent", path, i+1)
+		}
+	}
+	return &f, nil
+}
+
+// runBulkRetryFile sends the requests saved in a failed-items file again,
+// whatever their class, and saves those failing again back to the file
+func runBulkRetryFile(cmd *cobra.Command, path string, dryRun, skipConfirm bool) error {
+	failures, err := loadBulkFailures(path)
+	if err != nil {
+		return err
+	}
+	if len(failures.Items) == 0 {
+		fmt.Printf("✅ Nothing to retry in %s\n", path)
+		return nil
+	}
+
+	fmt.Printf("\n🔁 Retry Preview:\n")
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("File: %s (%s, saved %s)\n", path, failures.Command, failures.CreatedAt.Local().Format("2006-01-02 15:04"))
+	fmt.Printf("Work items: %d (%s)\n\n", len(failures.Items), failures.summary())
+	for _, item := range failures.Items {
+		fmt.Printf("  • %s %s [%s] %s\n", item.Op, truncate(item.Name, 40), item.Class, truncate(item.Error, 60))
+	}
+	fmt.Println(strings.Repeat("=", 70))
+
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no changes made.")
+		return nil
+	}
+	if !skipConfirm {
+		confirmed, err := confirm(fmt.Sprintf("\nRetry these %d work items?", len(failures.Items)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("\n❌ Retry cancelled.")
+			return nil
+		}
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	total := len(failures.Items)
+	fmt.Printf("\n🔄 Retrying %d work items...\n\n", total)
+	succeeded := failures.retry(client, true)
+	succeeded += failures.retryTransient(client)
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Printf("✅ Completed: %d/%d work items succeeded\n", succeeded, total)
+	if len(failures.Items) == 0 {
+		fmt.Printf("All items in %s are done; the file can be removed.\n", path)
+		return nil
+	}
+	failures.report(path)
+	return nil
+}
@@ -2,12 +2,13 @@ package commands
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"plane-cli/internal/config"
-	"plane-cli/internal/fuzzy"
 	"plane-cli/internal/plane"
+	"plane-cli/internal/query"
 )
 
 var bulkUpdateCmd = &cobra.Command{
@@ -28,10 +29,37 @@ Examples:
   plane-cli bulk-update --project c20fcc54-c675-47c4-85db-a4acdde3c9e1
 
   # Bulk update by search pattern
-  plane-cli bulk-update --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 --search "BE" --assignees user-id-1,user-id-2
+  plane-cli bulk-update --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 --search "BE" --assignees jane@example.com,john@example.com
+
+  # Bulk update specific work items by sequence number, without prompting
+  plane-cli bulk-update --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 --ids 12,15,21 --priority high --yes
 
   # Bulk update with confirmation
-  plane-cli bulk-update --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 --search "SaaS" --state "In Progress" --dry-run`,
+  plane-cli bulk-update --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 --search "SaaS" --state "In Progress" --dry-run
+
+  # Select by current values: all unassigned urgent backlog items
+  plane-cli bulk-update --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 --where state=Backlog --where priority=urgent --where assignee=none --assignees jane@example.com
+
+  # Retry the work items a previous run couldn't update
+  plane-cli bulk-update --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 --retry-file failed-items.json
+
+--where takes any field of the query language ('plane-cli list --help'),
+e.g. state, priority, label, assignee (an email, me or none), module,
+cycle or due, with = or != (and < > for priorities and dates). Every
+condition must match. Without --search, --ids or --interactive all matching
+items are selected; otherwise the selection is made among them.
+
+--search may mix the same filters, written field:value, with the words
+matched against titles: --search 'assignee:me group:started login' only
+matches open items assigned to you. Filters apply before matching.
+
+Work items picked by hand on a terminal are chosen in a live picker: they
+are listed as they load, narrow down as you type and are marked with TAB.
+With --interactive, --search is typed into the picker in advance.
+
+Failures are counted by class (auth, validation, rate-limit, network);
+rate-limit and network failures are retried at the end of the run, and
+what still fails is saved to failed-items.json for --retry-file.`,
 	RunE: runBulkUpdate,
 }
 
@@ -39,26 +67,31 @@ func init() {
 	rootCmd.AddCommand(bulkUpdateCmd)
 
 	// Required flags
-	bulkUpdateCmd.Flags().String("project", "", "Project identifier (required)")
+	bulkUpdateCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	bulkUpdateCmd.MarkFlagRequired("project")
 
 	// Search/Selection flags
 	bulkUpdateCmd.Flags().String("search", "", "Search term to find work items (if not provided, uses interactive selection)")
 	bulkUpdateCmd.Flags().Int("min-score", 60, "Minimum fuzzy match score (0-100)")
+	bulkUpdateCmd.Flags().StringSlice("ids", nil, "Work item sequence numbers or IDs to update (comma-separated)")
+	bulkUpdateCmd.Flags().StringArray("where", nil, "Only work items whose current value matches, e.g. state=Backlog (repeatable)")
 
 	// Update flags
-	bulkUpdateCmd.Flags().StringSlice("assignees", nil, "Assignee user IDs (comma-separated)")
+	bulkUpdateCmd.Flags().StringSlice("assignees", nil, "Assignees: member IDs, emails, names or me (comma-separated)")
 	bulkUpdateCmd.Flags().Bool("replace-assignees", false, "Replace existing assignees instead of adding")
 	bulkUpdateCmd.Flags().Float64("estimate", -1, "Estimate points (use -1 to skip)")
-	bulkUpdateCmd.Flags().StringSlice("labels", nil, "Label IDs (comma-separated)")
+	bulkUpdateCmd.Flags().StringSlice("labels", nil, "Labels: IDs or names (comma-separated)")
+	bulkUpdateCmd.Flags().Bool("create-labels", false, "Create labels given by name that don't exist yet")
 	bulkUpdateCmd.Flags().Bool("replace-labels", false, "Replace existing labels instead of adding")
-	bulkUpdateCmd.Flags().String("module", "", "Module ID")
+	bulkUpdateCmd.Flags().String("module", "", "Module ID or name")
 	bulkUpdateCmd.Flags().String("state", "", "State name")
 	bulkUpdateCmd.Flags().String("priority", "", "Priority (urgent, high, medium, low)")
 
 	// Behavior flags
 	bulkUpdateCmd.Flags().Bool("dry-run", false, "Preview changes without applying")
 	bulkUpdateCmd.Flags().Bool("interactive", false, "Force interactive mode even with flags")
+	bulkUpdateCmd.Flags().Bool("yes", false, "Apply without asking for confirmation")
+	bulkUpdateCmd.Flags().String("retry-file", "", "Retry the work items saved in a failed-items.json file")
 }
 
 func runBulkUpdate(cmd *cobra.Command, args []string) error {
@@ -72,6 +105,9 @@ func runBulkUpdate(cmd *cobra.Command, args []string) error {
 	minScore, _ := cmd.Flags().GetInt("min-score")
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
 	forceInteractive, _ := cmd.Flags().GetBool("interactive")
+	ids, _ := cmd.Flags().GetStringSlice("ids")
+	skipConfirm, _ := cmd.Flags().GetBool("yes")
+	wheres, _ := cmd.Flags().GetStringArray("where")
 
 	// Get update values from flags
 	assignees, _ := cmd.Flags().GetStringSlice("assignees")
@@ -83,70 +119,129 @@ func runBulkUpdate(cmd *cobra.Command, args []string) error {
 	state, _ := cmd.Flags().GetString("state")
 	priorityStr, _ := cmd.Flags().GetString("priority")
 
-	workspace := cfg.PlaneWorkspace
-	if workspace == "" {
-		workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
+	if retryFile, _ := cmd.Flags().GetString("retry-file"); retryFile != "" {
+		return runBulkRetryFile(cmd, retryFile, dryRun, skipConfirm)
 	}
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	workspace := resolveWorkspace(cmd, cfg)
+
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 	client.SetWorkspace(workspace)
 
-	// Fetch all work items
-	fmt.Printf("📥 Fetching work items from project '%s'...\n", projectID)
-	allWorkItems, err := fetchAllWorkItemsForProject(client, projectID)
-	if err != nil {
-		return fmt.Errorf("failed to fetch work items: %w", err)
+	// Assignees may be given by email or name
+	if assignees, err = newMemberResolver(client, projectID).resolve(assignees); err != nil {
+		return err
+	}
+	// Labels may be given by name
+	createLabels, _ := cmd.Flags().GetBool("create-labels")
+	if labels, err = newLabelResolver(client, projectID, createLabels, dryRun).resolve(labels); err != nil {
+		return err
 	}
 
-	if len(allWorkItems) == 0 {
-		return fmt.Errorf("no work items found in this project")
+	// Module and state may be given by name
+	if moduleID, err = resolveModule(client, projectID, moduleID); err != nil {
+		return err
+	}
+	if state, err = resolveState(client, projectID, state); err != nil {
+		return err
+	}
+
+	var where *query.Query
+	if len(wheres) > 0 {
+		if where, err = query.ParseConditions(wheres); err != nil {
+			return fmt.Errorf("invalid --where: %w", err)
+		}
+	}
+
+	// Work items picked by hand on a terminal are streamed into the live
+	// picker instead of being fetched first
+	pickLive := len(ids) == 0 && (forceInteractive || (searchTerm == "" && where == nil)) && pickerAvailable()
+
+	var allWorkItems []plane.WorkItem
+	if !pickLive {
+		fmt.Printf("📥 Fetching work items from project '%s'...\n", projectID)
+		if allWorkItems, err = fetchAllWorkItemsForProject(client, projectID); err != nil {
+			return fmt.Errorf("failed to fetch work items: %w", err)
+		}
+
+		if len(allWorkItems) == 0 {
+			return fmt.Errorf("no work items found in this project")
+		}
+
+		if where != nil {
+			matching, err := filterWorkItems(client, projectID, where, allWorkItems)
+			if err != nil {
+				return err
+			}
+			if len(matching) == 0 {
+				return fmt.Errorf("no work items match %s", strings.Join(wheres, ", "))
+			}
+			fmt.Printf("✓ %d of %d work items match %s\n", len(matching), len(allWorkItems), strings.Join(wheres, ", "))
+			allWorkItems = matching
+		}
 	}
 
 	// Select work items to update
 	var selectedWorkItems []plane.WorkItem
+	selectedInteractively := false
 
-	if searchTerm != "" && !forceInteractive {
-		// Use search pattern
+	if len(ids) > 0 {
+		selectedWorkItems, err = selectWorkItemsByIDs(allWorkItems, ids)
+		if err != nil {
+			if where != nil {
+				return fmt.Errorf("%w or doesn't match --where", err)
+			}
+			return err
+		}
+	} else if searchTerm != "" && !forceInteractive {
+		// Use search pattern; filter terms in it narrow the candidates first
 		fmt.Printf("🔍 Searching for work items matching '%s'...\n", searchTerm)
-		titles := make([]string, len(allWorkItems))
-		for i, item := range allWorkItems {
-			titles[i] = item.Name
-		}
-
-		matcher := fuzzy.NewMatcher(minScore)
-		matches := matcher.FindMatches(searchTerm, titles)
-
-		// Fallback to substring matching
-		if len(matches) == 0 {
-			searchLower := strings.ToLower(searchTerm)
-			for i, title := range titles {
-				if strings.Contains(strings.ToLower(title), searchLower) {
-					matches = append(matches, fuzzy.MatchResult{
-						Index: i,
-						Score: 50,
-					})
-				}
+		filters, text, err := splitSearch(searchTerm, nil)
+		if err != nil {
+			fmt.Printf("⚠️  Matching titles only: %v\n", err)
+		}
+		candidates := allWorkItems
+		if filters != nil {
+			if candidates, err = filterWorkItems(client, projectID, filters, allWorkItems); err != nil {
+				return err
 			}
 		}
 
-		if len(matches) == 0 {
-			return fmt.Errorf("no work items found matching '%s'", searchTerm)
+		if text == "" {
+			selectedWorkItems = candidates
+		} else {
+			titles := make([]string, len(candidates))
+			for i, item := range candidates {
+				titles[i] = item.Name
+			}
+			for _, match := range matchTitles(text, titles, minScore) {
+				selectedWorkItems = append(selectedWorkItems, candidates[match.Index])
+			}
 		}
-
-		for _, match := range matches {
-			selectedWorkItems = append(selectedWorkItems, allWorkItems[match.Index])
+		if len(selectedWorkItems) == 0 {
+			return fmt.Errorf("no work items found matching '%s'", searchTerm)
 		}
 
 		fmt.Printf("✓ Found %d matching work items\n", len(selectedWorkItems))
+	} else if where != nil && !forceInteractive {
+		selectedWorkItems = allWorkItems
+	} else if pickLive {
+		picker := itemPicker{Prompt: "⚡ Select work items to update", Multi: true, Query: searchTerm}
+		if selectedWorkItems, err = pickProjectWorkItems(client, projectID, where, picker); err != nil {
+			return err
+		}
+		fmt.Printf("✓ Selected %d work items\n", len(selectedWorkItems))
+		selectedInteractively = true
 	} else {
 		// Interactive selection
-		selectedWorkItems, err = selectMultipleWorkItemsInteractive(allWorkItems)
+		selectedWorkItems, err = selectMultipleWorkItemsInteractive(newChipResolver(client, projectID), allWorkItems)
 		if err != nil {
 			return err
 		}
+		selectedInteractively = true
 	}
 
 	if len(selectedWorkItems) == 0 {
@@ -269,42 +364,98 @@ func runBulkUpdate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Confirm
-	confirmed, err := confirm("\nApply these updates to all selected work items?")
-	if err != nil {
-		return err
-	}
-	if !confirmed {
-		fmt.Println("\n❌ Update cancelled.")
-		return nil
+	if !skipConfirm {
+		confirmed, err := confirm("\nApply these updates to all selected work items?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("\n❌ Update cancelled.")
+			return nil
+		}
 	}
 
 	// Apply updates
 	fmt.Printf("\n🔄 Updating %d work items...\n\n", len(selectedWorkItems))
 
 	successCount := 0
-	failCount := 0
+	failures := newBulkFailures("bulk-update --project " + projectID)
 
 	for _, item := range selectedWorkItems {
 		_, err := client.UpdateWorkItem(projectID, item.ID, update)
 		if err != nil {
 			fmt.Printf("  ❌ Failed: [%d] %s - %v\n", item.SequenceID, truncate(item.Name, 40), err)
-			failCount++
+			failures.add(bulkOpUpdate, projectID, item.ID, fmt.Sprintf("[%d] %s", item.SequenceID, item.Name), update, -1, err)
 		} else {
 			fmt.Printf("  ✅ Updated: [%d] %s\n", item.SequenceID, truncate(item.Name, 40))
 			successCount++
 		}
 	}
+	successCount += failures.retryTransient(client)
 
 	fmt.Printf("\n%s\n", strings.Repeat("-", 70))
 	fmt.Printf("✅ Completed: %d/%d work items updated successfully\n", successCount, len(selectedWorkItems))
-	if failCount > 0 {
-		fmt.Printf("❌ Failed: %d work items\n", failCount)
+	failures.report(failedItemsFile)
+
+	if selectedInteractively || forceInteractive {
+		offerSavedCommand(bulkUpdateCommandArgs(projectID, selectedWorkItems, update))
 	}
 
 	return nil
 }
 
-func selectMultipleWorkItemsInteractive(workItems []plane.WorkItem) ([]plane.WorkItem, error) {
+// selectWorkItemsByIDs picks work items by sequence number or ID
+func selectWorkItemsByIDs(workItems []plane.WorkItem, ids []string) ([]plane.WorkItem, error) {
+	var selected []plane.WorkItem
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		found := false
+		for _, item := range workItems {
+			if item.ID == id || strconv.Itoa(item.SequenceID) == id {
+				selected = append(selected, item)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("work item '%s' not found in this project", id)
+		}
+	}
+	return selected, nil
+}
+
+// bulkUpdateCommandArgs builds the bulk-update arguments that reproduce an
+// update chosen interactively. Assignees and labels were already merged
+// with the existing values, so they are replayed with --replace-*.
+func bulkUpdateCommandArgs(projectID string, workItems []plane.WorkItem, update *plane.WorkItemUpdate) []string {
+	ids := make([]string, len(workItems))
+	for i, item := range workItems {
+		ids[i] = strconv.Itoa(item.SequenceID)
+	}
+
+	args := []string{"bulk-update", "--project", projectID, "--ids", strings.Join(ids, ",")}
+	if len(update.Assignees) > 0 {
+		args = append(args, "--assignees", strings.Join(update.Assignees, ","), "--replace-assignees")
+	}
+	if update.EstimatePoint > 0 {
+		args = append(args, "--estimate", strconv.FormatFloat(update.EstimatePoint, 'f', -1, 64))
+	}
+	if len(update.Labels) > 0 {
+		args = append(args, "--labels", strings.Join(update.Labels, ","), "--replace-labels")
+	}
+	if update.Module != "" {
+		args = append(args, "--module", update.Module)
+	}
+	if update.State != "" {
+		args = append(args, "--state", update.State)
+	}
+	if update.Priority != "" {
+		args = append(args, "--priority", update.Priority)
+	}
+	return append(args, "--yes")
+}
+
+func selectMultipleWorkItemsInteractive(chips *chipResolver, workItems []plane.WorkItem) ([]plane.WorkItem, error) {
 	fmt.Println("\n🔍 Select Work Items to Update")
 	fmt.Println(strings.Repeat("-", 70))
 	fmt.Println("You can select multiple work items using SPACE, then press ENTER")
@@ -312,8 +463,8 @@ func selectMultipleWorkItemsInteractive(workItems []plane.WorkItem) ([]plane.Wor
 
 	// Build options
 	var options []string
-	for _, item := range workItems {
-		options = append(options, fmt.Sprintf("[%d] %s", item.SequenceID, truncate(item.Name, 60)))
+	for i := range workItems {
+		options = append(options, chips.option(&workItems[i], 60))
 	}
 
 	// Use multi-select
@@ -527,7 +678,7 @@ func selectModuleInteractive(client *plane.Client, projectID string) (string, er
 func getAllAssignees(workItems []plane.WorkItem) []string {
 	assigneeMap := make(map[string]bool)
 	for _, item := range workItems {
-		for _, a := range item.Assignees {
+		for _, a := range itemAssigneeIDs(&item) {
 			assigneeMap[a] = true
 		}
 	}
@@ -541,7 +692,7 @@ func getAllAssignees(workItems []plane.WorkItem) []string {
 func getAllLabels(workItems []plane.WorkItem) []string {
 	labelMap := make(map[string]bool)
 	for _, item := range workItems {
-		for _, l := range item.Labels {
+		for _, l := range itemLabelIDs(&item) {
 			labelMap[l] = true
 		}
 	}
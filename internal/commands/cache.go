@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/cache"
+	"plane-cli/internal/config"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect or clear the local response cache",
+	Long: `Work item details are cached on disk, keyed by work item ID and
+updated_at. view, diff and export reuse a cached body as long as the work
+item has not been updated, so large descriptions are only downloaded once.
+
+Lists of projects, members, states, labels, modules and cycles are kept
+for cache.ttl.<resource> seconds, so interactive menus don't fetch them at
+every step. They are kept in memory for one run, and on disk between runs
+with cache.metadata_on_disk. Creating, changing or deleting one of them
+through the CLI refreshes its list.
+
+Pass --no-cache to any command to bypass the cache.
+
+Examples:
+  plane-cli cache info
+  plane-cli cache clear`,
+}
+
+var cacheInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Show the cache location and size",
+	RunE:  runCacheInfo,
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove all cached responses and metadata",
+	RunE:  runCacheClear,
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheInfoCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+}
+
+// openResponseCache opens the on-disk response cache in the CLI directory
+func openResponseCache() (*cache.Disk, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return nil, err
+	}
+	return cache.Open(filepath.Join(dir, "cache"))
+}
+
+func runCacheInfo(cmd *cobra.Command, args []string) error {
+	c, err := openResponseCache()
+	if err != nil {
+		return err
+	}
+	count, size, err := c.Stats()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Location: %s\n", c.Dir())
+	fmt.Printf("Entries:  %d\n", count)
+	fmt.Printf("Size:     %.1f MB\n", float64(size)/(1024*1024))
+	return nil
+}
+
+func runCacheClear(cmd *cobra.Command, args []string) error {
+	c, err := openResponseCache()
+	if err != nil {
+		return err
+	}
+	if err := c.Clear(); err != nil {
+		return err
+	}
+	fmt.Println("✅ Cache cleared")
+	return nil
+}
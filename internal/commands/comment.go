@@ -0,0 +1,280 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/markdown"
+	"plane-cli/internal/plane"
+)
+
+var commentCmd = &cobra.Command{
+	Use:   "comment",
+	Short: "Read and write work item comments",
+	Long: `Read the discussion on a work item and add, edit or delete comments.
+
+Comment text is given as arguments or read from a file with --file
+(use --file - to read standard input). Markdown is accepted.
+Comments are referenced by ID; the short ID shown by 'comment list' works too.
+
+Examples:
+  plane-cli comment list PROJ-123
+  plane-cli comment add PROJ-123 "Deployed to staging"
+  plane-cli comment add PROJ-123 --file notes.md
+  git log -5 --oneline | plane-cli comment add PROJ-123 --file -
+  plane-cli comment edit PROJ-123 3f2a9c1e "Deployed to production"
+  plane-cli comment delete PROJ-123 3f2a9c1e`,
+}
+
+var commentListCmd = &cobra.Command{
+	Use:   "list <PROJ-123>",
+	Short: "List the comments of a work item",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCommentList,
+}
+
+var commentAddCmd = &cobra.Command{
+	Use:   "add <PROJ-123> [text]",
+	Short: "Add a comment to a work item",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runCommentAdd,
+}
+
+var commentEditCmd = &cobra.Command{
+	Use:   "edit <PROJ-123> <comment-id> [text]",
+	Short: "Change the text of a comment",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runCommentEdit,
+}
+
+var commentDeleteCmd = &cobra.Command{
+	Use:   "delete <PROJ-123> <comment-id>",
+	Short: "Delete a comment",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runCommentDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(commentCmd)
+	commentCmd.AddCommand(commentListCmd)
+	commentCmd.AddCommand(commentAddCmd)
+	commentCmd.AddCommand(commentEditCmd)
+	commentCmd.AddCommand(commentDeleteCmd)
+
+	commentAddCmd.Flags().StringP("file", "f", "", "Read the comment from a file (- for stdin)")
+	addQueueFlag(commentAddCmd, func(cmd *cobra.Command, args []string) []string {
+		return args[:min(1, len(args))]
+	})
+	commentEditCmd.Flags().StringP("file", "f", "", "Read the new text from a file (- for stdin)")
+	commentDeleteCmd.Flags().Bool("yes", false, "Skip confirmation prompt")
+}
+
+func runCommentList(cmd *cobra.Command, args []string) error {
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, projectID, err := itemByIdentifier(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	comments, err := client.ListComments(projectID, item.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(comments) == 0 {
+		fmt.Printf("No comments on %s.\n", strings.ToUpper(args[0]))
+		return nil
+	}
+
+	memberNames := make(map[string]string)
+	if members, err := client.GetWorkspaceMembers(); err == nil {
+		for _, m := range members {
+			memberNames[m.ID] = m.GetDisplayName()
+		}
+	}
+
+	fmt.Printf("\n💬 %s: %s (%d comments)\n", strings.ToUpper(args[0]), item.Name, len(comments))
+	for _, c := range comments {
+		fmt.Println(strings.Repeat("-", 70))
+		fmt.Printf("%s · %s · %s\n", shortID(c.ID), nameOrID(memberNames, c.Actor), c.CreatedAt.Local().Format("2006-01-02 15:04"))
+		fmt.Println()
+		fmt.Println(markdown.FromHTML(c.CommentHTML))
+	}
+	fmt.Println(strings.Repeat("-", 70))
+	return nil
+}
+
+func runCommentAdd(cmd *cobra.Command, args []string) error {
+	file, _ := cmd.Flags().GetString("file")
+	text, err := commentText(args[1:], file)
+	if err != nil {
+		return err
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, projectID, err := itemByIdentifier(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	comment, err := client.CreateComment(projectID, item.ID, &plane.CommentCreate{CommentHTML: markdownToHTML(text)})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Comment %s added to %s\n", shortID(comment.ID), strings.ToUpper(args[0]))
+	return nil
+}
+
+func runCommentEdit(cmd *cobra.Command, args []string) error {
+	file, _ := cmd.Flags().GetString("file")
+	text, err := commentText(args[2:], file)
+	if err != nil {
+		return err
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, projectID, err := itemByIdentifier(client, args[0])
+	if err != nil {
+		return err
+	}
+	comment, err := findComment(client, projectID, item.ID, args[1])
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.UpdateComment(projectID, item.ID, comment.ID, &plane.CommentUpdate{CommentHTML: markdownToHTML(text)}); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Comment %s updated\n", shortID(comment.ID))
+	return nil
+}
+
+func runCommentDelete(cmd *cobra.Command, args []string) error {
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, projectID, err := itemByIdentifier(client, args[0])
+	if err != nil {
+		return err
+	}
+	comment, err := findComment(client, projectID, item.ID, args[1])
+	if err != nil {
+		return err
+	}
+
+	if !yes {
+		fmt.Printf("\n%s\n\n", truncate(markdown.FromHTML(comment.CommentHTML), 200))
+		confirmed, err := confirm("Delete this comment?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("❌ Deletion cancelled.")
+			return nil
+		}
+	}
+
+	if err := client.DeleteComment(projectID, item.ID, comment.ID); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Comment %s deleted\n", shortID(comment.ID))
+	return nil
+}
+
+// itemByIdentifier fetches a work item by its PROJ-123 identifier and
+// returns it with its project ID
+func itemByIdentifier(client *plane.Client, identifier string) (*plane.WorkItem, string, error) {
+	item, err := client.GetWorkItemByIdentifier(strings.ToUpper(identifier))
+	if plane.IsNotFound(err) {
+		return nil, "", fmt.Errorf("work item %s not found", strings.ToUpper(identifier))
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	projectID := item.ProjectID
+	if projectID == "" {
+		projectID = item.Project
+	}
+	return item, projectID, nil
+}
+
+// commentText returns the comment given as arguments or read from file
+func commentText(args []string, file string) (string, error) {
+	text := strings.Join(args, " ")
+	if file != "" {
+		if text != "" {
+			return "", fmt.Errorf("give the comment as text or with --file, not both")
+		}
+		var content []byte
+		var err error
+		if file == "-" {
+			content, err = io.ReadAll(os.Stdin)
+		} else {
+			content, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read comment file: %w", err)
+		}
+		text = string(content)
+	}
+
+	if strings.TrimSpace(text) == "" {
+		return "", fmt.Errorf("comment text is required (pass it as an argument or with --file)")
+	}
+	return text, nil
+}
+
+// findComment resolves a comment by its ID or a unique ID prefix
+func findComment(client *plane.Client, projectID, workItemID, ref string) (*plane.Comment, error) {
+	comments, err := client.ListComments(projectID, workItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	var match *plane.Comment
+	for i := range comments {
+		if comments[i].ID == ref {
+			return &comments[i], nil
+		}
+		if strings.HasPrefix(comments[i].ID, ref) {
+			if match != nil {
+				return nil, fmt.Errorf("comment ID '%s' is ambiguous", ref)
+			}
+			match = &comments[i]
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("comment '%s' not found", ref)
+	}
+	return match, nil
+}
+
+// shortID returns the first eight characters of an ID
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
@@ -0,0 +1,269 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"plane-cli/internal/config"
+	"plane-cli/internal/plane"
+	"plane-cli/internal/templates"
+)
+
+// completionTimeout bounds the API requests made while completing, when
+// there is no completion data yet
+const completionTimeout = 5 * time.Second
+
+// completionSource provides the values flag completion offers: the stored
+// completion data, or the API when there is none
+type completionSource struct {
+	cmd    *cobra.Command
+	cfg    *config.Config
+	client *plane.Client
+	data   *completionData
+}
+
+// flagCompleter returns the completions of a flag, as value<TAB>description
+type flagCompleter func(src *completionSource, flag *pflag.Flag) []string
+
+// flagCompleters completes flags by name, on every command that has them
+var flagCompleters = map[string]flagCompleter{
+	"project":   completeProjects,
+	"state":     completeStates,
+	"labels":    completeLabels,
+	"module":    completeModules,
+	"cycle":     completeCycles,
+	"assignees": completeMembers,
+	"assignee":  completeMembers,
+	"template":  completeTemplates,
+	"priority":  completePriorities,
+}
+
+// registerFlagCompletions adds live value completion to the flags of cmd
+// and its subcommands that take projects, states, labels, modules, cycles,
+// members, templates or priorities
+func registerFlagCompletions(cmd *cobra.Command) {
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		complete, ok := flagCompleters[flag.Name]
+		if !ok {
+			return
+		}
+		// Already registered when the flag is inherited
+		_ = cmd.RegisterFlagCompletionFunc(flag.Name, func(c *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			src := newCompletionSource(c)
+			if src == nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return complete(src, flag), cobra.ShellCompDirectiveNoFileComp
+		})
+	})
+	for _, sub := range cmd.Commands() {
+		registerFlagCompletions(sub)
+	}
+}
+
+// newCompletionSource loads the configuration and completion data for a
+// completion request, or returns nil when the CLI isn't configured. Stale
+// or missing data is refreshed in the background for the next request.
+func newCompletionSource(cmd *cobra.Command) *completionSource {
+	// Completion requests skip the root's PersistentPreRun
+	if profile, _ := cmd.Flags().GetString("profile"); profile != "" {
+		config.SelectProfile(profile)
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return nil
+	}
+	workspace := resolveWorkspace(cmd, cfg)
+
+	src := &completionSource{cmd: cmd, cfg: cfg, data: loadCompletionData(cfg.PlaneBaseURL, workspace)}
+	maxAge := time.Duration(cfg.CompletionRefreshAfter) * time.Minute
+	if src.data == nil || (maxAge > 0 && time.Since(src.data.RefreshedAt) > maxAge) {
+		startCompletionRefresh(workspace)
+	}
+	if src.data == nil {
+		src.client, err = plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken,
+			plane.WithTimeout(completionTimeout), plane.WithRetries(0), plane.WithContext(cmd.Context()))
+		if err != nil {
+			return nil
+		}
+		src.client.SetWorkspace(workspace)
+	}
+	return src
+}
+
+// projects returns the projects of the workspace
+func (s *completionSource) projects() []plane.Project {
+	if s.data != nil {
+		return s.data.Projects
+	}
+	projects, _ := s.client.GetProjects()
+	return projects
+}
+
+// projectID returns the ID of the project the command works on: --project,
+// resolved by ID, identifier or name, or defaults.project
+func (s *completionSource) projectID() string {
+	ref, _ := s.cmd.Flags().GetString("project")
+	if ref == "" {
+		ref = config.DefaultProject()
+	}
+	if ref == "" || s.data == nil {
+		return ref
+	}
+	for _, p := range s.data.Projects {
+		if p.ID == ref || strings.EqualFold(p.Identifier, ref) || strings.EqualFold(p.Name, ref) {
+			return p.ID
+		}
+	}
+	return ref
+}
+
+// project returns the metadata of the command's project, asking the API for
+// only what fetch reads when there is no completion data
+func (s *completionSource) project(fetch func(*plane.Client, string, *completionProject)) *completionProject {
+	projectID := s.projectID()
+	if projectID == "" {
+		return nil
+	}
+	if s.data != nil {
+		if d, ok := s.data.Details[projectID]; ok {
+			return &d
+		}
+		return nil
+	}
+	var d completionProject
+	fetch(s.client, projectID, &d)
+	return &d
+}
+
+// byName reports whether a flag takes names rather than IDs, judging by its
+// help text ("State name", "Cycle name or ID" but "Module ID")
+func byName(flag *pflag.Flag) bool {
+	return !strings.Contains(flag.Usage, "ID") || strings.Contains(flag.Usage, "name")
+}
+
+// completion formats a completion value with its description
+func completion(value, description string) string {
+	if description == "" {
+		return value
+	}
+	return value + "\t" + description
+}
+
+func completeProjects(src *completionSource, flag *pflag.Flag) []string {
+	var values []string
+	for _, p := range src.projects() {
+		values = append(values, completion(p.ID, fmt.Sprintf("%s · %s", p.Identifier, p.Name)))
+	}
+	return values
+}
+
+func completeStates(src *completionSource, flag *pflag.Flag) []string {
+	d := src.project(func(c *plane.Client, id string, d *completionProject) { d.States, _ = c.GetProjectStates(id) })
+	if d == nil {
+		return nil
+	}
+	var values []string
+	for _, st := range d.States {
+		if byName(flag) {
+			values = append(values, completion(st.Name, st.Group))
+		} else {
+			values = append(values, completion(st.ID, st.Name))
+		}
+	}
+	return values
+}
+
+func completeLabels(src *completionSource, flag *pflag.Flag) []string {
+	d := src.project(func(c *plane.Client, id string, d *completionProject) { d.Labels, _ = c.GetProjectLabels(id) })
+	if d == nil {
+		return nil
+	}
+	var values []string
+	for _, l := range d.Labels {
+		if byName(flag) {
+			values = append(values, l.Name)
+		} else {
+			values = append(values, completion(l.ID, l.Name))
+		}
+	}
+	return values
+}
+
+func completeModules(src *completionSource, flag *pflag.Flag) []string {
+	d := src.project(func(c *plane.Client, id string, d *completionProject) { d.Modules, _ = c.GetProjectModules(id) })
+	if d == nil {
+		return nil
+	}
+	var values []string
+	for _, m := range d.Modules {
+		if byName(flag) {
+			values = append(values, completion(m.Name, m.Status))
+		} else {
+			values = append(values, completion(m.ID, m.Name))
+		}
+	}
+	return values
+}
+
+func completeCycles(src *completionSource, flag *pflag.Flag) []string {
+	d := src.project(func(c *plane.Client, id string, d *completionProject) { d.Cycles, _ = c.GetProjectCycles(id) })
+	if d == nil {
+		return nil
+	}
+	var values []string
+	for _, cy := range d.Cycles {
+		dates := strings.Trim(dateValue(cy.StartDate)+" → "+dateValue(cy.EndDate), " →")
+		if byName(flag) {
+			values = append(values, completion(cy.Name, dates))
+		} else {
+			values = append(values, completion(cy.ID, cy.Name))
+		}
+	}
+	return values
+}
+
+func completeMembers(src *completionSource, flag *pflag.Flag) []string {
+	d := src.project(func(c *plane.Client, id string, d *completionProject) { d.Members, _ = c.GetProjectMembers(id) })
+	if d == nil {
+		return nil
+	}
+	var values []string
+	for _, m := range d.Members {
+		values = append(values, completion(m.ID, m.GetDisplayName()))
+	}
+	return values
+}
+
+// completeTemplates completes work item template names; flags taking a Go
+// template are left alone
+func completeTemplates(src *completionSource, flag *pflag.Flag) []string {
+	if strings.Contains(flag.Usage, "Go template") {
+		return nil
+	}
+	// NewManager creates a missing directory, which completing shouldn't
+	if _, err := os.Stat(src.cfg.TemplatesDir); err != nil {
+		return nil
+	}
+	mgr, err := templates.NewManager(src.cfg.TemplatesDir)
+	if err != nil {
+		return nil
+	}
+	var values []string
+	for _, name := range mgr.List() {
+		description := ""
+		if tmpl, err := mgr.Get(name); err == nil {
+			description = tmpl.Description
+		}
+		values = append(values, completion(name, description))
+	}
+	return values
+}
+
+func completePriorities(src *completionSource, flag *pflag.Flag) []string {
+	return []string{"urgent", "high", "medium", "low"}
+}
@@ -0,0 +1,312 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/cache"
+	"plane-cli/internal/config"
+	"plane-cli/internal/plane"
+)
+
+// completionDataVersion is the cache version of completion data; bump it
+// when the stored fields change
+const completionDataVersion = "v1"
+
+// completionRefreshLockAge is how long a started background refresh keeps
+// others from starting
+const completionRefreshLockAge = 5 * time.Minute
+
+// completionData is the workspace metadata shell completion and interactive
+// pickers read instead of asking the API, refreshed in the background
+type completionData struct {
+	RefreshedAt time.Time                    `json:"refreshed_at"`
+	Projects    []plane.Project              `json:"projects"`
+	Details     map[string]completionProject `json:"details"`
+}
+
+// completionProject is the metadata of one project, by project ID
+type completionProject struct {
+	States  []plane.State  `json:"states"`
+	Labels  []plane.Label  `json:"labels"`
+	Members []plane.Member `json:"members"`
+	Modules []plane.Module `json:"modules"`
+	Cycles  []plane.Cycle  `json:"cycles"`
+}
+
+var completionDataCmd = &cobra.Command{
+	Use:   "data",
+	Short: "Manage the data shell completion and pickers use",
+}
+
+var completionDataRefreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Fetch projects, states, labels, members, modules and cycles for completion",
+	Long: `Fetch the projects of the workspace with their states, labels, members,
+modules and cycles, and store them in the local cache so shell completion
+and interactive pickers don't wait for the API.
+
+The data is also refreshed automatically in the background after a command
+once it is older than completion.refresh_after minutes (default 60; 0
+turns the automatic refresh off). Completion uses the data at any age;
+pickers only while it is fresh.
+
+Examples:
+  plane-cli completion data refresh
+  plane-cli completion data refresh --profile ci`,
+	Args: cobra.NoArgs,
+	RunE: runCompletionDataRefresh,
+}
+
+func init() {
+	// Cobra adds its completion command when executing; adding it now lets
+	// the data commands live next to the shell script generators
+	rootCmd.InitDefaultCompletionCmd()
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == "completion" {
+			c.AddCommand(completionDataCmd)
+		}
+	}
+	completionDataCmd.AddCommand(completionDataRefreshCmd)
+
+	completionDataRefreshCmd.Flags().Bool("quiet", false, "Print nothing; used by the background refresh")
+}
+
+func runCompletionDataRefresh(cmd *cobra.Command, args []string) error {
+	quiet, _ := cmd.Flags().GetBool("quiet")
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	c, err := openResponseCache()
+	if err != nil {
+		return err
+	}
+	defer os.Remove(completionRefreshLock(c))
+
+	data, err := fetchCompletionData(client, quiet)
+	if err != nil {
+		return err
+	}
+	if err := saveCompletionData(c, client, data); err != nil {
+		return err
+	}
+
+	if !quiet {
+		counts := make(map[string]int)
+		for _, d := range data.Details {
+			counts["states"] += len(d.States)
+			counts["labels"] += len(d.Labels)
+			counts["modules"] += len(d.Modules)
+			counts["cycles"] += len(d.Cycles)
+		}
+		fmt.Printf("✅ Cached %d projects, %d states, %d labels, %d modules and %d cycles for completion\n",
+			len(data.Projects), counts["states"], counts["labels"], counts["modules"], counts["cycles"])
+	}
+	return nil
+}
+
+// fetchCompletionData fetches the metadata of every project of the
+// workspace. Projects whose metadata can't be read are kept without it.
+func fetchCompletionData(client *plane.Client, quiet bool) (*completionData, error) {
+	projects, err := client.GetProjects()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch projects: %w", err)
+	}
+
+	data := &completionData{Projects: projects, Details: make(map[string]completionProject)}
+	for i, p := range projects {
+		if !quiet {
+			fmt.Fprintf(os.Stderr, "\r📥 Fetching project metadata %d/%d", i+1, len(projects))
+		}
+		var d completionProject
+		var errs []string
+		if d.States, err = client.GetProjectStates(p.ID); err != nil {
+			errs = append(errs, "states")
+		}
+		if d.Labels, err = client.GetProjectLabels(p.ID); err != nil {
+			errs = append(errs, "labels")
+		}
+		if d.Members, err = client.GetProjectMembers(p.ID); err != nil {
+			errs = append(errs, "members")
+		}
+		if d.Modules, err = client.GetProjectModules(p.ID); err != nil {
+			errs = append(errs, "modules")
+		}
+		if d.Cycles, err = client.GetProjectCycles(p.ID); err != nil {
+			errs = append(errs, "cycles")
+		}
+		if len(errs) > 0 && !quiet {
+			fmt.Fprintf(os.Stderr, "\n⚠️  Warning: could not fetch %s of %s\n", strings.Join(errs, ", "), p.Identifier)
+		}
+		data.Details[p.ID] = d
+	}
+	if !quiet && len(projects) > 0 {
+		fmt.Fprintln(os.Stderr)
+	}
+	data.RefreshedAt = time.Now()
+	return data, nil
+}
+
+// completionDataKey returns the cache key of the completion data of the
+// client's instance and workspace
+func completionDataKey(baseURL, workspace string) string {
+	return "completion/" + strings.TrimRight(baseURL, "/") + "/" + workspace
+}
+
+// saveCompletionData stores completion data for the client's workspace
+func saveCompletionData(c *cache.Disk, client *plane.Client, data *completionData) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode completion data: %w", err)
+	}
+	return c.Put(completionDataKey(client.BaseURL(), client.Workspace()), completionDataVersion, raw)
+}
+
+// loadCompletionData returns the stored completion data of a workspace, of
+// any age, or nil when there is none
+func loadCompletionData(baseURL, workspace string) *completionData {
+	c, err := openResponseCache()
+	if err != nil {
+		return nil
+	}
+	raw, ok := c.Get(completionDataKey(baseURL, workspace), completionDataVersion)
+	if !ok {
+		return nil
+	}
+	var data completionData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil
+	}
+	return &data
+}
+
+// completionDataMaxAge returns how old completion data may get before it is
+// refreshed, or 0 when it is never refreshed automatically
+func completionDataMaxAge() time.Duration {
+	cfg, err := config.Load()
+	if err != nil {
+		return 0
+	}
+	return time.Duration(cfg.CompletionRefreshAfter) * time.Minute
+}
+
+// freshCompletionData returns the client's completion data when it is fresh
+// enough for pickers to show instead of asking the API, or nil
+func freshCompletionData(client *plane.Client) *completionData {
+	maxAge := completionDataMaxAge()
+	if !client.HasCache() || maxAge <= 0 {
+		return nil
+	}
+	data := loadCompletionData(client.BaseURL(), client.Workspace())
+	if data == nil || time.Since(data.RefreshedAt) > maxAge {
+		return nil
+	}
+	return data
+}
+
+// pickerProjects returns the projects of the workspace for a picker, from
+// fresh completion data when there is some
+func pickerProjects(client *plane.Client) ([]plane.Project, error) {
+	if data := freshCompletionData(client); data != nil && len(data.Projects) > 0 {
+		return data.Projects, nil
+	}
+	return client.GetProjects()
+}
+
+// pickerMembers returns the members of a project for a picker, from fresh
+// completion data when there is some
+func pickerMembers(client *plane.Client, projectID string) ([]plane.Member, error) {
+	if data := freshCompletionData(client); data != nil {
+		if d, ok := data.Details[projectID]; ok && len(d.Members) > 0 {
+			return d.Members, nil
+		}
+	}
+	return client.GetProjectMembers(projectID)
+}
+
+// pickerModules returns the modules of a project for a picker, from fresh
+// completion data when there is some
+func pickerModules(client *plane.Client, projectID string) ([]plane.Module, error) {
+	if data := freshCompletionData(client); data != nil {
+		if d, ok := data.Details[projectID]; ok && d.Modules != nil {
+			return d.Modules, nil
+		}
+	}
+	return client.GetProjectModules(projectID)
+}
+
+// completionRefreshLock returns the file marking a background refresh in
+// progress
+func completionRefreshLock(c *cache.Disk) string {
+	return filepath.Join(c.Dir(), "completion-refresh.lock")
+}
+
+// warmCompletionData starts a background refresh of the completion data
+// after a command when the data is missing or stale. It never fails the
+// command: any problem just skips the refresh.
+func warmCompletionData(cmd *cobra.Command) {
+	if cmd == nil || cmd == rootCmd || isCompletionCommand(cmd) {
+		return
+	}
+	if noCache, _ := cmd.Flags().GetBool("no-cache"); noCache {
+		return
+	}
+	cfg, err := config.Load()
+	if err != nil || cfg.CompletionRefreshAfter <= 0 {
+		return
+	}
+	maxAge := time.Duration(cfg.CompletionRefreshAfter) * time.Minute
+	workspace := resolveWorkspace(cmd, cfg)
+	if data := loadCompletionData(cfg.PlaneBaseURL, workspace); data != nil && time.Since(data.RefreshedAt) < maxAge {
+		return
+	}
+	startCompletionRefresh(workspace)
+}
+
+// startCompletionRefresh refreshes the completion data of a workspace in a
+// background process, unless another refresh started recently
+func startCompletionRefresh(workspace string) {
+	c, err := openResponseCache()
+	if err != nil {
+		return
+	}
+	lock := completionRefreshLock(c)
+	if info, err := os.Stat(lock); err == nil && time.Since(info.ModTime()) < completionRefreshLockAge {
+		return
+	}
+	if err := os.WriteFile(lock, nil, 0644); err != nil {
+		return
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return
+	}
+	args := []string{"completion", "data", "refresh", "--quiet", "--workspace", workspace}
+	if profile := config.ActiveProfile(); profile != "" {
+		args = append(args, "--profile", profile)
+	}
+	refresh := exec.Command(exe, args...)
+	if refresh.Start() != nil {
+		os.Remove(lock)
+	}
+}
+
+// isCompletionCommand reports whether cmd is part of shell completion,
+// which must stay fast and side-effect free
+func isCompletionCommand(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		switch c.Name() {
+		case "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+			return true
+		}
+	}
+	return false
+}
@@ -15,14 +15,22 @@ This command allows you to view and update your Plane.so API credentials:
 - API Token (your Plane API key)
 - Workspace slug (e.g., lazuardy-tech)
 
-Configuration is saved to .env file in the current directory.
+Configuration is saved to the nearest .env file, searching upward from the
+current directory to the repository root, or to .env in the current directory.
 
 Examples:
   # View current configuration
   plane-cli configure --show
 
   # Update configuration interactively
-  plane-cli configure`,
+  plane-cli configure
+
+  # Create or update a named profile for another Plane instance
+  plane-cli configure --profile work
+
+With --profile (or PLANE_PROFILE, or a current profile chosen with
+'plane-cli profile use'), the credentials are saved to that profile in
+~/.config/plane-cli/profiles.yaml instead of .env.`,
 	RunE: runConfigure,
 }
 
@@ -0,0 +1,205 @@
+package commands
+
+import (
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"regexp"
+	"strings"
+
+	"plane-cli/internal/config"
+	"plane-cli/internal/plane"
+)
+
+var (
+	// dataImagePattern matches images embedded as base64 data URIs, which
+	// editors and converters produce and which make content huge
+	dataImagePattern = regexp.MustCompile(`data:image/([a-zA-Z0-9.+-]+);base64,([A-Za-z0-9+/=]+)`)
+	// splitPointPattern matches the top-level headings content is split at
+	splitPointPattern = regexp.MustCompile(`(?m)^#{1,2} |<h[12][\s>]`)
+	// paragraphPattern matches paragraph boundaries, used to split sections
+	// that are too large on their own
+	paragraphPattern = regexp.MustCompile(`\n\n|</p>`)
+)
+
+// checkContentSize returns an error with advice when content is larger than
+// limit bytes, which Plane's proxy rejects or times out on. hints are
+// command-specific ways to make it fit. A limit of 0 turns the check off.
+func checkContentSize(what, content string, limit int, hints ...string) error {
+	if limit <= 0 || len(content) <= limit {
+		return nil
+	}
+
+	var advice []string
+	if n, size := dataImageBytes(content); n > 0 {
+		advice = append(advice, fmt.Sprintf("%d embedded image(s) take %s; upload them as assets with --externalize-images", n, formatBytes(size)))
+	}
+	advice = append(advice, hints...)
+	advice = append(advice, "raise request.max_content_kb in config.yaml if your server accepts larger requests")
+
+	return fmt.Errorf("%s is %s, more than the %s sent in one request:\n  - %s",
+		what, formatBytes(len(content)), formatBytes(limit), strings.Join(advice, "\n  - "))
+}
+
+// fitContent uploads the images embedded in content first when externalize
+// is set, then checks that the result fits in one request
+func fitContent(client *plane.Client, cfg *config.Config, projectID, what, content string, externalize bool, hints ...string) (string, error) {
+	if externalize && content != "" {
+		var err error
+		if content, _, err = externalizeDataImages(client, projectID, content); err != nil {
+			return "", err
+		}
+	}
+	return content, checkContentSize(what, content, cfg.MaxContentKB*1024, hints...)
+}
+
+// pageContentParts prepares page content for sending: it returns the
+// content as a single part, or split into parts that each fit in one
+// request when split is set. The first part is the page's own content.
+func pageContentParts(client *plane.Client, cfg *config.Config, projectID, content string, externalize, split bool) ([]string, error) {
+	if !split {
+		content, err := fitContent(client, cfg, projectID, "page content", content, externalize,
+			"split it into the page and child pages with --split")
+		return []string{content}, err
+	}
+
+	content, err := fitContent(client, cfg, projectID, "page content", content, externalize)
+	if err == nil {
+		return []string{content}, nil
+	}
+	if content == "" {
+		return nil, err
+	}
+	return splitContent(content, cfg.MaxContentKB*1024)
+}
+
+// createContentParts creates the parts of split page content beyond the
+// first as child pages of page. Plane has no way to append to a page, so
+// each part is a page of its own, named after the parent.
+func createContentParts(client *plane.Client, projectID string, page *plane.Page, parts []string) error {
+	if len(parts) == 0 {
+		return nil
+	}
+
+	fmt.Printf("\n📄 Content split into %d parts; adding the rest as child pages:\n", len(parts)+1)
+	for i, part := range parts {
+		child, err := client.CreatePage(projectID, &plane.PageCreate{
+			Name:            fmt.Sprintf("%s (part %d)", page.Name, i+2),
+			Description:     part,
+			DescriptionHTML: part,
+			ParentID:        page.ID,
+			Access:          page.Access,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create part %d of %d: %w", i+2, len(parts)+1, err)
+		}
+		fmt.Printf("   ✅ %s (%s, %s)\n", child.Name, child.ID, formatBytes(len(part)))
+	}
+	return nil
+}
+
+// dataImageBytes counts the images embedded as data URIs and their size
+func dataImageBytes(content string) (int, int) {
+	n, size := 0, 0
+	for _, m := range dataImagePattern.FindAllString(content, -1) {
+		n++
+		size += len(m)
+	}
+	return n, size
+}
+
+// externalizeDataImages uploads the images embedded as data URIs as project
+// assets and replaces each data URI with the asset URL. It returns the
+// rewritten content and the number of images uploaded.
+func externalizeDataImages(client *plane.Client, projectID, content string) (string, int, error) {
+	mapping := make(map[string]string)
+	for _, m := range dataImagePattern.FindAllStringSubmatch(content, -1) {
+		if _, done := mapping[m[0]]; done {
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(m[2])
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to decode embedded image %d: %w", len(mapping)+1, err)
+		}
+		contentType := "image/" + strings.ToLower(m[1])
+		ext := "." + strings.TrimPrefix(strings.ToLower(m[1]), "x-")
+		if exts, _ := mime.ExtensionsByType(contentType); len(exts) > 0 {
+			ext = exts[0]
+		}
+		name := fmt.Sprintf("embedded-image-%d%s", len(mapping)+1, ext)
+
+		assetURL, err := client.UploadAsset(projectID, name, contentType, data)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to upload embedded image %d: %w", len(mapping)+1, err)
+		}
+		fmt.Printf("🖼️  Uploaded %s (%s)\n", name, formatBytes(len(data)))
+		mapping[m[0]] = assetURL
+	}
+
+	for dataURI, assetURL := range mapping {
+		content = strings.ReplaceAll(content, dataURI, assetURL)
+	}
+	return content, len(mapping), nil
+}
+
+// splitContent splits content into parts of at most limit bytes, at
+// top-level headings (# and ## in Markdown, <h1> and <h2> in HTML) and,
+// for sections that are too large by themselves, at paragraphs
+func splitContent(content string, limit int) ([]string, error) {
+	if limit <= 0 || len(content) <= limit {
+		return []string{content}, nil
+	}
+
+	var parts []string
+	current := ""
+	for _, section := range splitAt(content, splitPointPattern, false) {
+		pieces := []string{section}
+		if len(section) > limit {
+			pieces = splitAt(section, paragraphPattern, true)
+		}
+		for _, piece := range pieces {
+			if len(piece) > limit {
+				return nil, fmt.Errorf("a single paragraph is %s, more than the %s sent in one request", formatBytes(len(piece)), formatBytes(limit))
+			}
+			if len(current)+len(piece) > limit && current != "" {
+				parts = append(parts, current)
+				current = ""
+			}
+			current += piece
+		}
+	}
+	if strings.TrimSpace(current) != "" {
+		parts = append(parts, current)
+	}
+	return parts, nil
+}
+
+// splitAt cuts s before every match of pattern, or after it when after is
+// set, so that the pieces joined give s again
+func splitAt(s string, pattern *regexp.Regexp, after bool) []string {
+	var pieces []string
+	last := 0
+	for _, m := range pattern.FindAllStringIndex(s, -1) {
+		cut := m[0]
+		if after {
+			cut = m[1]
+		}
+		if cut > last {
+			pieces = append(pieces, s[last:cut])
+			last = cut
+		}
+	}
+	return append(pieces, s[last:])
+}
+
+// formatBytes formats a size in bytes as B, KB or MB
+func formatBytes(n int) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.0f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
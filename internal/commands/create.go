@@ -2,7 +2,6 @@ package commands
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/spf13/cobra"
 	"plane-cli/internal/config"
@@ -26,7 +25,13 @@ Examples:
   plane-cli create --project my-project --title "Dashboard" \
     --template feature \
     --vars feature_name="Analytics Dashboard" \
-    --vars notes="High priority feature"`,
+    --vars notes="High priority feature"
+
+  # List variables: a JSON array, or values separated by semicolons
+  plane-cli create --project my-project --title "Login fails" \
+    --template bug \
+    --vars steps="Open the app;Enter a valid password;Press Login" \
+    --vars env='{"os":"macOS","browser":"Safari"}'`,
 	RunE: runCreate,
 }
 
@@ -34,7 +39,7 @@ func init() {
 	rootCmd.AddCommand(createCmd)
 
 	// Required flags
-	createCmd.Flags().StringP("project", "p", "", "Project identifier (required)")
+	createCmd.Flags().StringP("project", "p", "", "Project identifier (required unless defaults.project is set)")
 	createCmd.Flags().StringP("title", "t", "", "Work item title (required)")
 	createCmd.MarkFlagRequired("project")
 	createCmd.MarkFlagRequired("title")
@@ -45,14 +50,17 @@ func init() {
 	createCmd.Flags().StringToString("vars", nil, "Template variables (key=value pairs)")
 	createCmd.Flags().String("state", "", "Initial state")
 	createCmd.Flags().String("priority", "medium", "Priority (urgent, high, medium, low)")
-	createCmd.Flags().StringSlice("assignees", nil, "Assignee user IDs")
-	createCmd.Flags().StringSlice("labels", nil, "Label IDs")
+	createCmd.Flags().StringSlice("assignees", nil, "Assignees: member IDs, emails, names or me")
+	createCmd.Flags().StringSlice("labels", nil, "Labels: IDs or names")
+	createCmd.Flags().Bool("create-labels", false, "Create labels given by name that don't exist yet")
 	createCmd.Flags().String("start-date", "", "Start date (YYYY-MM-DD)")
 	createCmd.Flags().String("target-date", "", "Target date (YYYY-MM-DD)")
 	createCmd.Flags().Float64("estimate", 0, "Estimate points")
-	createCmd.Flags().String("module", "", "Module ID")
-	createCmd.Flags().String("cycle", "", "Cycle ID")
+	createCmd.Flags().String("module", "", "Module ID or name")
+	createCmd.Flags().String("cycle", "", "Cycle ID or name")
 	createCmd.Flags().String("parent", "", "Parent work item ID")
+	createCmd.Flags().Bool("externalize-images", false, "Upload images embedded as data URIs as assets and link them instead")
+	addQueueFlag(createCmd, nil)
 }
 
 func runCreate(cmd *cobra.Command, args []string) error {
@@ -78,16 +86,9 @@ func runCreate(cmd *cobra.Command, args []string) error {
 	module, _ := cmd.Flags().GetString("module")
 	cycle, _ := cmd.Flags().GetString("cycle")
 	parent, _ := cmd.Flags().GetString("parent")
-	workspace, _ := cmd.Flags().GetString("workspace")
-
-	// Get workspace - priority: flag > env > extract from URL
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	externalize, _ := cmd.Flags().GetBool("externalize-images")
+
+	workspace := resolveWorkspace(cmd, cfg)
 
 	// Initialize template manager if template is specified
 	var tmplManager *templates.Manager
@@ -108,33 +109,51 @@ func runCreate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create Plane client
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 	client.SetWorkspace(workspace)
 
+	// Assignees may be given by email or name
+	if assignees, err = newMemberResolver(client, project).resolve(assignees); err != nil {
+		return err
+	}
+	// Labels may be given by name
+	createLabels, _ := cmd.Flags().GetBool("create-labels")
+	if labels, err = newLabelResolver(client, project, createLabels, false).resolve(labels); err != nil {
+		return err
+	}
+
+	// Module and cycle may be given by name
+	if module, err = resolveModule(client, project, module); err != nil {
+		return err
+	}
+	if cycle, err = resolveCycle(client, project, cycle); err != nil {
+		return err
+	}
+
+	if description, err = fitContent(client, cfg, project, "description", description, externalize); err != nil {
+		return err
+	}
+
 	// Build work item create payload
 	create := &plane.WorkItemCreate{
-		Name:        title,
-		Description: description,
-		Priority:    plane.ParsePriorityString(priorityStr),
-		Assignees:   assignees,
-		Labels:      labels,
-		StartDate:   startDate,
-		TargetDate:  targetDate,
-		Module:      module,
-		Cycle:       cycle,
-		Parent:      parent,
+		Name:            title,
+		DescriptionHTML: markdownToHTML(description),
+		Priority:        plane.ParsePriorityString(priorityStr),
+		Assignees:       assignees,
+		Labels:          labels,
+		StartDate:       startDate,
+		TargetDate:      targetDate,
+		Module:          module,
+		Cycle:           cycle,
+		Parent:          parent,
 	}
 
 	// Convert state name to UUID if provided
-	if state != "" {
-		stateID, err := client.GetStateByName(project, state)
-		if err != nil {
-			return fmt.Errorf("invalid state '%s': %w", state, err)
-		}
-		create.State = stateID
+	if create.State, err = resolveState(client, project, state); err != nil {
+		return fmt.Errorf("invalid state '%s': %w", state, err)
 	}
 
 	// Convert estimate to UUID if provided
@@ -162,16 +181,3 @@ func runCreate(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
-
-// extractWorkspaceFromURL extracts workspace slug from Plane URL
-func extractWorkspaceFromURL(baseURL string) string {
-	// This is a simplified extraction
-	// You might need to adjust based on your Plane setup
-	parts := strings.Split(baseURL, "/")
-	for i := len(parts) - 1; i >= 0; i-- {
-		if parts[i] != "" {
-			return parts[i]
-		}
-	}
-	return ""
-}
@@ -0,0 +1,259 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var cycleCmd = &cobra.Command{
+	Use:   "cycle",
+	Short: "Manage project cycles",
+	Long: `List, create and update the cycles (sprints) of a project, and write retro
+reports of them.
+
+Dates are checked before anything is saved: a cycle may not end before it
+starts, and a cycle that overlaps another one, or whose length or gap to the
+previous cycle differs from the project's usual cadence, is reported and
+must be confirmed (or pass --yes).
+
+Examples:
+  plane-cli cycle list --project <project-id>
+  plane-cli cycle create --project <project-id> --name "Sprint 43" --start-date 2024-06-03 --end-date 2024-06-14
+  plane-cli cycle update --project <project-id> --cycle "Sprint 43" --end-date 2024-06-17
+  plane-cli cycle report --project <project-id> --cycle "Sprint 43" --out sprint-43.md`,
+}
+
+var cycleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the cycles of a project",
+	RunE:  runCycleList,
+}
+
+var cycleCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a cycle",
+	RunE:  runCycleCreate,
+}
+
+var cycleUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Rename or reschedule a cycle",
+	RunE:  runCycleUpdate,
+}
+
+func init() {
+	rootCmd.AddCommand(cycleCmd)
+	cycleCmd.AddCommand(cycleListCmd)
+	cycleCmd.AddCommand(cycleCreateCmd)
+	cycleCmd.AddCommand(cycleUpdateCmd)
+
+	for _, c := range []*cobra.Command{cycleListCmd, cycleCreateCmd, cycleUpdateCmd} {
+		c.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+		c.MarkFlagRequired("project")
+	}
+
+	cycleCreateCmd.Flags().String("name", "", "Cycle name (required)")
+	cycleCreateCmd.Flags().String("description", "", "Cycle description")
+	cycleCreateCmd.Flags().String("start-date", "", "Start date (YYYY-MM-DD)")
+	cycleCreateCmd.Flags().String("end-date", "", "End date (YYYY-MM-DD)")
+	cycleCreateCmd.Flags().Bool("yes", false, "Create even when the dates conflict with other cycles")
+	cycleCreateCmd.MarkFlagRequired("name")
+
+	cycleUpdateCmd.Flags().String("cycle", "", "Cycle name or ID (required)")
+	cycleUpdateCmd.Flags().String("name", "", "New cycle name")
+	cycleUpdateCmd.Flags().String("description", "", "New cycle description")
+	cycleUpdateCmd.Flags().String("start-date", "", "New start date (YYYY-MM-DD)")
+	cycleUpdateCmd.Flags().String("end-date", "", "New end date (YYYY-MM-DD)")
+	cycleUpdateCmd.Flags().Bool("yes", false, "Update even when the dates conflict with other cycles")
+	cycleUpdateCmd.MarkFlagRequired("cycle")
+}
+
+func runCycleList(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return err
+	}
+	if len(cycles) == 0 {
+		fmt.Println("No cycles found in this project.")
+		return nil
+	}
+
+	// Undated cycles go last
+	sort.SliceStable(cycles, func(i, j int) bool {
+		a, b := dateValue(cycles[i].StartDate), dateValue(cycles[j].StartDate)
+		if a == "" || b == "" {
+			return a != ""
+		}
+		return a < b
+	})
+
+	fmt.Printf("\n🔄 Cycles (%d):\n\n", len(cycles))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSTART\tEND\tDAYS\tID")
+	for _, c := range cycles {
+		days := "-"
+		if s, e := scheduleDate(c.StartDate), scheduleDate(c.EndDate); !s.IsZero() && !e.IsZero() {
+			days = fmt.Sprintf("%d", scheduleDays(s, e))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.Name, emptyAsDash(dateValue(c.StartDate)), emptyAsDash(dateValue(c.EndDate)), days, c.ID)
+	}
+	w.Flush()
+	fmt.Println()
+	return nil
+}
+
+func runCycleCreate(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	name, _ := cmd.Flags().GetString("name")
+	description, _ := cmd.Flags().GetString("description")
+	startStr, _ := cmd.Flags().GetString("start-date")
+	endStr, _ := cmd.Flags().GetString("end-date")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	start, err := parseScheduleDate("start-date", startStr)
+	if err != nil {
+		return err
+	}
+	end, err := parseScheduleDate("end-date", endStr)
+	if err != nil {
+		return err
+	}
+	if err := validateScheduleRange(start, end); err != nil {
+		return err
+	}
+	if start.IsZero() != end.IsZero() {
+		return fmt.Errorf("a cycle needs both --start-date and --end-date, or neither")
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return err
+	}
+	ok, err := confirmScheduleWarnings(cycleScheduleWarnings(cycles, "", start, end), yes, "Create the cycle")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println("❌ Cycle not created.")
+		return nil
+	}
+
+	cycle, err := client.CreateCycle(projectID, &plane.CycleCreate{
+		Name:        name,
+		Description: description,
+		StartDate:   startStr,
+		EndDate:     endStr,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✅ Created cycle:\n")
+	fmt.Printf("   ID: %s\n", cycle.ID)
+	fmt.Printf("   Name: %s\n", cycle.Name)
+	if startStr != "" {
+		fmt.Printf("   Dates: %s to %s\n", startStr, endStr)
+	}
+	return nil
+}
+
+func runCycleUpdate(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	ref, _ := cmd.Flags().GetString("cycle")
+	name, _ := cmd.Flags().GetString("name")
+	description, _ := cmd.Flags().GetString("description")
+	startStr, _ := cmd.Flags().GetString("start-date")
+	endStr, _ := cmd.Flags().GetString("end-date")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	if name == "" && description == "" && startStr == "" && endStr == "" {
+		return fmt.Errorf("nothing to update: pass --name, --description, --start-date or --end-date")
+	}
+	start, err := parseScheduleDate("start-date", startStr)
+	if err != nil {
+		return err
+	}
+	end, err := parseScheduleDate("end-date", endStr)
+	if err != nil {
+		return err
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return err
+	}
+	cycle, err := findCycle(cycles, ref)
+	if err != nil {
+		return err
+	}
+
+	// Check the dates the cycle will have after the update
+	if start.IsZero() {
+		start = scheduleDate(cycle.StartDate)
+	}
+	if end.IsZero() {
+		end = scheduleDate(cycle.EndDate)
+	}
+	if err := validateScheduleRange(start, end); err != nil {
+		return err
+	}
+	if startStr != "" || endStr != "" {
+		ok, err := confirmScheduleWarnings(cycleScheduleWarnings(cycles, cycle.ID, start, end), yes, "Update the cycle")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("❌ Cycle not updated.")
+			return nil
+		}
+	}
+
+	updated, err := client.UpdateCycle(projectID, cycle.ID, &plane.CycleUpdate{
+		Name:        name,
+		Description: description,
+		StartDate:   startStr,
+		EndDate:     endStr,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✅ Updated cycle:\n")
+	fmt.Printf("   ID: %s\n", updated.ID)
+	fmt.Printf("   Name: %s\n", updated.Name)
+	fmt.Printf("   Dates: %s to %s\n", emptyAsDash(dateValue(updated.StartDate)), emptyAsDash(dateValue(updated.EndDate)))
+	return nil
+}
+
+// findCycle resolves a cycle by ID or case-insensitive name
+func findCycle(cycles []plane.Cycle, ref string) (*plane.Cycle, error) {
+	for i := range cycles {
+		if cycles[i].ID == ref || strings.EqualFold(cycles[i].Name, ref) {
+			return &cycles[i], nil
+		}
+	}
+	return nil, fmt.Errorf("cycle '%s' not found", ref)
+}
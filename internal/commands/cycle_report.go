@@ -0,0 +1,290 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var cycleReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Write a markdown retro report of a cycle",
+	Long: `Write a markdown report of a cycle for retro notes or a Plane page: the work
+items completed and carried over, estimate velocity compared with the
+previous cycles, and a breakdown per contributor.
+
+Work items count as completed when they are in a completed state and as
+carried over when they are still open; cancelled ones are listed apart.
+Estimate points are read from the cached estimates; without them velocity
+is counted in work items.
+
+Examples:
+  plane-cli cycle report --project <project-id> --cycle "Sprint 12"
+  plane-cli cycle report --project <project-id> --cycle "Sprint 12" --out sprint-12.md
+  plane-cli page create --project <project-id> --name "Sprint 12 retro" --description-file sprint-12.md`,
+	RunE: runCycleReport,
+}
+
+// cycleReportHistory is the number of earlier cycles velocity is compared with
+const cycleReportHistory = 3
+
+func init() {
+	cycleCmd.AddCommand(cycleReportCmd)
+
+	cycleReportCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+	cycleReportCmd.Flags().String("cycle", "", "Cycle name or ID (required)")
+	cycleReportCmd.Flags().String("out", "", "Write the report to this file instead of stdout")
+	cycleReportCmd.MarkFlagRequired("project")
+	cycleReportCmd.MarkFlagRequired("cycle")
+}
+
+// cycleVelocity is the work completed in one cycle
+type cycleVelocity struct {
+	cycle     *plane.Cycle
+	completed int
+	points    float64
+}
+
+func runCycleReport(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	cycleRef, _ := cmd.Flags().GetString("cycle")
+	out, _ := cmd.Flags().GetString("out")
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get cycles: %w", err)
+	}
+	cycle, err := findCycle(cycles, cycleRef)
+	if err != nil {
+		return err
+	}
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get states: %w", err)
+	}
+	items, err := client.GetCycleWorkItems(projectID, cycle.ID)
+	if err != nil {
+		return err
+	}
+	points, err := estimatePoints(client, projectID)
+	if err != nil {
+		points = nil
+	}
+
+	// The cycles that ended before this one started, latest first
+	var history []cycleVelocity
+	for _, c := range previousCycles(cycles, cycle, cycleReportHistory) {
+		cycleItems, err := client.GetCycleWorkItems(projectID, c.ID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: skipping %s in the velocity: %v\n", c.Name, err)
+			continue
+		}
+		history = append(history, completedWork(c, cycleItems, lookup, points))
+	}
+
+	workspace := resolveWorkspace(cmd, cfg)
+	link := func(item *plane.WorkItem) string {
+		return projectWebURL(webAppURL(cfg), workspace, projectID, "issues", item.ID)
+	}
+	doc := cycleReportMarkdown(cycle, items, aggregateWorkItems(items, states, lookup, points), history, lookup, points, link, time.Now())
+
+	if out == "" {
+		fmt.Print(doc)
+		return nil
+	}
+	if err := os.WriteFile(out, []byte(doc), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", out, err)
+	}
+	fmt.Printf("✅ Report written to %s\n", out)
+	return nil
+}
+
+// previousCycles returns up to n dated cycles that ended before cycle
+// started, latest first
+func previousCycles(cycles []plane.Cycle, cycle *plane.Cycle, n int) []*plane.Cycle {
+	start := scheduleDate(cycle.StartDate)
+	if start.IsZero() {
+		return nil
+	}
+	var earlier []*plane.Cycle
+	for i := range cycles {
+		if end := scheduleDate(cycles[i].EndDate); cycles[i].ID != cycle.ID && !end.IsZero() && end.Before(start) {
+			earlier = append(earlier, &cycles[i])
+		}
+	}
+	sort.SliceStable(earlier, func(i, j int) bool {
+		return dateValue(earlier[i].EndDate) > dateValue(earlier[j].EndDate)
+	})
+	return earlier[:min(n, len(earlier))]
+}
+
+// completedWork counts the completed work items of a cycle and their points
+func completedWork(cycle *plane.Cycle, items []plane.WorkItem, lookup *itemLookup, points map[string]float64) cycleVelocity {
+	v := cycleVelocity{cycle: cycle}
+	for i := range items {
+		if lookup.stateGroup(&items[i]) == "completed" {
+			v.completed++
+			v.points += itemPoints(&items[i], points)
+		}
+	}
+	return v
+}
+
+// cycleReportMarkdown renders the report of a cycle. link returns the web
+// address of a work item.
+func cycleReportMarkdown(cycle *plane.Cycle, items []plane.WorkItem, stats *workItemStats, history []cycleVelocity,
+	lookup *itemLookup, points map[string]float64, link func(*plane.WorkItem) string, now time.Time) string {
+	var completed, carried, cancelled []plane.WorkItem
+	for _, item := range items {
+		switch lookup.stateGroup(&item) {
+		case "completed":
+			completed = append(completed, item)
+		case "cancelled":
+			cancelled = append(cancelled, item)
+		default:
+			carried = append(carried, item)
+		}
+	}
+	sumPoints := func(items []plane.WorkItem) float64 {
+		total := 0.0
+		for i := range items {
+			total += itemPoints(&items[i], points)
+		}
+		return total
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Cycle report: %s\n\n", markdownEscape(cycle.Name))
+	fmt.Fprintf(&b, "**%s**", markdownEscape(lookup.projectName))
+	if start, end := dateValue(cycle.StartDate), dateValue(cycle.EndDate); start != "" || end != "" {
+		fmt.Fprintf(&b, " · %s → %s", emptyAsDash(start), emptyAsDash(end))
+	}
+	fmt.Fprintf(&b, " · generated %s\n\n", now.Format("2006-01-02"))
+
+	b.WriteString("## Summary\n\n")
+	if stats.Estimates {
+		b.WriteString("| | Work items | Points |\n|---|---:|---:|\n")
+		fmt.Fprintf(&b, "| Completed | %d | %s |\n", len(completed), formatPoints(sumPoints(completed)))
+		fmt.Fprintf(&b, "| Carried over | %d | %s |\n", len(carried), formatPoints(sumPoints(carried)))
+		fmt.Fprintf(&b, "| Cancelled | %d | %s |\n", len(cancelled), formatPoints(sumPoints(cancelled)))
+		fmt.Fprintf(&b, "| **Total** | **%d** | **%s** |\n\n", len(items), formatPoints(stats.Points))
+	} else {
+		b.WriteString("| | Work items |\n|---|---:|\n")
+		fmt.Fprintf(&b, "| Completed | %d |\n", len(completed))
+		fmt.Fprintf(&b, "| Carried over | %d |\n", len(carried))
+		fmt.Fprintf(&b, "| Cancelled | %d |\n", len(cancelled))
+		fmt.Fprintf(&b, "| **Total** | **%d** |\n\n", len(items))
+	}
+	fmt.Fprintf(&b, "Completion: %.0f%% of the work items", stats.Completion)
+	if scope := stats.Points - sumPoints(cancelled); stats.Estimates && scope > 0 {
+		fmt.Fprintf(&b, ", %.0f%% of the points", stats.CompletedPoints/scope*100)
+	}
+	b.WriteString(".\n\n")
+
+	b.WriteString("## Velocity\n\n")
+	velocities := append([]cycleVelocity{completedWork(cycle, items, lookup, points)}, history...)
+	if stats.Estimates {
+		b.WriteString("| Cycle | Dates | Completed | Points |\n|---|---|---:|---:|\n")
+	} else {
+		b.WriteString("| Cycle | Dates | Completed |\n|---|---|---:|\n")
+	}
+	for _, v := range velocities {
+		dates := fmt.Sprintf("%s → %s", emptyAsDash(dateValue(v.cycle.StartDate)), emptyAsDash(dateValue(v.cycle.EndDate)))
+		if stats.Estimates {
+			fmt.Fprintf(&b, "| %s | %s | %d | %s |\n", markdownEscape(v.cycle.Name), dates, v.completed, formatPoints(v.points))
+		} else {
+			fmt.Fprintf(&b, "| %s | %s | %d |\n", markdownEscape(v.cycle.Name), dates, v.completed)
+		}
+	}
+	b.WriteString("\n")
+	if len(history) > 0 {
+		completedAvg, pointsAvg := 0.0, 0.0
+		for _, v := range history {
+			completedAvg += float64(v.completed) / float64(len(history))
+			pointsAvg += v.points / float64(len(history))
+		}
+		if stats.Estimates {
+			fmt.Fprintf(&b, "Average of the previous %d cycle(s): %s points, %.1f work items.\n\n", len(history), formatPoints(pointsAvg), completedAvg)
+		} else {
+			fmt.Fprintf(&b, "Average of the previous %d cycle(s): %.1f work items.\n\n", len(history), completedAvg)
+		}
+	}
+	if !stats.Estimates {
+		b.WriteString("_Estimate points are left out: the project's estimates aren't cached._\n\n")
+	}
+
+	b.WriteString("## Contributors\n\n")
+	if stats.Estimates {
+		b.WriteString("| Assignee | Completed | Points | Carried over |\n|---|---:|---:|---:|\n")
+	} else {
+		b.WriteString("| Assignee | Completed | Carried over |\n|---|---:|---:|\n")
+	}
+	contributors := append([]assigneeStats(nil), stats.Assignees...)
+	sort.SliceStable(contributors, func(i, j int) bool {
+		if contributors[i].CompletedPoints != contributors[j].CompletedPoints {
+			return contributors[i].CompletedPoints > contributors[j].CompletedPoints
+		}
+		return contributors[i].Completed > contributors[j].Completed
+	})
+	for _, a := range contributors {
+		if stats.Estimates {
+			fmt.Fprintf(&b, "| %s | %d | %s | %d |\n", markdownEscape(a.Name), a.Completed, formatPoints(a.CompletedPoints), a.Open)
+		} else {
+			fmt.Fprintf(&b, "| %s | %d | %d |\n", markdownEscape(a.Name), a.Completed, a.Open)
+		}
+	}
+	b.WriteString("\n")
+
+	section := func(heading string, items []plane.WorkItem, showState bool) {
+		fmt.Fprintf(&b, "## %s (%d)\n\n", heading, len(items))
+		if len(items) == 0 {
+			b.WriteString("_None._\n\n")
+			return
+		}
+		sort.SliceStable(items, func(i, j int) bool { return items[i].SequenceID < items[j].SequenceID })
+		for i := range items {
+			item := &items[i]
+			key := fmt.Sprintf("%s-%d", lookup.projectIdentifier, item.SequenceID)
+			fmt.Fprintf(&b, "- [%s](%s) %s", key, link(item), markdownEscape(item.Name))
+			var details []string
+			if showState {
+				details = append(details, lookup.stateNames[itemStateID(item)])
+			}
+			details = append(details, strings.Join(lookup.assigneeNames(item), ", "))
+			if v := itemPoints(item, points); v > 0 {
+				details = append(details, formatPoints(v)+" pts")
+			}
+			fmt.Fprintf(&b, " — %s\n", markdownEscape(strings.Join(details, " · ")))
+		}
+		b.WriteString("\n")
+	}
+	section("Completed", completed, false)
+	section("Carried over", carried, true)
+	if len(cancelled) > 0 {
+		section("Cancelled", cancelled, false)
+	}
+	return b.String()
+}
+
+// markdownEscaper escapes the characters that would start markdown syntax
+// or end a table cell
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "|", `\|`, "<", `\<`)
+
+// markdownEscape returns s as literal markdown text
+func markdownEscape(s string) string {
+	return markdownEscaper.Replace(s)
+}
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete work items",
+	Long: `Delete one or more work items from a project.
+
+Work items are given with --id as PROJ-12, a bare sequence number (12) or a
+work item UUID; repeat --id or separate several with commas. An impact
+summary (sub-items, comments) is shown and must be confirmed unless --yes
+is passed.
+
+Deleting can't be undone through the API, so the full JSON of the work
+items and their comments is saved first to a timestamped file in the
+snapshots directory next to the configuration (e.g.
+~/.config/plane-cli/snapshots/). Its path is printed in the summary. Pass
+--no-snapshot to skip it.
+
+Examples:
+  plane-cli delete --project <project-id> --id PROJ-12
+  plane-cli delete --project <project-id> --id PROJ-12,PROJ-13 --id 27
+  plane-cli delete --project <project-id> --id PROJ-12 --yes`,
+	RunE: runDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(deleteCmd)
+
+	deleteCmd.Flags().StringP("project", "p", "", "Project identifier (required unless defaults.project is set)")
+	deleteCmd.Flags().StringSlice("id", nil, "Work items to delete: PROJ-12, 12 or a UUID (required)")
+	deleteCmd.Flags().Bool("dry-run", false, "Show what would be deleted without deleting")
+	deleteCmd.Flags().Bool("yes", false, "Skip confirmation prompt")
+	deleteCmd.Flags().Bool("no-snapshot", false, "Don't save a JSON snapshot of the work items before deleting")
+	deleteCmd.MarkFlagRequired("project")
+	deleteCmd.MarkFlagRequired("id")
+	addQueueFlag(deleteCmd, func(cmd *cobra.Command, args []string) []string {
+		refs, _ := cmd.Flags().GetStringSlice("id")
+		return refs
+	})
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	refs, _ := cmd.Flags().GetStringSlice("id")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+	noSnapshot, _ := cmd.Flags().GetBool("no-snapshot")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	identifier := ""
+	if project, err := client.GetProject(projectID); err == nil {
+		identifier = project.Identifier
+	}
+
+	fmt.Println("📥 Fetching work items...")
+	all, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	var items []plane.WorkItem
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		item, err := findWorkItemRef(all, identifier, strings.TrimSpace(ref))
+		if err != nil {
+			return err
+		}
+		if !seen[item.ID] {
+			seen[item.ID] = true
+			items = append(items, *item)
+		}
+	}
+
+	fmt.Printf("\n🗑️  Work items to delete (%d):\n", len(items))
+	for _, item := range items {
+		fmt.Printf("  %s-%d  %s\n", identifier, item.SequenceID, truncate(item.Name, 60))
+	}
+
+	impact := gatherItemImpact(client, projectID, fmt.Sprintf("Delete %d work item(s).", len(items)), items, all)
+	if dryRun {
+		impact.print()
+		fmt.Println("\n🔍 Dry run - nothing deleted.")
+		return nil
+	}
+	if yes {
+		impact.print()
+	} else {
+		confirmed, err := confirmDestructive(impact)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("❌ Deletion cancelled.")
+			return nil
+		}
+	}
+
+	snapshot := ""
+	if !noSnapshot {
+		if snapshot, err = writeItemSnapshot(client, projectID, identifier, "delete", items); err != nil {
+			return fmt.Errorf("%w (nothing deleted; pass --no-snapshot to delete without one)", err)
+		}
+	}
+
+	failed := 0
+	for _, item := range items {
+		if err := client.DeleteWorkItem(projectID, item.ID); err != nil {
+			fmt.Printf("  ❌ %s-%d: %v\n", identifier, item.SequenceID, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  ✅ Deleted %s-%d %s\n", identifier, item.SequenceID, truncate(item.Name, 50))
+	}
+
+	if snapshot != "" {
+		fmt.Printf("\n💾 Snapshot: %s\n", snapshot)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d work item(s) could not be deleted", failed, len(items))
+	}
+	fmt.Printf("\n✅ Deleted %d work item(s)\n", len(items))
+	return nil
+}
+
+// findWorkItemRef finds a work item by UUID, sequence number or PROJ-12
+// identifier. identifier is the project's identifier, used to reject keys
+// of other projects; it may be empty.
+func findWorkItemRef(items []plane.WorkItem, identifier, ref string) (*plane.WorkItem, error) {
+	seq := ref
+	if i := strings.LastIndex(ref, "-"); i > 0 {
+		if identifier != "" && !strings.EqualFold(ref[:i], identifier) {
+			// Not a key of this project; it may still be a UUID
+			seq = ""
+		} else {
+			seq = ref[i+1:]
+		}
+	}
+	n, err := strconv.Atoi(seq)
+	if err != nil {
+		n = -1
+	}
+
+	for i := range items {
+		if items[i].ID == ref || items[i].SequenceID == n {
+			return &items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("work item '%s' not found in this project", ref)
+}
@@ -0,0 +1,377 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <PROJ-123>",
+	Short: "Show how a work item's fields changed between two dates",
+	Long: `Reconstruct the field values of a work item at two points in time from
+its activity history and show what changed - useful for post-mortems of
+scope changes.
+
+Dates are interpreted as the end of that day in local time; RFC 3339
+timestamps are used as-is. --to defaults to now.
+
+Examples:
+  # What changed during May?
+  plane-cli diff PROJ-123 --from 2024-05-01 --to 2024-06-01
+
+  # Compare a past date with the current state, listing every field
+  plane-cli diff PROJ-123 --from 2024-05-01 --all`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDiff,
+}
+
+// diffFields lists the reconstructed fields in display order
+var diffFields = []string{"title", "state", "priority", "assignees", "labels", "estimate", "start_date", "target_date", "module", "cycle"}
+
+// activityFields maps activity field names to diff fields
+var activityFields = map[string]string{
+	"name":           "title",
+	"state":          "state",
+	"priority":       "priority",
+	"assignees":      "assignees",
+	"labels":         "labels",
+	"estimate_point": "estimate",
+	"start_date":     "start_date",
+	"target_date":    "target_date",
+	"modules":        "module",
+	"cycles":         "cycle",
+}
+
+// multiValueFields are fields whose activities add or remove one value at a time
+var multiValueFields = map[string]bool{
+	"assignees": true,
+	"labels":    true,
+	"module":    true,
+}
+
+// fieldSnapshot holds the values of every diff field at a point in time
+type fieldSnapshot map[string][]string
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().String("from", "", "Start date (YYYY-MM-DD or RFC 3339) (required)")
+	diffCmd.Flags().String("to", "", "End date (YYYY-MM-DD or RFC 3339, default: now)")
+	diffCmd.Flags().Bool("all", false, "Show unchanged fields too")
+	diffCmd.MarkFlagRequired("from")
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	identifier := strings.ToUpper(args[0])
+	fromStr, _ := cmd.Flags().GetString("from")
+	toStr, _ := cmd.Flags().GetString("to")
+	showAll, _ := cmd.Flags().GetBool("all")
+
+	from, err := parseDiffTime(fromStr)
+	if err != nil {
+		return fmt.Errorf("invalid --from: %w", err)
+	}
+	to := time.Now()
+	if toStr != "" {
+		if to, err = parseDiffTime(toStr); err != nil {
+			return fmt.Errorf("invalid --to: %w", err)
+		}
+	}
+	if to.Before(from) {
+		return fmt.Errorf("--to must be after --from")
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, err := client.GetWorkItemByIdentifier(identifier)
+	if err != nil {
+		return err
+	}
+	projectID := item.ProjectID
+	if projectID == "" {
+		projectID = item.Project
+	}
+
+	activities, err := client.GetWorkItemActivities(projectID, item.ID)
+	if err != nil {
+		return err
+	}
+
+	current, actors, err := currentSnapshot(client, projectID, item)
+	if err != nil {
+		return err
+	}
+
+	before := snapshotAt(current, activities, from)
+	after := snapshotAt(current, activities, to)
+
+	fmt.Printf("\n📋 %s: %s\n", identifier, item.Name)
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("Comparing %s → %s\n\n", formatDiffTime(fromStr), formatDiffTime(toStr))
+
+	if !item.CreatedAt.IsZero() && from.Before(item.CreatedAt) {
+		fmt.Printf("⚠️  The work item was created on %s, after --from.\n\n", item.CreatedAt.Local().Format("2006-01-02"))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FIELD\tFROM\tTO\t")
+	changed := 0
+	for _, field := range diffFields {
+		a, b := formatFieldValue(before[field]), formatFieldValue(after[field])
+		marker := ""
+		if a != b {
+			marker = "✏️"
+			changed++
+		} else if !showAll {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", field, a, b, marker)
+	}
+	w.Flush()
+
+	if changed == 0 {
+		fmt.Println("\nNo field changes in this period.")
+	}
+
+	// List the activity that explains the difference
+	var inRange []plane.Activity
+	descriptionEdits := 0
+	for _, a := range activities {
+		if a.CreatedAt.After(from) && !a.CreatedAt.After(to) {
+			if a.Field == "description" {
+				descriptionEdits++
+				continue
+			}
+			if _, ok := activityFields[a.Field]; ok {
+				inRange = append(inRange, a)
+			}
+		}
+	}
+
+	if len(inRange) > 0 {
+		fmt.Printf("\n🕒 Changes in this period (%d):\n", len(inRange))
+		for _, a := range inRange {
+			actor := actors[a.Actor]
+			if actor == "" {
+				actor = "unknown"
+			}
+			fmt.Printf("  %s  %-20s %s\n", a.CreatedAt.Local().Format("2006-01-02 15:04"), truncate(actor, 20), describeActivity(a))
+		}
+	}
+	if descriptionEdits > 0 {
+		fmt.Printf("\n📝 Description edited %d time(s) in this period\n", descriptionEdits)
+	}
+
+	return nil
+}
+
+// currentSnapshot builds the present field values of a work item using the
+// same display names that activity entries record. It also returns member
+// names by ID for labelling actors.
+func currentSnapshot(client *plane.Client, projectID string, item *plane.WorkItem) (fieldSnapshot, map[string]string, error) {
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get states: %w", err)
+	}
+	labels, err := client.GetLabels(projectID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+	members, err := client.GetProjectMembers(projectID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get members: %w", err)
+	}
+
+	stateNames := make(map[string]string)
+	for _, s := range states {
+		stateNames[s.ID] = s.Name
+	}
+	labelNames := make(map[string]string)
+	for _, l := range labels {
+		labelNames[l.ID] = l.Name
+	}
+	memberNames := make(map[string]string)
+	for _, m := range members {
+		memberNames[m.ID] = m.GetDisplayName()
+	}
+
+	snapshot := fieldSnapshot{
+		"title":       singleValue(item.Name),
+		"state":       singleValue(stateNames[itemStateID(item)]),
+		"priority":    singleValue(item.Priority),
+		"start_date":  singleValue(dateValue(item.StartDate)),
+		"target_date": singleValue(dateValue(item.TargetDate)),
+	}
+
+	for _, id := range itemAssigneeIDs(item) {
+		snapshot["assignees"] = append(snapshot["assignees"], nameOrID(memberNames, id))
+	}
+
+	for _, id := range itemLabelIDs(item) {
+		snapshot["labels"] = append(snapshot["labels"], nameOrID(labelNames, id))
+	}
+
+	if item.EstimatePoint != nil && *item.EstimatePoint != "" {
+		value := *item.EstimatePoint
+		if estimates, err := client.GetEstimates(projectID); err == nil {
+			for _, e := range estimates {
+				for _, p := range e.Points {
+					if p.ID == value {
+						value = p.Value
+					}
+				}
+			}
+		}
+		snapshot["estimate"] = singleValue(value)
+	}
+
+	moduleID := item.ModuleID
+	if moduleID == "" {
+		moduleID = item.Module
+	}
+	if moduleID != "" {
+		name := moduleID
+		if modules, err := client.GetModules(projectID); err == nil {
+			for _, m := range modules {
+				if m.ID == moduleID {
+					name = m.Name
+				}
+			}
+		}
+		snapshot["module"] = singleValue(name)
+	}
+
+	cycleID := item.CycleID
+	if cycleID == "" {
+		cycleID = item.Cycle
+	}
+	if cycleID != "" {
+		name := cycleID
+		if cycles, err := client.GetProjectCycles(projectID); err == nil {
+			for _, c := range cycles {
+				if c.ID == cycleID {
+					name = c.Name
+				}
+			}
+		}
+		snapshot["cycle"] = singleValue(name)
+	}
+
+	return snapshot, memberNames, nil
+}
+
+// snapshotAt rewinds the current values to time t by undoing, newest first,
+// every activity recorded after t
+func snapshotAt(current fieldSnapshot, activities []plane.Activity, t time.Time) fieldSnapshot {
+	snapshot := make(fieldSnapshot)
+	for field, values := range current {
+		snapshot[field] = append([]string(nil), values...)
+	}
+
+	for i := len(activities) - 1; i >= 0; i-- {
+		a := activities[i]
+		if !a.CreatedAt.After(t) {
+			break
+		}
+		field, ok := activityFields[a.Field]
+		if !ok {
+			continue
+		}
+
+		if !multiValueFields[field] {
+			snapshot[field] = singleValue(a.OldValue)
+			continue
+		}
+
+		// Undo an addition by removing the value, and a removal by restoring it
+		if a.NewValue != "" {
+			snapshot[field] = removeValue(snapshot[field], a.NewValue)
+		}
+		if a.OldValue != "" {
+			snapshot[field] = append(removeValue(snapshot[field], a.OldValue), a.OldValue)
+		}
+	}
+
+	return snapshot
+}
+
+// describeActivity renders an activity entry as "field: old → new"
+func describeActivity(a plane.Activity) string {
+	field := activityFields[a.Field]
+	if multiValueFields[field] {
+		if a.NewValue != "" {
+			return fmt.Sprintf("%s: + %s", field, a.NewValue)
+		}
+		return fmt.Sprintf("%s: - %s", field, a.OldValue)
+	}
+	return fmt.Sprintf("%s: %s → %s", field, emptyAsNone(a.OldValue), emptyAsNone(a.NewValue))
+}
+
+func formatFieldValue(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return truncate(strings.Join(sorted, ", "), 40)
+}
+
+func singleValue(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
+
+func removeValue(values []string, value string) []string {
+	var result []string
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func nameOrID(names map[string]string, id string) string {
+	if name, ok := names[id]; ok && name != "" {
+		return name
+	}
+	return id
+}
+
+func emptyAsNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
+
+// parseDiffTime parses a date as the end of that day, or an RFC 3339 timestamp
+func parseDiffTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	day, err := time.ParseInLocation("2006-01-02", s, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected YYYY-MM-DD or RFC 3339, got %q", s)
+	}
+	return day.Add(24*time.Hour - time.Second), nil
+}
+
+func formatDiffTime(input string) string {
+	if input == "" {
+		return "now"
+	}
+	return input
+}
@@ -0,0 +1,256 @@
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/markdown"
+	"plane-cli/internal/plane"
+)
+
+var expandChecklistCmd = &cobra.Command{
+	Use:   "expand-checklist <PROJ-42>",
+	Short: "Create sub-items from the checklist in a description",
+	Long: `Turn the checklist in a work item's description into sub-items: each
+open checklist line ("- [ ] Write docs") becomes a new work item whose
+parent is the original.
+
+With --section, only the checklist under the given heading is expanded, up
+to the next heading of the same or a higher level. A line that is entirely
+bold ("**Definition of Done**") counts as a heading too.
+
+Checked lines are skipped unless --include-checked is given. Lines for
+which the original already has a sub-item with the same title are skipped,
+so the command can be run again after the checklist grew.
+
+With --replace, the expanded checklist lines are replaced with links to
+the created sub-items. The description is rewritten from its Markdown
+form, so formatting the CLI doesn't know may be simplified.
+
+Examples:
+  plane-cli expand-checklist PROJ-42
+  plane-cli expand-checklist PROJ-42 --section "Definition Of Done" --dry-run
+  plane-cli expand-checklist PROJ-42 --replace --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExpandChecklist,
+}
+
+func init() {
+	rootCmd.AddCommand(expandChecklistCmd)
+
+	expandChecklistCmd.Flags().String("section", "", "Only expand the checklist under this heading")
+	expandChecklistCmd.Flags().Bool("include-checked", false, "Also create sub-items for checked lines")
+	expandChecklistCmd.Flags().Bool("replace", false, "Replace the expanded lines with links to the new sub-items")
+	expandChecklistCmd.Flags().Bool("dry-run", false, "Preview the sub-items without creating them")
+	expandChecklistCmd.Flags().Bool("yes", false, "Skip confirmation prompt")
+}
+
+var (
+	checklistLine  = regexp.MustCompile(`^(\s*)[-*+]\s+\[([ xX])\]\s+(.+)$`)
+	listLine       = regexp.MustCompile(`^(\s*)(?:[-*+]|\d+[.)])\s+(?:\[([ xX])\]\s+)?(.+)$`)
+	sectionHeading = regexp.MustCompile(`^(#{1,6})\s+(.+)$`)
+	boldHeading    = regexp.MustCompile(`^\*\*([^*]+)\*\*:?$`)
+)
+
+// checklistItem is a checklist line of a description
+type checklistItem struct {
+	Line    int
+	Indent  string
+	Title   string
+	Checked bool
+	Exists  bool
+	Key     string
+	ItemID  string
+}
+
+func runExpandChecklist(cmd *cobra.Command, args []string) error {
+	identifier := strings.ToUpper(args[0])
+	section, _ := cmd.Flags().GetString("section")
+	includeChecked, _ := cmd.Flags().GetBool("include-checked")
+	replace, _ := cmd.Flags().GetBool("replace")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	links, err := newItemLinker(cfg, resolveWorkspace(cmd, cfg))
+	if err != nil {
+		return err
+	}
+	// Replaced checklists link to the new sub-items whatever the configured
+	// output style is
+	links.style = linkStyleMarkdown
+
+	item, projectID, err := itemByIdentifier(client, identifier)
+	if err != nil {
+		return err
+	}
+	prefix := identifier[:strings.LastIndex(identifier, "-")+1]
+
+	lines := strings.Split(markdown.FromHTML(item.DescriptionHTML), "\n")
+	checklist, found := parseChecklist(lines, section)
+	if section != "" && !found {
+		return fmt.Errorf("%s has no section '%s'", identifier, section)
+	}
+	if len(checklist) == 0 {
+		return fmt.Errorf("%s has no checklist lines to expand", identifier)
+	}
+
+	children, err := client.GetWorkItemChildren(projectID, item.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get sub-items: %w", err)
+	}
+	existing := make(map[string]bool)
+	for _, child := range children {
+		existing[strings.ToLower(strings.TrimSpace(child.Name))] = true
+	}
+
+	var toCreate []*checklistItem
+	for _, c := range checklist {
+		c.Exists = existing[strings.ToLower(c.Title)]
+		if !c.Exists && (!c.Checked || includeChecked) {
+			toCreate = append(toCreate, c)
+		}
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Printf("☑️  Expand checklist of %s: %s\n", identifier, item.Name)
+	if section != "" {
+		fmt.Printf("Section: %s\n", section)
+	}
+	fmt.Println(strings.Repeat("=", 70))
+	for _, c := range checklist {
+		status := "✅ create"
+		switch {
+		case c.Exists:
+			status = "⏭️  sub-item exists"
+		case c.Checked && !includeChecked:
+			status = "⏭️  checked"
+		}
+		box := "[ ]"
+		if c.Checked {
+			box = "[x]"
+		}
+		fmt.Printf("  %s%s %s  %s\n", c.Indent, box, truncate(c.Title, 50), status)
+	}
+	if replace {
+		fmt.Printf("\nReplace: created lines become links in %s's description\n", identifier)
+	}
+
+	if len(toCreate) == 0 {
+		fmt.Println("\n✅ Nothing to create - every checklist line is done or already a sub-item.")
+		return nil
+	}
+	if dryRun {
+		fmt.Println("\n🔍 Dry run - no items created.")
+		return nil
+	}
+
+	if !yes {
+		confirmed, err := confirm(fmt.Sprintf("Create %d sub-items of %s?", len(toCreate), identifier))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("❌ Expansion cancelled.")
+			return nil
+		}
+	}
+
+	fmt.Println()
+	var createdKeys []string
+	for _, c := range toCreate {
+		workItem, err := client.CreateWorkItem(projectID, &plane.WorkItemCreate{Name: c.Title, Parent: item.ID})
+		if err != nil {
+			fmt.Printf("  ❌ Failed: %s - %v\n", c.Title, err)
+			continue
+		}
+		c.Key = fmt.Sprintf("%s%d", prefix, workItem.SequenceID)
+		c.ItemID = workItem.ID
+		fmt.Printf("  ✅ Created: %s %s\n", c.Key, c.Title)
+		createdKeys = append(createdKeys, c.Key)
+	}
+
+	if len(createdKeys) == 0 {
+		return fmt.Errorf("no sub-items were created")
+	}
+
+	if replace {
+		for _, c := range toCreate {
+			if c.Key == "" {
+				continue
+			}
+			ref := c.Key
+			if u := links.url(projectID, c.ItemID); u != "" {
+				ref = fmt.Sprintf("[%s](%s)", c.Key, u)
+			}
+			lines[c.Line] = fmt.Sprintf("%s- %s %s", c.Indent, ref, c.Title)
+		}
+		description := markdownToHTML(strings.Join(lines, "\n"))
+		if _, err := client.UpdateWorkItem(projectID, item.ID, &plane.WorkItemUpdate{DescriptionHTML: description}); err != nil {
+			fmt.Printf("⚠️  Warning: couldn't replace the checklist: %v\n", err)
+		}
+	}
+
+	fmt.Printf("\n✅ Created %d/%d sub-items of %s: %s\n", len(createdKeys), len(toCreate), identifier, strings.Join(createdKeys, ", "))
+	if len(createdKeys) < len(toCreate) {
+		return fmt.Errorf("%d sub-item(s) could not be created", len(toCreate)-len(createdKeys))
+	}
+	return nil
+}
+
+// parseChecklist returns the checklist lines of a Markdown description,
+// limited to the given section when it isn't empty. found reports whether
+// the section heading exists.
+func parseChecklist(lines []string, section string) (items []*checklistItem, found bool) {
+	return parseSectionList(lines, section, checklistLine)
+}
+
+// parseSectionList returns the lines matching pattern, limited to the given
+// section when it isn't empty. pattern captures the indentation, the
+// checkbox (empty for plain list items) and the title.
+func parseSectionList(lines []string, section string, pattern *regexp.Regexp) (items []*checklistItem, found bool) {
+	// inSection is the level of the matched heading, 0 outside of it; bold
+	// lines act as headings below every Markdown level
+	inSection := 0
+	if section == "" {
+		inSection = 1
+	}
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		level, title := 0, ""
+		if m := sectionHeading.FindStringSubmatch(trimmed); m != nil {
+			level, title = len(m[1]), m[2]
+		} else if m := boldHeading.FindStringSubmatch(trimmed); m != nil {
+			level, title = 7, m[1]
+		}
+		if level > 0 && section != "" {
+			title = strings.TrimSuffix(strings.TrimSpace(strings.Trim(title, "*")), ":")
+			switch {
+			case strings.EqualFold(strings.TrimSpace(title), strings.TrimSpace(section)):
+				inSection, found = level, true
+			case inSection > 0 && level <= inSection:
+				inSection = 0
+			}
+			continue
+		}
+
+		if inSection == 0 {
+			continue
+		}
+		if m := pattern.FindStringSubmatch(line); m != nil {
+			items = append(items, &checklistItem{
+				Line:    i,
+				Indent:  m[1],
+				Title:   strings.TrimSpace(m[3]),
+				Checked: strings.EqualFold(m[2], "x"),
+			})
+		}
+	}
+	return items, found
+}
@@ -0,0 +1,417 @@
+package commands
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/markdown"
+	"plane-cli/internal/plane"
+	"plane-cli/internal/query"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export work items and pages to Markdown files",
+	Long: `Export the work items (and optionally pages) of a project to Markdown files.
+
+Each work item is written to <IDENTIFIER>-<sequence>.md with its fields as
+YAML front matter. Pages are written to pages/<name>.md.
+
+Images referenced in descriptions are downloaded into an assets/ folder and
+their URLs rewritten to relative paths, so the exported docs render offline.
+
+Examples:
+  # Export all work items of a project
+  plane-cli export --project <project-id> --out ./export
+
+  # Export only open bugs
+  plane-cli export --project <project-id> --out ./bugs -q 'label:bug -group:completed'
+
+  # Include pages as well
+  plane-cli export --project <project-id> --out ./export --pages
+
+  # Keep remote image URLs instead of downloading them
+  plane-cli export --project <project-id> --out ./export --inline-assets=false
+
+  # Write each work item with your own layout
+  plane-cli export --project <project-id> --out ./export --template @item.md.tmpl
+
+  # Keep the discussion history for a migration
+  plane-cli export --project <project-id> --out ./export --comments
+
+With --comments, the comments of each work item (author, timestamp and
+body) are written to its front matter, where 'plane-cli import --comments'
+picks them up again.
+
+With --template, each work item file is rendered from the template instead
+of the default front matter and Markdown body.
+
+` + outputTemplateHelp,
+	RunE: runExport,
+}
+
+// exportFrontMatter holds the work item fields written as front matter
+type exportFrontMatter struct {
+	ID         string `yaml:"id"`
+	Identifier string `yaml:"identifier"`
+	Name       string `yaml:"name"`
+	State      string `yaml:"state,omitempty"`
+	Priority   string `yaml:"priority,omitempty"`
+	StartDate  string `yaml:"start_date,omitempty"`
+	TargetDate string `yaml:"target_date,omitempty"`
+	UpdatedAt  string `yaml:"updated_at,omitempty"`
+
+	Comments []exportComment `yaml:"comments,omitempty"`
+}
+
+// exportComment is a comment written to the front matter of a work item
+type exportComment struct {
+	Author    string `yaml:"author"`
+	CreatedAt string `yaml:"created_at"`
+	Body      string `yaml:"body"`
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+	exportCmd.Flags().String("out", "export", "Output directory")
+	exportCmd.Flags().StringP("query", "q", "", "Only export work items matching a query (see 'plane-cli list --help')")
+	exportCmd.Flags().Bool("pages", false, "Also export project pages")
+	exportCmd.Flags().Bool("inline-assets", true, "Download referenced images into assets/ and rewrite URLs")
+	exportCmd.Flags().String("template", "", "Render each work item file with a Go template")
+	exportCmd.Flags().Bool("comments", false, "Include the comments of each work item in its front matter")
+	exportCmd.MarkFlagRequired("project")
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	outDir, _ := cmd.Flags().GetString("out")
+	withPages, _ := cmd.Flags().GetBool("pages")
+	inlineAssets, _ := cmd.Flags().GetBool("inline-assets")
+	queryStr, _ := cmd.Flags().GetString("query")
+	templateStr, _ := cmd.Flags().GetString("template")
+	withComments, _ := cmd.Flags().GetBool("comments")
+
+	var tmpl *template.Template
+	if templateStr != "" {
+		if withComments {
+			return fmt.Errorf("--comments can't be combined with --template")
+		}
+		var err error
+		if tmpl, err = parseOutputTemplate(templateStr); err != nil {
+			return err
+		}
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get states: %w", err)
+	}
+	stateNames := make(map[string]string)
+	for _, s := range states {
+		stateNames[s.ID] = s.Name
+	}
+
+	fmt.Println("📥 Fetching work items...")
+	q, ctx := &query.Query{}, &query.Context{}
+	if queryStr != "" {
+		if q, err = query.Parse(queryStr); err != nil {
+			return fmt.Errorf("invalid query: %w", err)
+		}
+		if ctx, err = loadQueryContext(client, projectID, q); err != nil {
+			return err
+		}
+	}
+	items, err := fetchExportItems(client, projectID, q, ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	var lookup *itemLookup
+	if tmpl != nil {
+		if lookup, err = newItemLookup(client, projectID); err != nil {
+			return err
+		}
+	}
+
+	assets := &assetInliner{client: client, dir: filepath.Join(outDir, "assets"), files: make(map[string]string)}
+
+	var authors map[string]string
+	commentCount := 0
+	if withComments {
+		authors = make(map[string]string)
+		if members, err := client.GetWorkspaceMembers(); err == nil {
+			for _, m := range members {
+				authors[m.ID] = m.GetDisplayName()
+			}
+		}
+	}
+
+	for _, item := range items {
+		identifier := fmt.Sprintf("%s-%d", project.Identifier, item.SequenceID)
+		front := exportFrontMatter{
+			ID:         item.ID,
+			Identifier: identifier,
+			Name:       item.Name,
+			State:      stateNames[itemStateID(&item)],
+			Priority:   item.Priority,
+			StartDate:  dateValue(item.StartDate),
+			TargetDate: dateValue(item.TargetDate),
+		}
+		if !item.UpdatedAt.IsZero() {
+			front.UpdatedAt = item.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+
+		body := markdown.FromHTML(item.DescriptionHTML)
+		if inlineAssets {
+			body = assets.inline(body, "assets")
+		}
+
+		if withComments {
+			comments, err := client.ListComments(projectID, item.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get comments of %s: %w", identifier, err)
+			}
+			for _, c := range comments {
+				text := markdown.FromHTML(c.CommentHTML)
+				if inlineAssets {
+					text = assets.inline(text, "assets")
+				}
+				front.Comments = append(front.Comments, exportComment{
+					Author:    nameOrID(authors, c.Actor),
+					CreatedAt: c.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+					Body:      text,
+				})
+			}
+			commentCount += len(comments)
+		}
+
+		filename := filepath.Join(outDir, identifier+".md")
+		if tmpl != nil {
+			view := lookup.view(&item)
+			view.Description = body
+			if err := writeTemplateFile(filename, tmpl, view); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := writeMarkdownFile(filename, front, item.Name, body); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("✅ Exported %d work items\n", len(items))
+	if withComments {
+		fmt.Printf("✅ Exported %d comments\n", commentCount)
+	}
+
+	if withPages {
+		pages, err := client.GetPages(projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get pages: %w", err)
+		}
+
+		pagesDir := filepath.Join(outDir, "pages")
+		if err := os.MkdirAll(pagesDir, 0755); err != nil {
+			return fmt.Errorf("failed to create pages directory: %w", err)
+		}
+
+		used := make(map[string]bool)
+		for _, page := range pages {
+			name := slugify(page.Name)
+			if name == "" || used[name] {
+				name = strings.Trim(name+"-"+page.ID[:min(8, len(page.ID))], "-")
+			}
+			used[name] = true
+
+			body := markdown.FromHTML(page.DescriptionHTML)
+			if inlineAssets {
+				body = assets.inline(body, "../assets")
+			}
+
+			front := map[string]string{"id": page.ID, "name": page.Name}
+			if err := writeMarkdownFile(filepath.Join(pagesDir, name+".md"), front, page.Name, body); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("✅ Exported %d pages\n", len(pages))
+	}
+
+	if inlineAssets {
+		fmt.Printf("🖼️  Downloaded %d assets", len(assets.files))
+		if assets.failed > 0 {
+			fmt.Printf(" (%d failed, remote URLs kept)", assets.failed)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("\n📁 Export written to %s\n", outDir)
+	return nil
+}
+
+// writeMarkdownFile writes a Markdown document with YAML front matter
+// fetchExportItems fetches the work items to export. With a response cache
+// only IDs and update times are listed first: when every item is cached its
+// body is read from disk, otherwise the full listing is downloaded and
+// cached for the next run.
+func fetchExportItems(client *plane.Client, projectID string, q *query.Query, ctx *query.Context) ([]plane.WorkItem, error) {
+	if client.HasCache() {
+		headers, err := fetchMatchingWorkItems(client, projectID, q, ctx, []string{"updated_at"})
+		if err != nil {
+			return nil, err
+		}
+		items := make([]plane.WorkItem, 0, len(headers))
+		for _, h := range headers {
+			cached, ok := client.CachedWorkItem(h.ID, h.UpdatedAt)
+			if !ok {
+				items = nil
+				break
+			}
+			items = append(items, *cached)
+		}
+		if items != nil {
+			fmt.Printf("   %d work item(s) unchanged since the last run, using the cache\n", len(items))
+			return items, nil
+		}
+	}
+
+	items, err := fetchMatchingWorkItems(client, projectID, q, ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		client.CacheWorkItem(&items[i])
+	}
+	return items, nil
+}
+
+func writeMarkdownFile(filename string, front interface{}, title, body string) error {
+	meta, err := yaml.Marshal(front)
+	if err != nil {
+		return fmt.Errorf("failed to encode front matter: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("---\n")
+	sb.Write(meta)
+	sb.WriteString("---\n\n")
+	sb.WriteString("# " + title + "\n")
+	if body != "" {
+		sb.WriteString("\n" + body + "\n")
+	}
+
+	if err := os.WriteFile(filename, []byte(sb.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", filename, err)
+	}
+	return nil
+}
+
+// writeTemplateFile renders a work item with an output template into a file
+func writeTemplateFile(filename string, tmpl *template.Template, view workItemView) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", filename, err)
+	}
+	defer f.Close()
+	return executeItemTemplate(f, tmpl, view)
+}
+
+// assetInliner downloads images referenced from exported Markdown and
+// remembers where each URL was saved so shared images are fetched once
+type assetInliner struct {
+	client *plane.Client
+	dir    string
+	files  map[string]string
+	failed int
+}
+
+// inline downloads the images referenced by body and rewrites their URLs
+// relative to prefix. Images that fail to download keep their remote URL.
+func (a *assetInliner) inline(body, prefix string) string {
+	mapping := make(map[string]string)
+	for _, u := range markdown.ImageURLs(body) {
+		if strings.HasPrefix(u, "data:") {
+			continue
+		}
+		name, err := a.fetch(u)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: could not download %s: %v\n", u, err)
+			a.failed++
+			continue
+		}
+		mapping[u] = path.Join(prefix, name)
+	}
+	return markdown.ReplaceImageURLs(body, mapping)
+}
+
+// fetch downloads an asset once and returns its file name inside the assets folder
+func (a *assetInliner) fetch(u string) (string, error) {
+	if name, ok := a.files[u]; ok {
+		return name, nil
+	}
+
+	data, contentType, err := a.client.DownloadAsset(u)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum([]byte(u))
+	name := hex.EncodeToString(sum[:])[:12] + assetExtension(u, contentType)
+
+	if err := os.MkdirAll(a.dir, 0755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(a.dir, name), data, 0644); err != nil {
+		return "", err
+	}
+
+	a.files[u] = name
+	return name, nil
+}
+
+// assetExtension picks a file extension from the URL path or the content type
+func assetExtension(u, contentType string) string {
+	if i := strings.IndexAny(u, "?#"); i >= 0 {
+		u = u[:i]
+	}
+	if ext := path.Ext(u); ext != "" && len(ext) <= 5 {
+		return strings.ToLower(ext)
+	}
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		if exts, _ := mime.ExtensionsByType(mediaType); len(exts) > 0 {
+			return exts[0]
+		}
+	}
+	return ".bin"
+}
+
+var slugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify turns a title into a file-name friendly slug
+func slugify(s string) string {
+	return strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
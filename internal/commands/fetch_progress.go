@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"plane-cli/internal/console"
+	"plane-cli/internal/plane"
+)
+
+// progressBarWidth is the number of cells of the fetch progress bar
+const progressBarWidth = 30
+
+// fetchProgress reports on stderr how far a fetch of more than one page
+// has come. The total is known up front from a one-item preflight page.
+type fetchProgress struct {
+	total    int
+	fetched  int
+	terminal bool
+}
+
+// newFetchProgress counts the items of a pager and announces the fetch
+// when it spans several pages. It returns nil, showing nothing, for
+// single-page fetches or when the count can't be fetched; the fetch itself
+// reports any error.
+func newFetchProgress(pager *plane.WorkItemsPager) *fetchProgress {
+	total, err := pager.Count()
+	if err != nil || total <= pager.PageSize() {
+		return nil
+	}
+
+	pages := (total + pager.PageSize() - 1) / pager.PageSize()
+	p := &fetchProgress{total: total, terminal: console.IsTerminal(os.Stderr)}
+	if !p.terminal {
+		fmt.Fprintf(os.Stderr, "📥 Fetching %d work items (%d pages)...\n", total, pages)
+	}
+	p.draw()
+	return p
+}
+
+// add records a fetched page
+func (p *fetchProgress) add(n int) {
+	if p == nil {
+		return
+	}
+	p.fetched += n
+	p.draw()
+}
+
+// draw redraws the progress bar in place
+func (p *fetchProgress) draw() {
+	if p == nil || !p.terminal {
+		return
+	}
+	filled := min(p.fetched*progressBarWidth/p.total, progressBarWidth)
+	fmt.Fprintf(os.Stderr, "\r📥 Fetching work items [%s%s] %d/%d",
+		strings.Repeat("█", filled), strings.Repeat("░", progressBarWidth-filled), min(p.fetched, p.total), p.total)
+}
+
+// finish clears the progress bar
+func (p *fetchProgress) finish() {
+	if p == nil || !p.terminal {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "\r%s\r", strings.Repeat(" ", progressBarWidth+50))
+}
+
+// fetchPages fetches every page of a pager with a progress bar, keeping
+// the items keep accepts (all of them when keep is nil)
+func fetchPages(pager *plane.WorkItemsPager, keep func(*plane.WorkItem) bool) ([]plane.WorkItem, error) {
+	progress := newFetchProgress(pager)
+	defer progress.finish()
+
+	var items []plane.WorkItem
+	for pager.More() {
+		page, err := pager.Next()
+		if err != nil {
+			return nil, err
+		}
+		progress.add(len(page))
+		for i := range page {
+			if keep == nil || keep(&page[i]) {
+				items = append(items, page[i])
+			}
+		}
+	}
+	return items, nil
+}
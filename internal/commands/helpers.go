@@ -0,0 +1,150 @@
+package commands
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/cache"
+	"plane-cli/internal/config"
+	"plane-cli/internal/logging"
+	"plane-cli/internal/plane"
+)
+
+// resolveWorkspace returns the workspace slug - priority: flag > env or
+// profile (see 'workspace use') > a /workspaces/<slug> path in the base URL
+func resolveWorkspace(cmd *cobra.Command, cfg *config.Config) string {
+	workspace, _ := cmd.Flags().GetString("workspace")
+	if workspace == "" {
+		if cfg.PlaneWorkspace != "" {
+			workspace = cfg.PlaneWorkspace
+		} else {
+			workspace = config.WorkspaceFromURL(cfg.PlaneBaseURL)
+		}
+	}
+	return workspace
+}
+
+// newClientFromFlags loads the configuration and creates a Plane client
+// bound to the workspace selected for this command
+func newClientFromFlags(cmd *cobra.Command) (*config.Config, *plane.Client, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w\n\n💡 To configure the CLI, run: plane-cli configure", err)
+	}
+
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	client.SetWorkspace(resolveWorkspace(cmd, cfg))
+
+	return cfg, client, nil
+}
+
+// clientOptions returns the client options selected by global flags and the
+// configuration
+func clientOptions(cmd *cobra.Command, cfg *config.Config) []plane.ClientOption {
+	maxRetries := cfg.MaxRetries
+	if cmd.Flags().Changed("max-retries") {
+		maxRetries, _ = cmd.Flags().GetInt("max-retries")
+	}
+	options := []plane.ClientOption{
+		plane.WithContext(cmd.Context()),
+		plane.WithRetries(maxRetries),
+		plane.WithRateLimit(cfg.RateLimit),
+		plane.WithRetryNotify(func(status int, wait time.Duration, attempt, maxRetries int) {
+			fmt.Fprintf(os.Stderr, "⏳ API returned %d, retrying in %s (%d/%d)\n", status, wait.Round(100*time.Millisecond), attempt, maxRetries)
+		}),
+	}
+	pageSize := cfg.PageSize
+	if cmd.Flags().Changed("page-size") {
+		pageSize, _ = cmd.Flags().GetInt("page-size")
+	}
+	if pageSize > 0 {
+		options = append(options, plane.WithPageSize(pageSize))
+	}
+	if level := logLevel(cmd); level != logging.Quiet {
+		options = append(options, plane.WithLogger(logging.New(os.Stderr, level, cfg.PlaneAPIToken)))
+	}
+	if strict, _ := cmd.Flags().GetBool("strict"); strict {
+		options = append(options, plane.WithStrict(true))
+	}
+	if cfg.HistoryEnabled {
+		options = append(options, plane.WithChangeNotify(operations.observe(cfg)))
+	}
+	// The cache is an optimisation; commands work without it
+	if noCache, _ := cmd.Flags().GetBool("no-cache"); !noCache {
+		c, err := openResponseCache()
+		if err == nil {
+			options = append(options, plane.WithCache(c))
+		}
+		options = append(options, metadataCacheOption(cfg, c))
+	}
+	return options
+}
+
+// metadataCacheOption keeps metadata listings for their configured TTL, on
+// disk too when cache.metadata_on_disk is set and the disk cache opened
+func metadataCacheOption(cfg *config.Config, disk *cache.Disk) plane.ClientOption {
+	ttl := make(map[string]time.Duration)
+	for resource, seconds := range cfg.MetadataTTL {
+		ttl[resource] = time.Duration(seconds) * time.Second
+	}
+	if !cfg.MetadataOnDisk || disk == nil {
+		return plane.WithMetadataCache(ttl, nil)
+	}
+	return plane.WithMetadataCache(ttl, disk)
+}
+
+// logLevel returns the diagnostics level selected with --verbose or --debug
+func logLevel(cmd *cobra.Command) logging.Level {
+	if debug, _ := cmd.Flags().GetBool("debug"); debug {
+		return logging.Debug
+	}
+	if verbose, _ := cmd.Flags().GetBool("verbose"); verbose {
+		return logging.Verbose
+	}
+	return logging.Quiet
+}
+
+// apiErrorHint suggests how to fix a failed API request, based on its status
+func apiErrorHint(err error) string {
+	switch plane.StatusCode(err) {
+	case http.StatusUnauthorized:
+		return "The API token was rejected - it may have expired or been revoked. Run: plane-cli configure"
+	case http.StatusForbidden:
+		return "The API token has no access to this workspace or project. Check --workspace and your project membership."
+	case http.StatusNotFound:
+		return "Check the workspace slug, project ID and work item identifier - the API could not find them."
+	case http.StatusTooManyRequests:
+		return "The API rate limit was hit. Lower request.rate_limit in config.yaml or raise --max-retries."
+	}
+	return ""
+}
+
+// itemStateID returns the state UUID of a work item regardless of which field the API filled
+func itemStateID(item *plane.WorkItem) string {
+	if item.StateID != "" {
+		return item.StateID
+	}
+	return item.State
+}
+
+// itemAssigneeIDs returns the member IDs assigned to item
+func itemAssigneeIDs(item *plane.WorkItem) []string {
+	if len(item.AssigneeIDs) > 0 {
+		return item.AssigneeIDs
+	}
+	return item.Assignees
+}
+
+// itemLabelIDs returns the label IDs of item
+func itemLabelIDs(item *plane.WorkItem) []string {
+	if len(item.LabelIDs) > 0 {
+		return item.LabelIDs
+	}
+	return item.Labels
+}
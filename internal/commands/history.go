@@ -0,0 +1,432 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+	"plane-cli/internal/plane"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history [PROJ-123]",
+	Short: "Show the activity of a work item, or work with the local operation history",
+	Long: `With a work item, show its activity as a timeline, oldest first: when it
+was created, state, assignee and other field changes, description edits
+and comments. --since keeps the events from a date (YYYY-MM-DD), a
+timestamp, or a period before now such as 7d or 12h. Use -o json for the
+events as objects.
+
+Every command that changes data in Plane is also recorded in a local
+operation history (history.jsonl in the config directory): who ran it (the
+local user and the Plane account), when, the full command line, the work
+items and other objects it created, changed or deleted, and whether it
+succeeded. 'history export' exports it.
+
+Recording can be turned off with history.enabled: false in config.yaml.
+Values of flags naming a token, password or secret are not recorded.
+
+Examples:
+  plane-cli history PROJ-123
+  plane-cli history PROJ-123 --since 7d --no-comments
+  plane-cli history export --since 2024-01-01 --format csv > audit.csv`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runItemHistory,
+}
+
+var historyExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the operation history as CSV or JSON",
+	Long: `Export the recorded operations, oldest first, for archiving.
+
+CSV has one row per command run; the objects it touched are listed in the
+items column and those whose change failed in failed_items. JSON also
+includes every request with its HTTP status.
+
+Examples:
+  plane-cli history export --since 2024-01-01 --format csv > audit.csv
+  plane-cli history export --since 2024-01-01 --until 2024-03-31 --out 2024-Q1.csv
+  plane-cli history export --format json | jq '.[] | select(.result != "success")'`,
+	RunE: runHistoryExport,
+}
+
+func init() {
+	historyExportCmd.Flags().String("since", "", "Only operations on or after this date (YYYY-MM-DD)")
+	historyExportCmd.Flags().String("until", "", "Only operations on or before this date (YYYY-MM-DD)")
+	historyExportCmd.Flags().String("format", "csv", "Export format: csv or json")
+	historyExportCmd.Flags().String("out", "", "Write to this file instead of stdout")
+
+	historyCmd.Flags().String("since", "", "Only events from this date (YYYY-MM-DD), timestamp or period before now (e.g. 7d)")
+	historyCmd.Flags().Bool("no-comments", false, "Leave comments out of the timeline")
+
+	historyCmd.AddCommand(historyExportCmd)
+	rootCmd.AddCommand(historyCmd)
+}
+
+// Results of a recorded operation
+const (
+	historySuccess = "success"
+	historyPartial = "partial"
+	historyFailed  = "failed"
+)
+
+// historyEntry is one command run that changed data
+type historyEntry struct {
+	Time       time.Time       `json:"time"`
+	DurationMs int64           `json:"duration_ms"`
+	User       string          `json:"user"`
+	Account    string          `json:"account,omitempty"`
+	Profile    string          `json:"profile,omitempty"`
+	Workspace  string          `json:"workspace"`
+	Command    string          `json:"command"`
+	Result     string          `json:"result"`
+	Error      string          `json:"error,omitempty"`
+	Items      []string        `json:"items"`
+	Changes    []historyChange `json:"changes"`
+}
+
+// historyChange is one request of an operation
+type historyChange struct {
+	Method string `json:"method"`
+	Target string `json:"target"`
+	Status int    `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+var historyCSVHeader = []string{"time", "user", "account", "profile", "workspace", "command", "result", "items", "failed_items", "requests", "duration_ms", "error"}
+
+// operationRecorder collects the changes made by the running command
+type operationRecorder struct {
+	mu      sync.Mutex
+	cfg     *config.Config
+	changes []plane.Change
+}
+
+var operations operationRecorder
+
+// observe returns the client callback recording changes for cfg's account
+func (r *operationRecorder) observe(cfg *config.Config) plane.ChangeNotifyFunc {
+	return func(change plane.Change) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.cfg = cfg
+		r.changes = append(r.changes, change)
+	}
+}
+
+// take returns and forgets the recorded changes
+func (r *operationRecorder) take() (*config.Config, []plane.Change) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cfg, changes := r.cfg, r.changes
+	r.cfg, r.changes = nil, nil
+	return cfg, changes
+}
+
+// recordOperation appends the command that just ran to the history when it
+// changed data. A failure to record is reported but doesn't fail the
+// command, whose changes are made already.
+func recordOperation(cmd *cobra.Command, args []string, start time.Time, runErr error) {
+	cfg, changes := operations.take()
+	if cmd == nil || cfg == nil || len(changes) == 0 {
+		return
+	}
+
+	entry := historyEntry{
+		Time:       start,
+		DurationMs: time.Since(start).Milliseconds(),
+		User:       localUser(),
+		Account:    planeAccount(cfg),
+		Profile:    config.ActiveProfile(),
+		Workspace:  resolveWorkspace(cmd, cfg),
+		Command:    rootCmd.Name() + " " + shellJoin(redactArgs(args)),
+		Items:      []string{},
+	}
+	if runErr != nil {
+		entry.Error = runErr.Error()
+	}
+
+	seen := make(map[string]bool)
+	failed := 0
+	for _, c := range changes {
+		target := changeTarget(c)
+		change := historyChange{Method: c.Method, Target: target, Status: c.Status}
+		if c.Err != nil {
+			change.Error = c.Err.Error()
+			failed++
+		}
+		entry.Changes = append(entry.Changes, change)
+		if !seen[target] {
+			seen[target] = true
+			entry.Items = append(entry.Items, target)
+		}
+	}
+	switch {
+	case failed == len(changes):
+		entry.Result = historyFailed
+	case failed > 0 || runErr != nil:
+		entry.Result = historyPartial
+	default:
+		entry.Result = historySuccess
+	}
+
+	if err := appendHistory(entry); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: operation not recorded in the history: %v\n", err)
+	}
+}
+
+// workspacePath matches the workspace part of API endpoints
+var workspacePath = regexp.MustCompile(`^/?api/v1/workspaces/[^/]+/`)
+
+// changeTarget names the object a request changed by its API path below
+// the workspace, e.g. projects/<id>/work-items/<id>. Objects created by a
+// POST are named by their new ID.
+func changeTarget(c plane.Change) string {
+	target := c.Endpoint
+	if i := strings.IndexAny(target, "?#"); i >= 0 {
+		target = target[:i]
+	}
+	target = workspacePath.ReplaceAllString(target, "")
+	target = strings.Trim(target, "/")
+	if c.CreatedID != "" && !strings.HasSuffix(target, c.CreatedID) {
+		target += "/" + c.CreatedID
+	}
+	return target
+}
+
+// secretFlag matches the names of flags whose values must not be recorded
+var secretFlag = regexp.MustCompile(`(?i)^--?[a-z-]*(token|password|secret)[a-z-]*$`)
+
+// redactArgs masks the values of flags naming a token, password or secret
+func redactArgs(args []string) []string {
+	redacted := make([]string, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, _, hasValue := strings.Cut(arg, "=")
+		switch {
+		case !secretFlag.MatchString(name):
+			redacted[i] = arg
+		case hasValue:
+			redacted[i] = name + "=***"
+		default:
+			redacted[i] = arg
+			if i+1 < len(args) {
+				i++
+				redacted[i] = "***"
+			}
+		}
+	}
+	return redacted
+}
+
+// localUser returns the name of the user running the CLI on this machine
+func localUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	return os.Getenv("USERNAME")
+}
+
+// planeAccount returns the Plane account of the API token, as
+// "Name <email>", or "" when it can't be looked up
+func planeAccount(cfg *config.Config) string {
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken,
+		plane.WithTimeout(completionTimeout), plane.WithRetries(0))
+	if err != nil {
+		return ""
+	}
+	me, err := client.GetCurrentUser()
+	if err != nil {
+		return ""
+	}
+	if name := me.GetDisplayName(); name != me.Email && me.Email != "" {
+		return fmt.Sprintf("%s <%s>", name, me.Email)
+	}
+	return me.GetDisplayName()
+}
+
+// historyPath returns the location of the operation history
+func historyPath() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "history.jsonl"), nil
+}
+
+// appendHistory adds an entry to the history, one JSON object per line
+func appendHistory(entry historyEntry) error {
+	path, err := historyPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+
+	var line bytes.Buffer
+	enc := json.NewEncoder(&line)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(entry); err != nil {
+		return fmt.Errorf("failed to encode history entry: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open history: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(line.Bytes()); err != nil {
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+	return nil
+}
+
+// loadHistory reads the entries recorded between since and until; zero
+// times leave the range open. Lines that can't be read are skipped with a
+// warning, so one damaged line doesn't hide the rest.
+func loadHistory(since, until time.Time) ([]historyEntry, error) {
+	path, err := historyPath()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history: %w", err)
+	}
+	defer f.Close()
+
+	var entries []historyEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var entry historyEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: skipping line %d of %s: %v\n", n, path, err)
+			continue
+		}
+		if (!since.IsZero() && entry.Time.Before(since)) || (!until.IsZero() && !entry.Time.Before(until)) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	return entries, nil
+}
+
+func runHistoryExport(cmd *cobra.Command, args []string) error {
+	sinceStr, _ := cmd.Flags().GetString("since")
+	untilStr, _ := cmd.Flags().GetString("until")
+	format, _ := cmd.Flags().GetString("format")
+	out, _ := cmd.Flags().GetString("out")
+
+	format = strings.ToLower(format)
+	if format != "csv" && format != "json" {
+		return fmt.Errorf("invalid --format '%s' (use csv or json)", format)
+	}
+	since, err := parseScheduleDate("since", sinceStr)
+	if err != nil {
+		return err
+	}
+	until, err := parseScheduleDate("until", untilStr)
+	if err != nil {
+		return err
+	}
+	if err := validateScheduleRange(since, until); err != nil {
+		return err
+	}
+	if !until.IsZero() {
+		// The whole last day is included
+		until = until.AddDate(0, 0, 1)
+	}
+
+	entries, err := loadHistory(since, until)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", out, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if format == "json" {
+		if entries == nil {
+			entries = []historyEntry{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(entries); err != nil {
+			return fmt.Errorf("failed to write JSON: %w", err)
+		}
+	} else if err := writeHistoryCSV(w, entries); err != nil {
+		return err
+	}
+
+	if out != "" {
+		fmt.Printf("✅ Exported %d operation(s) to %s\n", len(entries), out)
+	}
+	return nil
+}
+
+// writeHistoryCSV writes one row per operation
+func writeHistoryCSV(w io.Writer, entries []historyEntry) error {
+	cw := csv.NewWriter(w)
+	cw.Write(historyCSVHeader)
+	for _, e := range entries {
+		var failed []string
+		for _, c := range e.Changes {
+			if c.Error != "" {
+				failed = append(failed, c.Target)
+			}
+		}
+		cw.Write([]string{
+			e.Time.UTC().Format(time.RFC3339),
+			e.User,
+			e.Account,
+			e.Profile,
+			e.Workspace,
+			e.Command,
+			e.Result,
+			strings.Join(e.Items, "; "),
+			strings.Join(failed, "; "),
+			strconv.Itoa(len(e.Changes)),
+			strconv.FormatInt(e.DurationMs, 10),
+			e.Error,
+		})
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/markdown"
+	"plane-cli/internal/plane"
+)
+
+// Kinds of work item history events
+const (
+	itemEventCreated = "created"
+	itemEventChange  = "change"
+	itemEventComment = "comment"
+)
+
+// itemHistoryEvent is one entry of the timeline of a work item
+type itemHistoryEvent struct {
+	Time  time.Time `json:"time"`
+	Actor string    `json:"actor"`
+	Kind  string    `json:"kind"`
+	Field string    `json:"field,omitempty"`
+	From  string    `json:"from,omitempty"`
+	To    string    `json:"to,omitempty"`
+	// Text is the comment, in markdown
+	Text string `json:"text,omitempty"`
+}
+
+func (e itemHistoryEvent) String() string {
+	switch {
+	case e.Kind == itemEventCreated:
+		return "🆕 created the work item"
+	case e.Kind == itemEventComment:
+		return "💬 " + truncate(strings.Join(strings.Fields(e.Text), " "), 80)
+	case e.Field == "description":
+		return "📝 edited the description"
+	case e.Field == "state":
+		return fmt.Sprintf("🔄 state: %s → %s", emptyAsNone(e.From), emptyAsNone(e.To))
+	case e.Field == "assignees" && e.To != "":
+		return "👤 assigned " + e.To
+	case e.Field == "assignees":
+		return "👤 unassigned " + e.From
+	case multiValueFields[e.Field] && e.To != "":
+		return fmt.Sprintf("✏️  %s: + %s", e.Field, e.To)
+	case multiValueFields[e.Field]:
+		return fmt.Sprintf("✏️  %s: - %s", e.Field, e.From)
+	default:
+		return fmt.Sprintf("✏️  %s: %s → %s", e.Field, emptyAsNone(e.From), emptyAsNone(e.To))
+	}
+}
+
+func runItemHistory(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmd.Help()
+	}
+	identifier := strings.ToUpper(args[0])
+	sinceStr, _ := cmd.Flags().GetString("since")
+	noComments, _ := cmd.Flags().GetBool("no-comments")
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+	since, err := parseHistorySince(sinceStr, time.Now())
+	if err != nil {
+		return fmt.Errorf("invalid --since: %w", err)
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	item, projectID, err := itemByIdentifier(client, identifier)
+	if err != nil {
+		return err
+	}
+	activities, err := client.GetWorkItemActivities(projectID, item.ID)
+	if err != nil {
+		return err
+	}
+	var comments []plane.Comment
+	if !noComments {
+		if comments, err = client.ListComments(projectID, item.ID); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: comments left out: %v\n", err)
+		}
+	}
+
+	memberNames := make(map[string]string)
+	members, err := client.GetProjectMembers(projectID)
+	if err != nil {
+		members, _ = client.GetWorkspaceMembers()
+	}
+	for _, m := range members {
+		memberNames[m.ID] = m.GetDisplayName()
+	}
+
+	events := []itemHistoryEvent{}
+	for _, e := range itemHistory(activities, comments, memberNames) {
+		if since.IsZero() || !e.Time.Before(since) {
+			events = append(events, e)
+		}
+	}
+
+	if format != "" {
+		return render(format, events)
+	}
+
+	fmt.Printf("\n📜 %s: %s (%d events)\n", identifier, item.Name, len(events))
+	fmt.Println(strings.Repeat("=", 70))
+	if len(events) == 0 {
+		fmt.Println("No activity in this period.")
+		return nil
+	}
+	day := ""
+	for _, e := range events {
+		t := e.Time.Local()
+		if d := t.Format("Mon 2006-01-02"); d != day {
+			if day != "" {
+				fmt.Println()
+			}
+			fmt.Println(d)
+			day = d
+		}
+		fmt.Printf("  %s  %-16s %s\n", t.Format("15:04"), truncate(e.Actor, 16), e)
+	}
+	fmt.Println()
+	return nil
+}
+
+// itemHistory merges the activities and comments of a work item into one
+// timeline, oldest first. Comment activities are left to the comments,
+// which carry the text.
+func itemHistory(activities []plane.Activity, comments []plane.Comment, memberNames map[string]string) []itemHistoryEvent {
+	actor := func(id string) string {
+		if id == "" {
+			return "unknown"
+		}
+		return nameOrID(memberNames, id)
+	}
+
+	var events []itemHistoryEvent
+	for _, a := range activities {
+		e := itemHistoryEvent{Time: a.CreatedAt, Actor: actor(a.Actor), Kind: itemEventChange, From: a.OldValue, To: a.NewValue}
+		switch {
+		case a.Field == "" && a.Verb == "created":
+			e.Kind, e.From, e.To = itemEventCreated, "", ""
+		case a.Field == "comment" || a.Field == "":
+			continue
+		case a.Field == "description":
+			e.Field, e.From, e.To = "description", "", ""
+		default:
+			e.Field = a.Field
+			if field, ok := activityFields[a.Field]; ok {
+				e.Field = field
+			}
+		}
+		events = append(events, e)
+	}
+	for _, c := range comments {
+		events = append(events, itemHistoryEvent{
+			Time:  c.CreatedAt,
+			Actor: actor(c.Actor),
+			Kind:  itemEventComment,
+			Text:  markdown.FromHTML(c.CommentHTML),
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+	return events
+}
+
+// parseHistorySince parses --since: a date (the start of that day), an RFC
+// 3339 timestamp, or a period before now such as 7d or 12h. An empty value
+// gives the zero time.
+func parseHistorySince(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if day, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return day, nil
+	}
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("expected YYYY-MM-DD, RFC 3339 or a period like 7d or 12h, got %q", s)
+}
@@ -0,0 +1,181 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"plane-cli/internal/config"
+	"plane-cli/internal/plane"
+)
+
+// defaultConfirmThreshold applies when the configuration can't be loaded
+const defaultConfirmThreshold = 10
+
+// impactSummary describes what a destructive operation will touch
+type impactSummary struct {
+	Action       string
+	Items        int
+	WithSubItems int
+	WithComments int
+	// CountOnly means the work items were counted but not checked for
+	// sub-items and comments
+	CountOnly bool
+	Notes     []string
+}
+
+// gatherItemImpact counts the affected work items that have sub-items or
+// comments. all should hold every work item of the project so children can
+// be found; comments are fetched per item.
+func gatherItemImpact(client *plane.Client, projectID, action string, items, all []plane.WorkItem) impactSummary {
+	impact := impactSummary{Action: action, Items: len(items)}
+	if len(items) == 0 {
+		return impact
+	}
+
+	fmt.Printf("🔍 Checking the impact on %d work item(s)...\n", len(items))
+
+	parents := make(map[string]bool)
+	for _, item := range all {
+		if item.ParentID != "" {
+			parents[item.ParentID] = true
+		}
+	}
+
+	failed := 0
+	for _, item := range items {
+		if parents[item.ID] {
+			impact.WithSubItems++
+		}
+		comments, err := client.ListComments(projectID, item.ID)
+		if err != nil {
+			failed++
+			continue
+		}
+		if len(comments) > 0 {
+			impact.WithComments++
+		}
+	}
+	if failed > 0 {
+		impact.Notes = append(impact.Notes, fmt.Sprintf("comments could not be checked for %d item(s)", failed))
+	}
+
+	return impact
+}
+
+// print shows the impact summary
+func (s impactSummary) print() {
+	fmt.Println("\n⚠️  Impact summary")
+	fmt.Println(strings.Repeat("-", 70))
+	fmt.Println(s.Action)
+	if s.Items > 0 {
+		fmt.Printf("  Work items affected:    %d\n", s.Items)
+		if !s.CountOnly {
+			fmt.Printf("  With sub-items:         %d\n", s.WithSubItems)
+			fmt.Printf("  With comments:          %d\n", s.WithComments)
+		}
+	}
+	for _, note := range s.Notes {
+		fmt.Printf("  • %s\n", note)
+	}
+	fmt.Println(strings.Repeat("-", 70))
+}
+
+// confirmDestructive shows the impact summary and asks for confirmation.
+// Operations touching at least the configured threshold of work items must
+// be confirmed by typing the number of affected items.
+func confirmDestructive(impact impactSummary) (bool, error) {
+	impact.print()
+
+	threshold := defaultConfirmThreshold
+	if cfg, err := config.Load(); err == nil && cfg.ConfirmThreshold > 0 {
+		threshold = cfg.ConfirmThreshold
+	}
+
+	if impact.Items < threshold {
+		return confirm("Proceed?")
+	}
+
+	answer, err := input(fmt.Sprintf("This affects %d work items. Type %d to confirm:", impact.Items, impact.Items))
+	if err != nil {
+		return false, err
+	}
+	if strings.TrimSpace(answer) != strconv.Itoa(impact.Items) {
+		fmt.Println("The number did not match.")
+		return false, nil
+	}
+	return true, nil
+}
+
+// labelDeleteImpact describes deleting a label from the work items using it
+func labelDeleteImpact(client *plane.Client, projectID string, label *plane.Label) impactSummary {
+	action := fmt.Sprintf("Delete label '%s'; it will be removed from every work item using it.", label.Name)
+
+	all, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return impactSummary{Action: action, Notes: []string{fmt.Sprintf("affected work items could not be checked: %v", err)}}
+	}
+
+	var items []plane.WorkItem
+	for _, item := range all {
+		for _, id := range itemLabelIDs(&item) {
+			if id == label.ID {
+				items = append(items, item)
+				break
+			}
+		}
+	}
+	return gatherItemImpact(client, projectID, action, items, all)
+}
+
+// moduleDeleteImpact describes deleting a module and detaching its work items
+func moduleDeleteImpact(client *plane.Client, projectID string, module *plane.Module) impactSummary {
+	action := fmt.Sprintf("Delete module '%s'; its work items will no longer belong to a module.", module.Name)
+
+	items, err := client.GetModuleWorkItems(projectID, module.ID)
+	if err != nil {
+		return impactSummary{Action: action, Notes: []string{fmt.Sprintf("affected work items could not be checked: %v", err)}}
+	}
+	all, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		all = items
+	}
+	return gatherItemImpact(client, projectID, action, items, all)
+}
+
+// stateDeleteImpact describes deleting a workflow state; Items counts the
+// work items still in the state
+func stateDeleteImpact(client *plane.Client, projectID string, state *plane.State) impactSummary {
+	action := fmt.Sprintf("Delete state '%s' (%s).", state.Name, state.Group)
+
+	all, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return impactSummary{Action: action, Notes: []string{fmt.Sprintf("affected work items could not be checked: %v", err)}}
+	}
+
+	impact := impactSummary{Action: action}
+	for i := range all {
+		if itemStateID(&all[i]) == state.ID {
+			impact.Items++
+		}
+	}
+	return impact
+}
+
+// pageDeleteImpact describes deleting a page and lists its child pages
+func pageDeleteImpact(client *plane.Client, projectID string, page *plane.Page) impactSummary {
+	impact := impactSummary{Action: fmt.Sprintf("Delete page '%s'.", page.Name)}
+
+	children, err := client.GetPageChildren(projectID, page.ID)
+	if err != nil {
+		impact.Notes = append(impact.Notes, fmt.Sprintf("child pages could not be checked: %v", err))
+		return impact
+	}
+	if len(children) > 0 {
+		impact.Notes = append(impact.Notes, fmt.Sprintf("%d child page(s) will lose their parent", len(children)))
+	}
+	if page.DescriptionHTML != "" {
+		impact.Notes = append(impact.Notes, fmt.Sprintf("%d characters of content will be lost", len(stripHTML(page.DescriptionHTML))))
+	}
+	return impact
+}
@@ -0,0 +1,300 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/plane"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import work items from a Markdown export",
+	Long: `Create work items in a project from the Markdown files written by
+'plane-cli export', e.g. to move a project to another workspace.
+
+Each work item keeps its name, state (matched by name), priority, dates and
+description. Images in the export's assets/ folder are uploaded again. The
+ID of the original item is stored as the external ID of the new one, so
+items imported earlier are skipped and 'plane-cli verify-migration' can
+pair source and target.
+
+With --comments, comments exported with 'plane-cli export --comments' are
+re-created too. They are posted by your account, so each one starts with a
+note naming its original author and time.
+
+Examples:
+  plane-cli export --project <source-id> --out ./export --comments
+  plane-cli import --project <target-id> --dir ./export --comments --dry-run
+  plane-cli import --project <target-id> --dir ./export --comments --yes`,
+	RunE: runImport,
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+
+	importCmd.Flags().StringP("project", "p", "", "Project to import into (required unless defaults.project is set)")
+	importCmd.Flags().String("dir", "export", "Directory written by 'plane-cli export'")
+	importCmd.Flags().Bool("comments", false, "Re-create exported comments with a note naming the original author")
+	importCmd.Flags().Bool("dry-run", false, "Show what would be imported without creating anything")
+	importCmd.Flags().Bool("yes", false, "Skip confirmation prompt")
+	importCmd.MarkFlagRequired("project")
+}
+
+// importExternalSource marks work items created by import
+const importExternalSource = "plane-cli-export"
+
+// importItem is an exported work item file read back for import
+type importItem struct {
+	File   string
+	Front  exportFrontMatter
+	Body   string
+	Exists bool
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	dir, _ := cmd.Flags().GetString("dir")
+	withComments, _ := cmd.Flags().GetBool("comments")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	items, err := readExportDir(dir)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("no exported work items found in %s", dir)
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get states: %w", err)
+	}
+
+	fmt.Println("📥 Fetching existing work items...")
+	existing, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+	imported := make(map[string]bool)
+	for _, item := range existing {
+		if item.ExternalID != "" {
+			imported[item.ExternalID] = true
+		}
+	}
+
+	toCreate, comments := 0, 0
+	for _, item := range items {
+		item.Exists = imported[item.Front.ID]
+		if !item.Exists {
+			toCreate++
+			comments += len(item.Front.Comments)
+		}
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Println("                    📋 IMPORT PREVIEW")
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("Project: %s (%s)\n", project.Name, project.Identifier)
+	fmt.Printf("Files in %s: %d (%d to create, %d already imported)\n\n", dir, len(items), toCreate, len(items)-toCreate)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SOURCE\tTITLE\tSTATE\tCOMMENTS\t")
+	for _, item := range items {
+		status := "✅"
+		if item.Exists {
+			status = "⏭️  exists"
+		}
+		commentCount := "-"
+		if withComments {
+			commentCount = fmt.Sprintf("%d", len(item.Front.Comments))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", item.Front.Identifier, truncate(item.Front.Name, 40),
+			emptyAsDash(item.Front.State), commentCount, status)
+	}
+	w.Flush()
+	fmt.Println(strings.Repeat("=", 70))
+
+	if toCreate == 0 {
+		fmt.Println("\n✅ Nothing to import - every work item was imported before.")
+		return nil
+	}
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no work items created.")
+		return nil
+	}
+
+	if !yes {
+		message := fmt.Sprintf("\nCreate %d work items?", toCreate)
+		if withComments {
+			message = fmt.Sprintf("\nCreate %d work items with %d comments?", toCreate, comments)
+		}
+		confirmed, err := confirm(message)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("\n❌ Import cancelled.")
+			return nil
+		}
+	}
+
+	fmt.Printf("\n🔄 Importing %d work items...\n", toCreate)
+	created, failed, commentsCreated := 0, 0, 0
+	for _, item := range items {
+		if item.Exists {
+			continue
+		}
+
+		workItem, err := importWorkItem(client, projectID, states, dir, item)
+		if err != nil {
+			fmt.Printf("  ❌ %s: %s - %v\n", item.Front.Identifier, item.Front.Name, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  ✅ %s → %s-%d %s\n", item.Front.Identifier, project.Identifier, workItem.SequenceID, item.Front.Name)
+		created++
+
+		if !withComments {
+			continue
+		}
+		for _, c := range item.Front.Comments {
+			if err := importComment(client, projectID, workItem.ID, dir, c); err != nil {
+				fmt.Printf("  ⚠️  Warning: couldn't re-create a comment by %s: %v\n", c.Author, err)
+				continue
+			}
+			commentsCreated++
+		}
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Printf("✅ Completed: %d/%d work items imported\n", created, toCreate)
+	if withComments {
+		fmt.Printf("💬 Comments: %d/%d re-created\n", commentsCreated, comments)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d work item(s) could not be imported", failed)
+	}
+	return nil
+}
+
+// readExportDir reads the work item files of an export directory, in file
+// name order. Pages and other Markdown files without an exported ID are
+// ignored.
+func readExportDir(dir string) ([]*importItem, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.md"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	sort.Strings(files)
+
+	var items []*importItem
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		item, err := parseExportFile(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if item == nil {
+			continue
+		}
+		item.File = file
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// parseExportFile splits an exported work item into its front matter and
+// Markdown body. Files without front matter or an ID give nil.
+func parseExportFile(data []byte) (*importItem, error) {
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	if !bytes.HasPrefix(data, []byte("---\n")) {
+		return nil, nil
+	}
+	end := bytes.Index(data[4:], []byte("\n---\n"))
+	if end < 0 {
+		return nil, fmt.Errorf("front matter is not closed")
+	}
+
+	item := &importItem{}
+	if err := yaml.Unmarshal(data[4:4+end+1], &item.Front); err != nil {
+		return nil, fmt.Errorf("invalid front matter: %w", err)
+	}
+	if item.Front.ID == "" || item.Front.Name == "" {
+		return nil, nil
+	}
+
+	// The body starts with the "# title" heading written by export
+	body := strings.TrimLeft(string(data[4+end+5:]), "\n")
+	if heading, rest, ok := strings.Cut(body, "\n"); ok && strings.HasPrefix(heading, "# ") {
+		body = rest
+	} else if strings.HasPrefix(body, "# ") {
+		body = ""
+	}
+	item.Body = strings.TrimSpace(body)
+	return item, nil
+}
+
+// importWorkItem creates the work item of an exported file
+func importWorkItem(client *plane.Client, projectID string, states []plane.State, dir string, item *importItem) (*plane.WorkItem, error) {
+	create := &plane.WorkItemCreate{
+		Name:           item.Front.Name,
+		Priority:       item.Front.Priority,
+		StartDate:      item.Front.StartDate,
+		TargetDate:     item.Front.TargetDate,
+		ExternalID:     item.Front.ID,
+		ExternalSource: importExternalSource,
+	}
+	if item.Front.State != "" {
+		if id, err := stateIDByName(states, item.Front.State); err == nil {
+			create.State = id
+		} else {
+			fmt.Printf("  ⚠️  %s: %v, using the default state\n", item.Front.Identifier, err)
+		}
+	}
+	if item.Body != "" {
+		body, _, err := uploadLocalImages(client, projectID, item.Body, dir)
+		if err != nil {
+			return nil, err
+		}
+		create.DescriptionHTML = markdownToHTML(body)
+	}
+	return client.CreateWorkItem(projectID, create)
+}
+
+// importComment re-creates an exported comment, starting with a note that
+// names its original author and time
+func importComment(client *plane.Client, projectID, workItemID, dir string, c exportComment) error {
+	posted := c.CreatedAt
+	if t, err := time.Parse(time.RFC3339, c.CreatedAt); err == nil {
+		posted = t.UTC().Format("2006-01-02 15:04 UTC")
+	}
+	body, _, err := uploadLocalImages(client, projectID, c.Body, dir)
+	if err != nil {
+		return err
+	}
+	text := fmt.Sprintf("_Originally posted by %s on %s_\n\n%s", c.Author, posted, body)
+	_, err = client.CreateComment(projectID, workItemID, &plane.CommentCreate{CommentHTML: markdownToHTML(text)})
+	return err
+}
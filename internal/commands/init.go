@@ -30,7 +30,7 @@ func init() {
 
 func runInit(cmd *cobra.Command, args []string) error {
 	fmt.Println("🚀 Welcome to Plane CLI!")
-	fmt.Println("Let's set up your configuration.\n")
+	fmt.Print("Let's set up your configuration.\n\n")
 
 	// Check if already initialized
 	if _, err := os.Stat(".env"); err == nil {
@@ -46,7 +46,7 @@ func runInit(cmd *cobra.Command, args []string) error {
 	// Get configuration from user
 	reader := bufio.NewReader(os.Stdin)
 
-	fmt.Println("Please provide the following information:\n")
+	fmt.Print("Please provide the following information:\n\n")
 
 	// Base URL
 	fmt.Print("Plane Base URL (e.g., https://plane.your-domain.com): ")
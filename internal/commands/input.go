@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/AlecAivazis/survey/v2"
+	"plane-cli/internal/console"
 )
 
 // input prompts the user for input and returns the result
@@ -25,6 +26,16 @@ func input(message string) (string, error) {
 	return result, nil
 }
 
+// inputPath prompts for a file path; quotes and ~ are handled the same way
+// in every shell
+func inputPath(message string) (string, error) {
+	path, err := input(message)
+	if err != nil {
+		return "", err
+	}
+	return console.CleanPath(path), nil
+}
+
 // inputWithDefault prompts for input with a default value
 func inputWithDefault(message, defaultValue string) (string, error) {
 	var result string
@@ -42,6 +53,43 @@ func inputWithDefault(message, defaultValue string) (string, error) {
 	return result, nil
 }
 
+// multilineInput prompts for text spanning several lines
+func multilineInput(message string) (string, error) {
+	var result string
+	prompt := &survey.Multiline{
+		Message: message,
+	}
+	err := survey.AskOne(prompt, &result)
+	if err != nil {
+		if err.Error() == "interrupt" {
+			return "", errors.New("cancelled by user")
+		}
+		return "", err
+	}
+	return result, nil
+}
+
+// editText opens the user's editor ($VISUAL or $EDITOR) on the given text
+// and returns the saved result
+func editText(message, text string) (string, error) {
+	var result string
+	prompt := &survey.Editor{
+		Message:       message,
+		Default:       text,
+		AppendDefault: true,
+		HideDefault:   true,
+		FileName:      "*.md",
+	}
+	err := survey.AskOne(prompt, &result)
+	if err != nil {
+		if err.Error() == "interrupt" {
+			return "", errors.New("cancelled by user")
+		}
+		return "", err
+	}
+	return result, nil
+}
+
 // passwordInput prompts for password/token input (hidden)
 func passwordInput(message string) (string, error) {
 	var result string
@@ -0,0 +1,390 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/plane"
+	"plane-cli/internal/templates"
+)
+
+var intakeFormCmd = &cobra.Command{
+	Use:   "intake-form",
+	Short: "File a work item by answering a guided set of questions",
+	Long: `Ask a configurable set of questions and submit the answers as a work
+item with the right template, labels and priority - so support engineers can
+file well-formed bug reports without learning every flag.
+
+The form is described in a YAML schema:
+
+  title: "[Bug] {{.component}}: {{.summary}}"
+  template: bug            # optional description template, rendered with the answers
+  state: Triage
+  labels: [bug]
+  priority: medium
+  questions:
+    - id: summary
+      prompt: One-line summary
+      required: true
+    - id: severity
+      prompt: How bad is it?
+      type: select
+      options:
+        - value: S1 - outage
+          priority: urgent
+        - value: S2 - degraded
+          priority: high
+        - S3 - minor
+    - id: component
+      type: select
+      options:
+        - value: API
+          labels: [backend]
+        - Web
+    - id: repro
+      prompt: Steps to reproduce
+      type: text
+
+Question types: input (default), text (multi-line), select, multiselect, confirm.
+Options may set the priority and add labels when chosen.
+
+Examples:
+  plane-cli intake-form --project <project-id> --schema bug-form.yaml`,
+	RunE: runIntakeForm,
+}
+
+// IntakeSchema describes an intake form
+type IntakeSchema struct {
+	Title     string           `yaml:"title"`
+	Template  string           `yaml:"template,omitempty"`
+	State     string           `yaml:"state,omitempty"`
+	Priority  string           `yaml:"priority,omitempty"`
+	Labels    []string         `yaml:"labels,omitempty"`
+	Questions []IntakeQuestion `yaml:"questions"`
+}
+
+// IntakeQuestion is a single question of an intake form
+type IntakeQuestion struct {
+	ID       string         `yaml:"id"`
+	Prompt   string         `yaml:"prompt,omitempty"`
+	Type     string         `yaml:"type,omitempty"`
+	Required bool           `yaml:"required,omitempty"`
+	Default  string         `yaml:"default,omitempty"`
+	Options  []IntakeOption `yaml:"options,omitempty"`
+}
+
+// IntakeOption is a choice of a select question. It can be written as a
+// plain string or as a mapping that also sets the priority and labels.
+type IntakeOption struct {
+	Value    string   `yaml:"value"`
+	Priority string   `yaml:"priority,omitempty"`
+	Labels   []string `yaml:"labels,omitempty"`
+}
+
+// UnmarshalYAML accepts both "option" and "{value: option, ...}"
+func (o *IntakeOption) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		o.Value = node.Value
+		return nil
+	}
+	type plain IntakeOption
+	return node.Decode((*plain)(o))
+}
+
+// intakeAnswers collects the answers and the effects of chosen options
+type intakeAnswers struct {
+	Values   map[string]string
+	Order    []string
+	Priority string
+	Labels   []string
+}
+
+func init() {
+	rootCmd.AddCommand(intakeFormCmd)
+
+	intakeFormCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+	intakeFormCmd.Flags().String("schema", "", "Form schema YAML file (required)")
+	intakeFormCmd.Flags().Bool("dry-run", false, "Show the work item without submitting it")
+	intakeFormCmd.MarkFlagRequired("project")
+	intakeFormCmd.MarkFlagRequired("schema")
+}
+
+func runIntakeForm(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	schemaFile, _ := cmd.Flags().GetString("schema")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	schema, err := loadIntakeSchema(schemaFile)
+	if err != nil {
+		return err
+	}
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Println("       📝 New Work Item")
+	fmt.Println(strings.Repeat("=", 70))
+
+	answers, err := askIntakeQuestions(schema.Questions)
+	if err != nil {
+		return err
+	}
+
+	title, err := renderIntakeText("title", schema.Title, answers.Values)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(title) == "" {
+		return fmt.Errorf("the form produced an empty title")
+	}
+
+	var description string
+	if schema.Template != "" {
+		mgr, err := templates.NewManager(cfg.TemplatesDir)
+		if err != nil {
+			return fmt.Errorf("failed to initialize template manager: %w", err)
+		}
+		if description, err = mgr.Render(schema.Template, answers.Values); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+	} else {
+		description = defaultIntakeDescription(schema.Questions, answers)
+	}
+
+	priority := schema.Priority
+	if answers.Priority != "" {
+		priority = answers.Priority
+	}
+	labelNames := mergeSlices(schema.Labels, answers.Labels)
+
+	fmt.Println("\n📋 Work item preview:")
+	fmt.Println(strings.Repeat("-", 70))
+	fmt.Printf("Title:    %s\n", title)
+	if priority != "" {
+		fmt.Printf("Priority: %s\n", priority)
+	}
+	if schema.State != "" {
+		fmt.Printf("State:    %s\n", schema.State)
+	}
+	if len(labelNames) > 0 {
+		fmt.Printf("Labels:   %s\n", strings.Join(labelNames, ", "))
+	}
+	fmt.Printf("\n%s\n", description)
+	fmt.Println(strings.Repeat("-", 70))
+
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - nothing submitted.")
+		return nil
+	}
+
+	confirmed, err := confirm("\nSubmit this work item?")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("\n❌ Cancelled.")
+		return nil
+	}
+
+	create := &plane.WorkItemCreate{
+		Name:            title,
+		DescriptionHTML: markdownToHTML(description),
+	}
+	if priority != "" {
+		create.Priority = plane.ParsePriorityString(priority)
+	}
+	if schema.State != "" {
+		stateID, err := client.GetStateByName(projectID, schema.State)
+		if err != nil {
+			return fmt.Errorf("invalid state '%s': %w", schema.State, err)
+		}
+		create.State = stateID
+	}
+	if len(labelNames) > 0 {
+		if create.Labels, err = newLabelResolver(client, projectID, false, false).resolve(labelNames); err != nil {
+			return err
+		}
+	}
+
+	workItem, err := client.CreateWorkItem(projectID, create)
+	if err != nil {
+		return fmt.Errorf("failed to create work item: %w", err)
+	}
+
+	fmt.Printf("\n✅ Created work item #%d: %s\n", workItem.SequenceID, workItem.Name)
+	return nil
+}
+
+// loadIntakeSchema reads and validates a form schema
+func loadIntakeSchema(filename string) (*IntakeSchema, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema: %w", err)
+	}
+
+	var schema IntakeSchema
+	if err := yaml.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	if schema.Title == "" {
+		return nil, fmt.Errorf("schema must define a title")
+	}
+	if len(schema.Questions) == 0 {
+		return nil, fmt.Errorf("schema must define at least one question")
+	}
+
+	seen := make(map[string]bool)
+	for i, q := range schema.Questions {
+		if q.ID == "" {
+			return nil, fmt.Errorf("question %d has no id", i+1)
+		}
+		if seen[q.ID] {
+			return nil, fmt.Errorf("duplicate question id '%s'", q.ID)
+		}
+		seen[q.ID] = true
+
+		switch q.Type {
+		case "", "input", "text", "confirm":
+		case "select", "multiselect":
+			if len(q.Options) == 0 {
+				return nil, fmt.Errorf("question '%s' needs options", q.ID)
+			}
+		default:
+			return nil, fmt.Errorf("question '%s' has unknown type '%s'", q.ID, q.Type)
+		}
+	}
+
+	// Catch template mistakes before asking anything
+	if _, err := template.New("title").Option("missingkey=zero").Parse(schema.Title); err != nil {
+		return nil, fmt.Errorf("invalid title template: %w", err)
+	}
+
+	return &schema, nil
+}
+
+// askIntakeQuestions asks each question in order
+func askIntakeQuestions(questions []IntakeQuestion) (*intakeAnswers, error) {
+	answers := &intakeAnswers{Values: make(map[string]string)}
+
+	for _, q := range questions {
+		prompt := q.Prompt
+		if prompt == "" {
+			prompt = q.ID
+		}
+
+		var value string
+		var err error
+		switch q.Type {
+		case "text":
+			for {
+				value, err = multilineInput(prompt + ":")
+				if err != nil || !q.Required || strings.TrimSpace(value) != "" {
+					break
+				}
+				fmt.Println("⚠️  This question is required.")
+			}
+
+		case "confirm":
+			var yes bool
+			yes, err = confirm(prompt)
+			value = "no"
+			if yes {
+				value = "yes"
+			}
+
+		case "select":
+			var idx int
+			idx, err = selectOption(prompt, optionValues(q.Options))
+			if err == nil {
+				chosen := q.Options[idx]
+				value = chosen.Value
+				if chosen.Priority != "" {
+					answers.Priority = chosen.Priority
+				}
+				answers.Labels = mergeSlices(answers.Labels, chosen.Labels)
+			}
+
+		case "multiselect":
+			var indices []int
+			indices, err = selectMultiOption(prompt, optionValues(q.Options))
+			if err == nil {
+				var chosen []string
+				for _, idx := range indices {
+					chosen = append(chosen, q.Options[idx].Value)
+					answers.Labels = mergeSlices(answers.Labels, q.Options[idx].Labels)
+				}
+				value = strings.Join(chosen, ", ")
+			}
+
+		default:
+			for {
+				value, err = inputWithDefault(prompt+":", q.Default)
+				if err != nil || !q.Required || strings.TrimSpace(value) != "" {
+					break
+				}
+				fmt.Println("⚠️  This question is required.")
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		answers.Values[q.ID] = strings.TrimSpace(value)
+		answers.Order = append(answers.Order, q.ID)
+	}
+
+	return answers, nil
+}
+
+func optionValues(options []IntakeOption) []string {
+	values := make([]string, len(options))
+	for i, o := range options {
+		values[i] = o.Value
+	}
+	return values
+}
+
+// renderIntakeText renders a Go template with the answers
+func renderIntakeText(name, text string, values map[string]string) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s template: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, values); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", name, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// defaultIntakeDescription lists every answer under its question
+func defaultIntakeDescription(questions []IntakeQuestion, answers *intakeAnswers) string {
+	var sb strings.Builder
+	for _, q := range questions {
+		value := answers.Values[q.ID]
+		if value == "" {
+			continue
+		}
+		prompt := q.Prompt
+		if prompt == "" {
+			prompt = q.ID
+		}
+		if q.Type == "text" {
+			sb.WriteString(fmt.Sprintf("## %s\n\n%s\n\n", prompt, value))
+		} else {
+			sb.WriteString(fmt.Sprintf("**%s:** %s\n\n", prompt, value))
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
@@ -14,9 +14,17 @@ var interactiveCmd = &cobra.Command{
 	Short: "Interactive mode for all Plane CLI features",
 	Long: `Launch interactive mode with a menu to access all features:
 - Work Items: Update work items with guided workflow
+- Board: Full-screen kanban board of a project
 - Modules: Create, update, delete project modules  
 - Labels: Manage project labels
 - Pages: Create and manage project pages
+- States: Manage project workflow states
+
+Type / in the main menu and press Enter to search all actions across the
+submenus, e.g. "create label", and jump straight to one.
+
+Options that create, update or delete data are hidden when your workspace
+role only allows viewing.
 
 This is the easiest way to use the CLI without remembering all commands.`,
 	RunE: runInteractive,
@@ -36,39 +44,45 @@ func runInteractive(cmd *cobra.Command, args []string) error {
 
 	if wasConfigured {
 		// User just configured the CLI, show success message
-		fmt.Println("\n✨ Configuration complete! Continuing to interactive mode...\n")
+		fmt.Print("\n✨ Configuration complete! Continuing to interactive mode...\n\n")
 	}
 
-	workspace, _ := cmd.Flags().GetString("workspace")
+	workspace := resolveWorkspace(cmd, cfg)
 
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
-
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 	client.SetWorkspace(workspace)
 
+	readOnly := detectReadOnly(client)
+
 	for {
 		fmt.Println("\n" + strings.Repeat("=", 70))
 		fmt.Println("                    🚀 PLANE CLI - INTERACTIVE MODE")
 		fmt.Println(strings.Repeat("=", 70))
+		if readOnly {
+			fmt.Println("🔒 Read-only access: options that change data are hidden")
+		}
 
-		options := []string{
-			"📋 Work Items - Update single work item",
-			"⚡ Work Items - Bulk Update multiple items",
-			"➕ Work Items - Bulk Create multiple items",
-			"📦 Modules - Manage project modules",
-			"🏷️  Labels - Manage project labels",
-			"📄 Pages - Manage project documentation",
-			"🚪 Exit",
+		entries := visibleMenuEntries([]menuEntry{
+			{paletteLabel, false, func() error { return runCommandPalette(client, readOnly) }},
+			{"📋 Work Items - Update single work item", true, func() error { return runWorkItemInteractive(client) }},
+			{"⚡ Work Items - Bulk Update multiple items", true, func() error { return runBulkUpdateInteractive(client) }},
+			{"➕ Work Items - Bulk Create multiple items", true, func() error { return runBulkCreateInteractive(client) }},
+			{"📁 Projects - Create a new project", true, func() error { return createProjectInteractive(client) }},
+			{"🗂️  Board - Kanban view of a project", false, func() error { return runBoardInteractive(client, readOnly) }},
+			{"📦 Modules - Manage project modules", false, func() error { return runModuleInteractiveSubmenu(client, readOnly) }},
+			{"🏷️  Labels - Manage project labels", false, func() error { return runLabelInteractiveSubmenu(client, readOnly) }},
+			{"📄 Pages - Manage project documentation", false, func() error { return runPageInteractiveSubmenu(client, readOnly) }},
+			{"🔀 States - Manage workflow states", false, func() error { return runStateInteractiveSubmenu(client, readOnly) }},
+		}, readOnly)
+
+		options := make([]string, 0, len(entries)+1)
+		for _, e := range entries {
+			options = append(options, e.Label)
 		}
+		options = append(options, "🚪 Exit")
 
 		idx, err := selectOption("Select an option:", options)
 		if err != nil {
@@ -79,41 +93,13 @@ func runInteractive(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		switch idx {
-		case 0:
-			if err := runWorkItemInteractive(client); err != nil {
-				fmt.Printf("\n❌ Error: %v\n", err)
-			}
-
-		case 1:
-			if err := runBulkUpdateInteractive(client); err != nil {
-				fmt.Printf("\n❌ Error: %v\n", err)
-			}
-
-		case 2:
-			if err := runBulkCreateInteractive(client); err != nil {
-				fmt.Printf("\n❌ Error: %v\n", err)
-			}
-
-		case 3:
-			if err := runModuleInteractiveSubmenu(client); err != nil {
-				fmt.Printf("\n❌ Error: %v\n", err)
-			}
-
-		case 4:
-			if err := runLabelInteractiveSubmenu(client); err != nil {
-				fmt.Printf("\n❌ Error: %v\n", err)
-			}
-
-		case 5:
-			if err := runPageInteractiveSubmenu(client); err != nil {
-				fmt.Printf("\n❌ Error: %v\n", err)
-			}
-
-		case 6:
+		if idx == len(entries) {
 			fmt.Println("\n👋 Goodbye!")
 			return nil
 		}
+		if err := entries[idx].Run(); err != nil {
+			fmt.Printf("\n❌ Error: %v\n", err)
+		}
 
 		fmt.Println("\nPress Enter to continue...")
 		input("")
@@ -133,13 +119,13 @@ func runWorkItemInteractive(client *plane.Client) error {
 	}
 
 	// Step 2: Search for Work Item
-	workItem, err := searchAndSelectWorkItem(client, project.ID, 60)
+	workItem, err := searchAndSelectWorkItem(client, project.ID, 60, nil)
 	if err != nil {
 		return err
 	}
 
 	// Step 3: Choose what to update
-	update, err := chooseUpdateFields(client, project.ID)
+	update, err := chooseUpdateFields(client, project.ID, workItem)
 	if err != nil {
 		return err
 	}
@@ -180,141 +166,84 @@ func runWorkItemInteractive(client *plane.Client) error {
 }
 
 // Module Interactive Submenu
-func runModuleInteractiveSubmenu(client *plane.Client) error {
-	// Step 1: Select Project
-	project, err := selectProjectInteractive(client)
-	if err != nil {
-		return err
-	}
-
-	for {
-		fmt.Println("\n" + strings.Repeat("-", 70))
-		fmt.Println("                    📦 MODULES")
-		fmt.Println(strings.Repeat("-", 70))
-		fmt.Printf("Project: %s\n\n", project.Name)
-
-		options := []string{
-			"List all modules",
-			"Create new module",
-			"Update module",
-			"Delete module",
-			"Back to main menu",
-		}
-
-		idx, err := selectOption("Select an action:", options)
-		if err != nil {
-			if err.Error() == "cancelled by user" {
-				return nil
-			}
-			return err
-		}
-
-		switch idx {
-		case 0:
-			if err := listModulesInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
-
-		case 1:
-			if err := createModuleInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
-
-		case 2:
-			if err := updateModuleInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
-
-		case 3:
-			if err := deleteModuleInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
+func runModuleInteractiveSubmenu(client *plane.Client, readOnly bool) error {
+	return runProjectSubmenu(client, readOnly, "📦 MODULES", moduleMenuEntries)
+}
 
-		case 4:
-			return nil
-		}
+func moduleMenuEntries(client *plane.Client, projectID string) []menuEntry {
+	return []menuEntry{
+		{"List all modules", false, func() error { return listModulesInteractive(client, projectID) }},
+		{"Create new module", true, func() error { return createModuleInteractive(client, projectID) }},
+		{"Update module", true, func() error { return updateModuleInteractive(client, projectID) }},
+		{"Delete module", true, func() error { return deleteModuleInteractive(client, projectID) }},
 	}
 }
 
 // Label Interactive Submenu
-func runLabelInteractiveSubmenu(client *plane.Client) error {
-	// Step 1: Select Project
-	project, err := selectProjectInteractive(client)
-	if err != nil {
-		return err
-	}
-
-	for {
-		fmt.Println("\n" + strings.Repeat("-", 70))
-		fmt.Println("                    🏷️  LABELS")
-		fmt.Println(strings.Repeat("-", 70))
-		fmt.Printf("Project: %s\n\n", project.Name)
-
-		options := []string{
-			"List all labels",
-			"Create new label",
-			"Update label",
-			"Delete label",
-			"Back to main menu",
-		}
-
-		idx, err := selectOption("Select an action:", options)
-		if err != nil {
-			if err.Error() == "cancelled by user" {
-				return nil
-			}
-			return err
-		}
+func runLabelInteractiveSubmenu(client *plane.Client, readOnly bool) error {
+	return runProjectSubmenu(client, readOnly, "🏷️  LABELS", labelMenuEntries)
+}
 
-		switch idx {
-		case 0:
-			if err := listLabelsInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
+func labelMenuEntries(client *plane.Client, projectID string) []menuEntry {
+	return []menuEntry{
+		{"List all labels", false, func() error { return listLabelsInteractive(client, projectID) }},
+		{"Create new label", true, func() error { return createLabelInteractive(client, projectID) }},
+		{"Update label", true, func() error { return updateLabelInteractive(client, projectID) }},
+		{"Delete label", true, func() error { return deleteLabelInteractive(client, projectID) }},
+	}
+}
 
-		case 1:
-			if err := createLabelInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
+// Page Interactive Submenu
+func runPageInteractiveSubmenu(client *plane.Client, readOnly bool) error {
+	return runProjectSubmenu(client, readOnly, "📄 PAGES", pageMenuEntries)
+}
 
-		case 2:
-			if err := updateLabelInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
+func pageMenuEntries(client *plane.Client, projectID string) []menuEntry {
+	return []menuEntry{
+		{"List all pages", false, func() error { return listPagesInteractive(client, projectID) }},
+		{"Create new page", true, func() error { return createPageInteractive(client, projectID) }},
+		{"Update page", true, func() error { return updatePageInteractive(client, projectID) }},
+		{"Delete page", true, func() error { return deletePageInteractive(client, projectID) }},
+	}
+}
 
-		case 3:
-			if err := deleteLabelInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
+// State Interactive Submenu
+func runStateInteractiveSubmenu(client *plane.Client, readOnly bool) error {
+	return runProjectSubmenu(client, readOnly, "🔀 STATES", stateMenuEntries)
+}
 
-		case 4:
-			return nil
-		}
+func stateMenuEntries(client *plane.Client, projectID string) []menuEntry {
+	return []menuEntry{
+		{"List all states", false, func() error { return listStates(client, projectID) }},
+		{"Create new state", true, func() error { return createStateInteractive(client, projectID) }},
+		{"Update state", true, func() error { return updateStateInteractive(client, projectID) }},
+		{"Delete state", true, func() error { return deleteStateInteractive(client, projectID) }},
 	}
 }
 
-// Page Interactive Submenu
-func runPageInteractiveSubmenu(client *plane.Client) error {
+// runProjectSubmenu asks for a project and then offers the actions of a
+// submenu on it until the user goes back
+func runProjectSubmenu(client *plane.Client, readOnly bool, title string, entriesFor func(*plane.Client, string) []menuEntry) error {
 	// Step 1: Select Project
 	project, err := selectProjectInteractive(client)
 	if err != nil {
 		return err
 	}
 
+	entries := visibleMenuEntries(entriesFor(client, project.ID), readOnly)
+
+	options := make([]string, 0, len(entries)+1)
+	for _, e := range entries {
+		options = append(options, e.Label)
+	}
+	options = append(options, "Back to main menu")
+
 	for {
 		fmt.Println("\n" + strings.Repeat("-", 70))
-		fmt.Println("                    📄 PAGES")
+		fmt.Println("                    " + title)
 		fmt.Println(strings.Repeat("-", 70))
 		fmt.Printf("Project: %s\n\n", project.Name)
 
-		options := []string{
-			"List all pages",
-			"Create new page",
-			"Update page",
-			"Delete page",
-			"Back to main menu",
-		}
-
 		idx, err := selectOption("Select an action:", options)
 		if err != nil {
 			if err.Error() == "cancelled by user" {
@@ -323,30 +252,12 @@ func runPageInteractiveSubmenu(client *plane.Client) error {
 			return err
 		}
 
-		switch idx {
-		case 0:
-			if err := listPagesInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
-
-		case 1:
-			if err := createPageInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
-
-		case 2:
-			if err := updatePageInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
-
-		case 3:
-			if err := deletePageInteractive(client, project.ID); err != nil {
-				fmt.Printf("❌ Error: %v\n", err)
-			}
-
-		case 4:
+		if idx == len(entries) {
 			return nil
 		}
+		if err := entries[idx].Run(); err != nil {
+			fmt.Printf("❌ Error: %v\n", err)
+		}
 	}
 }
 
@@ -362,20 +273,7 @@ func runBulkUpdateInteractive(client *plane.Client) error {
 		return err
 	}
 
-	// Fetch all work items
-	fmt.Printf("\n📥 Fetching work items from project '%s'...\n", project.Name)
-	allWorkItems, err := fetchAllWorkItemsForProject(client, project.ID)
-	if err != nil {
-		return fmt.Errorf("failed to fetch work items: %w", err)
-	}
-
-	if len(allWorkItems) == 0 {
-		return fmt.Errorf("no work items found in this project")
-	}
-
-	// Select work items
-	fmt.Printf("\nFound %d work items. Select which ones to update:\n", len(allWorkItems))
-	selectedWorkItems, err := selectMultipleWorkItemsInteractive(allWorkItems)
+	selectedWorkItems, err := selectBulkWorkItems(client, project)
 	if err != nil {
 		return err
 	}
@@ -441,9 +339,36 @@ func runBulkUpdateInteractive(client *plane.Client) error {
 		fmt.Printf("❌ Failed: %d work items\n", failCount)
 	}
 
+	offerSavedCommand(bulkUpdateCommandArgs(project.ID, selectedWorkItems, update))
+
 	return nil
 }
 
+// selectBulkWorkItems lets the user pick the work items of a project to
+// update, with the live picker on a terminal
+func selectBulkWorkItems(client *plane.Client, project *plane.Project) ([]plane.WorkItem, error) {
+	if pickerAvailable() {
+		items, err := pickProjectWorkItems(client, project.ID, nil, itemPicker{Prompt: "⚡ Select work items to update", Multi: true})
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("✓ Selected %d work items\n", len(items))
+		return items, nil
+	}
+
+	fmt.Printf("\n📥 Fetching work items from project '%s'...\n", project.Name)
+	allWorkItems, err := fetchAllWorkItemsForProject(client, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch work items: %w", err)
+	}
+	if len(allWorkItems) == 0 {
+		return nil, fmt.Errorf("no work items found in this project")
+	}
+
+	fmt.Printf("\nFound %d work items. Select which ones to update:\n", len(allWorkItems))
+	return selectMultipleWorkItemsInteractive(newChipResolver(client, project.ID), allWorkItems)
+}
+
 // chooseBulkUpdateFields allows selecting which fields to bulk update
 func chooseBulkUpdateFields(client *plane.Client, projectID string, workItems []plane.WorkItem) (*plane.WorkItemUpdate, error) {
 	update := &plane.WorkItemUpdate{}
@@ -539,13 +464,13 @@ func chooseBulkUpdateFields(client *plane.Client, projectID string, workItems []
 			}
 
 		case 4: // State
-			state, err := selectState()
+			state, err := selectState(client, projectID)
 			if err != nil {
 				continue
 			}
-			update.State = state
+			update.State = state.ID
 			hasUpdates = true
-			fmt.Printf("✓ State set to: %s\n", state)
+			fmt.Printf("✓ State set to: %s\n", state.Name)
 
 		case 5: // Priority
 			priority, err := selectPriority()
@@ -600,10 +525,7 @@ func runBulkCreateInteractive(client *plane.Client) error {
 	}
 
 	// Convert state name to UUID
-	var stateID string
-	if attrs.State != "" {
-		stateID, _ = client.GetStateByName(project.ID, attrs.State)
-	}
+	stateID, _ := resolveState(client, project.ID, attrs.State)
 
 	// Convert estimate value to UUID
 	var estimateID string
@@ -664,14 +586,14 @@ func runBulkCreateInteractive(client *plane.Client) error {
 
 	for _, title := range titles {
 		create := &plane.WorkItemCreate{
-			Name:          title,
-			Description:   attrs.Description,
-			State:         stateID,
-			Priority:      plane.ParsePriorityString(priorityStr),
-			Assignees:     attrs.Assignees,
-			Labels:        attrs.Labels,
-			EstimatePoint: estimateID,
-			Module:        attrs.Module,
+			Name:            title,
+			DescriptionHTML: markdownToHTML(attrs.Description),
+			State:           stateID,
+			Priority:        plane.ParsePriorityString(priorityStr),
+			Assignees:       attrs.Assignees,
+			Labels:          attrs.Labels,
+			EstimatePoint:   estimateID,
+			Module:          attrs.Module,
 		}
 
 		workItem, err := client.CreateWorkItem(project.ID, create)
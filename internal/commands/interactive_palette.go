@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"plane-cli/internal/plane"
+)
+
+// paletteLabel is the main menu entry that opens the command palette.
+// Typing "/" in the main menu filters the list down to it.
+const paletteLabel = "🔎 / Search all actions"
+
+// paletteEntry is an action offered by the command palette. Keywords are
+// matched like the label but not shown.
+type paletteEntry struct {
+	menuEntry
+	Keywords string
+}
+
+// paletteGroup is a submenu whose actions the palette lists directly
+type paletteGroup struct {
+	Name       string
+	Keywords   string
+	EntriesFor func(*plane.Client, string) []menuEntry
+}
+
+// paletteEntries lists every action of the interactive mode, across
+// submenus. Actions of project submenus ask for the project first.
+func paletteEntries(client *plane.Client, readOnly bool) []paletteEntry {
+	entries := []paletteEntry{
+		{menuEntry{"Work items › Update a work item", true, func() error { return runWorkItemInteractive(client) }},
+			"edit change title description state priority assignee label"},
+		{menuEntry{"Work items › Bulk update multiple items", true, func() error { return runBulkUpdateInteractive(client) }},
+			"transition move state assign assignees labels estimate module"},
+		{menuEntry{"Work items › Bulk create multiple items", true, func() error { return runBulkCreateInteractive(client) }},
+			"new add issues tasks"},
+		{menuEntry{"Work items › Kanban board", false, func() error { return runBoardInteractive(client, readOnly) }},
+			"board kanban columns drag tui"},
+		{menuEntry{"Projects › Create a new project", true, func() error { return createProjectInteractive(client) }},
+			"project new add identifier"},
+	}
+
+	groups := []paletteGroup{
+		{"Modules", "module epic", moduleMenuEntries},
+		{"Labels", "label tag", labelMenuEntries},
+		{"Pages", "page docs documentation wiki", pageMenuEntries},
+		{"States", "state status workflow", stateMenuEntries},
+	}
+	for _, g := range groups {
+		for i, e := range g.EntriesFor(client, "") {
+			entries = append(entries, paletteEntry{
+				menuEntry: menuEntry{
+					Label:    g.Name + " › " + e.Label,
+					Mutating: e.Mutating,
+					Run: func() error {
+						project, err := selectProjectInteractive(client)
+						if err != nil {
+							return err
+						}
+						return g.EntriesFor(client, project.ID)[i].Run()
+					},
+				},
+				Keywords: g.Keywords,
+			})
+		}
+	}
+	return entries
+}
+
+// runCommandPalette lets the user search all actions by typing a few
+// letters of each word, e.g. "cr lab" for "Labels › Create new label", and
+// runs the chosen one
+func runCommandPalette(client *plane.Client, readOnly bool) error {
+	var entries []paletteEntry
+	for _, e := range paletteEntries(client, readOnly) {
+		if !readOnly || !e.Mutating {
+			entries = append(entries, e)
+		}
+	}
+
+	options := make([]string, len(entries))
+	for i, e := range entries {
+		options[i] = e.Label
+	}
+
+	var idx int
+	prompt := &survey.Select{
+		Message:  "Search actions (type to filter):",
+		Options:  options,
+		PageSize: 12,
+		Filter: func(filter, value string, index int) bool {
+			return paletteMatch(filter, value+" "+entries[index].Keywords)
+		},
+	}
+	if err := survey.AskOne(prompt, &idx); err != nil {
+		if errors.Is(err, terminal.InterruptErr) {
+			return nil
+		}
+		return err
+	}
+	return entries[idx].Run()
+}
+
+// paletteMatch reports whether every word of the filter appears in text as
+// a subsequence of letters, ignoring case
+func paletteMatch(filter, text string) bool {
+	text = strings.ToLower(text)
+	for _, word := range strings.Fields(strings.ToLower(filter)) {
+		word = strings.TrimPrefix(word, "/")
+		if !isSubsequence(word, text) {
+			return false
+		}
+	}
+	return true
+}
+
+// isSubsequence reports whether the runes of s appear in t in order
+func isSubsequence(s, t string) bool {
+	rs := []rune(s)
+	i := 0
+	for _, r := range t {
+		if i < len(rs) && r == rs[i] {
+			i++
+		}
+	}
+	return i == len(rs)
+}
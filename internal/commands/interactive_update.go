@@ -9,7 +9,9 @@ import (
 
 	"plane-cli/internal/config"
 	"plane-cli/internal/fuzzy"
+	"plane-cli/internal/markdown"
 	"plane-cli/internal/plane"
+	"plane-cli/internal/query"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -23,15 +25,31 @@ var interactiveUpdateCmd = &cobra.Command{
 1. Select a project from list
 2. Search for work item by name (fuzzy matching)
 3. Select the work item to update
-4. Choose what to update (description from file, title, state, etc.)
+4. Choose what to update (description from file, title, state, parent, etc.)
 5. Apply the update
 
+The search term may include filters of the query language ('plane-cli list
+--help'), applied before matching: 'assignee:me group:started login' only
+searches open items assigned to you. --where adds filters to every search.
+
+On a terminal, steps 2 and 3 are a live picker instead: work items are
+listed as they load and narrow down as you type; --where filters them.
+
+When updating the description, the current description is shown first and
+the new text can replace it or be appended or prepended to it.
+
 Examples:
   # Start interactive update workflow
   plane-cli interactive-update
 
   # Start with specific project pre-selected
-  plane-cli interactive-update --project c20fcc54-c675-47c4-85db-a4acdde3c9e1`,
+  plane-cli interactive-update --project c20fcc54-c675-47c4-85db-a4acdde3c9e1
+
+  # Only search unfinished work items assigned to you
+  plane-cli interactive-update --where assignee=me --where group!=completed,cancelled
+
+  # Search every project of the workspace instead of picking one first
+  plane-cli interactive-update --all-projects`,
 	RunE: runInteractiveUpdate,
 }
 
@@ -42,6 +60,8 @@ func init() {
 	interactiveUpdateCmd.Flags().String("project", "", "Pre-select a project (optional)")
 	interactiveUpdateCmd.Flags().String("workspace", "", "Workspace identifier")
 	interactiveUpdateCmd.Flags().Int("min-score", 60, "Minimum fuzzy match score (0-100)")
+	interactiveUpdateCmd.Flags().Bool("all-projects", false, "Search the work items of every project instead of selecting one")
+	interactiveUpdateCmd.Flags().StringArray("where", nil, "Only search work items whose current value matches, e.g. assignee=me (repeatable)")
 }
 
 func runInteractiveUpdate(cmd *cobra.Command, args []string) error {
@@ -52,28 +72,38 @@ func runInteractiveUpdate(cmd *cobra.Command, args []string) error {
 	}
 
 	projectID, _ := cmd.Flags().GetString("project")
-	workspace, _ := cmd.Flags().GetString("workspace")
 	minScore, _ := cmd.Flags().GetInt("min-score")
+	allProjects, _ := cmd.Flags().GetBool("all-projects")
+	wheres, _ := cmd.Flags().GetStringArray("where")
 
-	// Get workspace
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
+	var where *query.Query
+	if len(wheres) > 0 {
+		if allProjects {
+			return fmt.Errorf("--where cannot be combined with --all-projects")
+		}
+		if where, err = query.ParseConditions(wheres); err != nil {
+			return fmt.Errorf("invalid --where: %w", err)
 		}
 	}
 
+	workspace := resolveWorkspace(cmd, cfg)
+
 	// Create Plane client
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 	client.SetWorkspace(workspace)
 
-	// Step 1: Select Project
+	// Steps 1 and 2: Select Project and Work Item
 	var project *plane.Project
-	if projectID == "" {
+	var workItem *plane.WorkItem
+	if allProjects {
+		if workItem, project, err = searchAndSelectWorkspaceItem(client, minScore); err != nil {
+			return err
+		}
+		projectID = project.ID
+	} else if projectID == "" {
 		project, err = selectProjectInteractive(client)
 		if err != nil {
 			return err
@@ -88,14 +118,14 @@ func runInteractiveUpdate(cmd *cobra.Command, args []string) error {
 		fmt.Printf("\n✓ Using project: %s (%s)\n", project.Name, project.Identifier)
 	}
 
-	// Step 2: Search for Work Item
-	workItem, err := searchAndSelectWorkItem(client, projectID, minScore)
-	if err != nil {
-		return err
+	if workItem == nil {
+		if workItem, err = searchAndSelectWorkItem(client, projectID, minScore, where); err != nil {
+			return err
+		}
 	}
 
 	// Step 3: Choose what to update
-	update, err := chooseUpdateFields(client, projectID)
+	update, err := chooseUpdateFields(client, projectID, workItem)
 	if err != nil {
 		return err
 	}
@@ -138,7 +168,7 @@ func runInteractiveUpdate(cmd *cobra.Command, args []string) error {
 }
 
 func selectProjectInteractive(client *plane.Client) (*plane.Project, error) {
-	projects, err := client.GetProjects()
+	projects, err := pickerProjects(client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch projects: %w", err)
 	}
@@ -170,16 +200,29 @@ func selectProjectInteractive(client *plane.Client) (*plane.Project, error) {
 	return selected, nil
 }
 
-func searchAndSelectWorkItem(client *plane.Client, projectID string, minScore int) (*plane.WorkItem, error) {
+// searchAndSelectWorkItem asks for a search term and returns the work item
+// selected among the matches. Filter terms in the search and the
+// conditions of where, which may be nil, narrow the candidates first. On a
+// terminal the live picker is shown instead, filtered by where.
+func searchAndSelectWorkItem(client *plane.Client, projectID string, minScore int, where *query.Query) (*plane.WorkItem, error) {
 	fmt.Println("\n🔍 Step 2: Find Work Item")
 
+	if pickerAvailable() {
+		items, err := pickProjectWorkItems(client, projectID, where, itemPicker{Prompt: "🔍 Find a work item"})
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("✓ Selected: %s (ID: %d)\n", items[0].Name, items[0].SequenceID)
+		return &items[0], nil
+	}
+
 	for {
 		searchTerm, err := input("Enter search term (or part of the title):")
 		if err != nil {
 			return nil, err
 		}
 
-		if searchTerm == "" {
+		if searchTerm == "" && where == nil {
 			fmt.Println("❌ Please enter a search term.")
 			continue
 		}
@@ -196,27 +239,34 @@ func searchAndSelectWorkItem(client *plane.Client, projectID string, minScore in
 			return nil, fmt.Errorf("no work items found in this project")
 		}
 
+		// Narrow the candidates with the filters before matching
+		filters, text, err := splitSearch(searchTerm, where)
+		if err != nil {
+			fmt.Printf("⚠️  Matching titles only: %v\n", err)
+		}
+		if filters != nil {
+			total := len(workItems)
+			if workItems, err = filterWorkItems(client, projectID, filters, workItems); err != nil {
+				return nil, err
+			}
+			fmt.Printf("✓ %d of %d work items match the filters\n", len(workItems), total)
+		}
+
 		// Extract titles for fuzzy matching
 		titles := make([]string, len(workItems))
 		for i, item := range workItems {
 			titles[i] = item.Name
 		}
 
-		// Find fuzzy matches
-		matcher := fuzzy.NewMatcher(minScore)
-		matches := matcher.FindMatches(searchTerm, titles)
-
-		// If no fuzzy matches, try substring matching as fallback
-		if len(matches) == 0 {
-			searchLower := strings.ToLower(searchTerm)
-			for i, title := range titles {
-				if strings.Contains(strings.ToLower(title), searchLower) {
-					matches = append(matches, fuzzy.MatchResult{
-						Index: i,
-						Score: 50, // Substring match gets 50%
-					})
-				}
+		// Find fuzzy matches, or titles containing the term; with only
+		// filters every remaining item matches
+		var matches []fuzzy.MatchResult
+		if text == "" {
+			for i := range workItems {
+				matches = append(matches, fuzzy.MatchResult{Index: i, Score: 100})
 			}
+		} else {
+			matches = matchTitles(text, titles, minScore)
 		}
 
 		if len(matches) == 0 {
@@ -233,10 +283,11 @@ func searchAndSelectWorkItem(client *plane.Client, projectID string, minScore in
 
 		// Build options from matches
 		fmt.Printf("\nFound %d match(es):\n", len(matches))
+		chips := newChipResolver(client, projectID)
 		var options []string
 		for _, match := range matches {
-			item := workItems[match.Index]
-			options = append(options, fmt.Sprintf("[%d] %s (Score: %d%%)", item.SequenceID, truncate(item.Name, 40), match.Score))
+			item := &workItems[match.Index]
+			options = append(options, fmt.Sprintf("%s (Score: %d%%)", chips.option(item, 40), match.Score))
 		}
 
 		// Get selection
@@ -254,34 +305,70 @@ func searchAndSelectWorkItem(client *plane.Client, projectID string, minScore in
 	}
 }
 
-func fetchAllWorkItemsForProject(client *plane.Client, projectID string) ([]plane.WorkItem, error) {
-	var allItems []plane.WorkItem
-	offset := 0
-	limit := 100
+// searchAndSelectWorkspaceItem searches the work items of every project and
+// returns the selected one, in full, with its project
+func searchAndSelectWorkspaceItem(client *plane.Client, minScore int) (*plane.WorkItem, *plane.Project, error) {
+	fmt.Println("\n🔍 Find a Work Item in any project")
+	index, err := buildWorkspaceIndex(client, listFields(false, false))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(index.Items) == 0 {
+		return nil, nil, fmt.Errorf("no work items found in this workspace")
+	}
 
 	for {
-		options := map[string]string{
-			"offset": fmt.Sprintf("%d", offset),
-			"limit":  fmt.Sprintf("%d", limit),
+		searchTerm, err := input("Enter search term (or part of the title):")
+		if err != nil {
+			return nil, nil, err
+		}
+		if searchTerm == "" {
+			fmt.Println("❌ Please enter a search term.")
+			continue
 		}
 
-		response, err := client.GetWorkItems(projectID, options)
-		if err != nil {
-			return nil, err
+		found := index.search(searchTerm, minScore, nil)
+		if len(found) == 0 {
+			fmt.Printf("❌ No work items found matching '%s'.\n", searchTerm)
+			retry, err := confirm("Try again?")
+			if err != nil {
+				return nil, nil, err
+			}
+			if retry {
+				continue
+			}
+			return nil, nil, fmt.Errorf("no matches found")
 		}
 
-		allItems = append(allItems, response.Results...)
+		fmt.Printf("\nFound %d match(es):\n", len(found))
+		options := make([]string, len(found))
+		for i, item := range found {
+			options[i] = fmt.Sprintf("[%s] %s · %s (Score: %d%%)", item.Key, truncate(item.Name, 50), emptyAsDash(index.States[itemStateID(&item.WorkItem)].Name), item.Score)
+		}
+		idx, err := selectOption("Select work item:", options)
+		if err != nil {
+			if err.Error() == "cancelled by user" {
+				continue
+			}
+			return nil, nil, err
+		}
 
-		if !response.NextPageResults || response.NextCursor == nil {
-			break
+		selected := found[idx]
+		project := index.Projects[selected.ProjectID]
+		item, err := client.GetWorkItem(project.ID, selected.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get work item: %w", err)
 		}
-		break // TODO: Implement cursor pagination
+		fmt.Printf("✓ Selected: %s (%s)\n", item.Name, selected.Key)
+		return item, &project, nil
 	}
+}
 
-	return allItems, nil
+func fetchAllWorkItemsForProject(client *plane.Client, projectID string) ([]plane.WorkItem, error) {
+	return fetchPages(client.WorkItemsPager(projectID, nil), nil)
 }
 
-func chooseUpdateFields(client *plane.Client, projectID string) (*plane.WorkItemUpdate, error) {
+func chooseUpdateFields(client *plane.Client, projectID string, item *plane.WorkItem) (*plane.WorkItemUpdate, error) {
 	fmt.Println("\n✏️  Step 3: What would you like to update?")
 
 	options := []string{
@@ -292,6 +379,7 @@ func chooseUpdateFields(client *plane.Client, projectID string) (*plane.WorkItem
 		"Assignees",
 		"Estimate Points",
 		"Module",
+		"Parent",
 		"Multiple fields",
 		"Cancel",
 	}
@@ -305,8 +393,8 @@ func chooseUpdateFields(client *plane.Client, projectID string) (*plane.WorkItem
 
 	switch idx {
 	case 0:
-		// Description - choose between file or direct text
-		desc, err := selectDescriptionSource()
+		// Description - replace, append or prepend, from file or direct text
+		desc, err := editDescription(client, projectID, item)
 		if err != nil {
 			return nil, err
 		}
@@ -322,11 +410,11 @@ func chooseUpdateFields(client *plane.Client, projectID string) (*plane.WorkItem
 
 	case 2:
 		// State
-		state, err := selectState()
+		state, err := selectState(client, projectID)
 		if err != nil {
 			return nil, err
 		}
-		update.State = state
+		update.State = state.ID
 
 	case 3:
 		// Priority
@@ -361,10 +449,18 @@ func chooseUpdateFields(client *plane.Client, projectID string) (*plane.WorkItem
 		update.Module = module
 
 	case 7:
-		// Multiple fields
-		return chooseMultipleFields(client, projectID)
+		// Parent
+		parent, err := selectParentWorkItem(client, projectID, item)
+		if err != nil {
+			return nil, err
+		}
+		update.Parent = parent.ID
 
 	case 8:
+		// Multiple fields
+		return chooseMultipleFields(client, projectID, item)
+
+	case 9:
 		// Cancel
 		return nil, nil
 	}
@@ -372,6 +468,58 @@ func chooseUpdateFields(client *plane.Client, projectID string) (*plane.WorkItem
 	return update, nil
 }
 
+// editDescription shows the current description of item, asks whether the
+// new text replaces it or is appended or prepended, and returns the
+// resulting description
+func editDescription(client *plane.Client, projectID string, item *plane.WorkItem) (string, error) {
+	// Listings may omit the description; fetch the item to be sure
+	current := item.DescriptionHTML
+	if full, err := client.GetWorkItem(projectID, item.ID); err == nil {
+		current = full.DescriptionHTML
+	} else {
+		fmt.Printf("⚠️  Warning: could not fetch the current description: %v\n", err)
+	}
+
+	mode := 0
+	if body := markdown.FromHTML(current); strings.TrimSpace(body) != "" {
+		fmt.Println("\n📄 Current Description")
+		fmt.Println(strings.Repeat("-", 70))
+		lines := strings.Split(body, "\n")
+		for i, line := range lines {
+			if i == 20 {
+				fmt.Printf("... (%d more lines)\n", len(lines)-20)
+				break
+			}
+			fmt.Println(line)
+		}
+		fmt.Println(strings.Repeat("-", 70))
+
+		var err error
+		mode, err = selectOption("What should happen to the current description?", []string{
+			"Replace it",
+			"Append new text after it",
+			"Prepend new text before it",
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	desc, err := selectDescriptionSource()
+	if err != nil {
+		return "", err
+	}
+	desc = markdownToHTML(desc)
+
+	switch mode {
+	case 1:
+		return current + "\n" + desc, nil
+	case 2:
+		return desc + "\n" + current, nil
+	}
+	return desc, nil
+}
+
 func selectDescriptionSource() (string, error) {
 	fmt.Println("\n📝 Update Description")
 
@@ -469,7 +617,7 @@ func selectDescriptionFile() (string, error) {
 
 	// Custom path input
 	for {
-		path, err := input("Enter path to description file:")
+		path, err := inputPath("Enter path to description file:")
 		if err != nil {
 			return "", err
 		}
@@ -492,23 +640,10 @@ func selectDescriptionFile() (string, error) {
 	}
 }
 
-func selectState() (string, error) {
+// selectState lets the user pick one of the project's states
+func selectState(client *plane.Client, projectID string) (*plane.State, error) {
 	fmt.Println("\n📊 Select State")
-
-	options := []string{
-		"Backlog",
-		"Todo",
-		"In Progress",
-		"Done",
-		"Cancelled",
-	}
-
-	idx, err := selectOption("Select state:", options)
-	if err != nil {
-		return "", err
-	}
-
-	return options[idx], nil
+	return selectStateInteractive(client, projectID, "Select state:")
 }
 
 func selectPriority() (string, error) {
@@ -536,7 +671,7 @@ func selectPriority() (string, error) {
 	return options[idx], nil
 }
 
-func chooseMultipleFields(client *plane.Client, projectID string) (*plane.WorkItemUpdate, error) {
+func chooseMultipleFields(client *plane.Client, projectID string, item *plane.WorkItem) (*plane.WorkItemUpdate, error) {
 	update := &plane.WorkItemUpdate{}
 
 	for {
@@ -560,7 +695,7 @@ func chooseMultipleFields(client *plane.Client, projectID string) (*plane.WorkIt
 
 		switch idx {
 		case 0:
-			desc, err := selectDescriptionSource()
+			desc, err := editDescription(client, projectID, item)
 			if err != nil {
 				continue
 			}
@@ -576,12 +711,12 @@ func chooseMultipleFields(client *plane.Client, projectID string) (*plane.WorkIt
 			fmt.Println("✓ Title added to update")
 
 		case 2:
-			state, err := selectState()
+			state, err := selectState(client, projectID)
 			if err != nil {
 				continue
 			}
-			update.State = state
-			fmt.Printf("✓ State set to: %s\n", state)
+			update.State = state.ID
+			fmt.Printf("✓ State set to: %s\n", state.Name)
 
 		case 3:
 			priority, err := selectPriority()
@@ -625,7 +760,7 @@ func selectAssignees(client *plane.Client, projectID string) ([]string, error) {
 	fmt.Println("\n👥 Select Assignees")
 
 	// Try to get project members first, fall back to workspace members
-	members, err := client.GetProjectMembers(projectID)
+	members, err := pickerMembers(client, projectID)
 	if err != nil || len(members) == 0 {
 		members, err = client.GetWorkspaceMembers()
 		if err != nil {
@@ -698,7 +833,7 @@ func selectEstimate() (float64, error) {
 func selectModule(client *plane.Client, projectID string) (string, error) {
 	fmt.Println("\n📦 Select Module")
 
-	modules, err := client.GetProjectModules(projectID)
+	modules, err := pickerModules(client, projectID)
 	if err != nil {
 		return "", fmt.Errorf("failed to get modules: %w", err)
 	}
@@ -748,4 +883,32 @@ func printUpdatePreview(update *plane.WorkItemUpdate) {
 	if update.Module != "" {
 		fmt.Printf("   → Module: %s\n", update.Module)
 	}
+	if update.Parent != "" {
+		fmt.Printf("   → Parent: %s\n", update.Parent)
+	}
+}
+
+// selectParentWorkItem picks the new parent of item among the other work
+// items of its project
+func selectParentWorkItem(client *plane.Client, projectID string, item *plane.WorkItem) (*plane.WorkItem, error) {
+	if pickerAvailable() {
+		items, err := pickProjectWorkItems(client, projectID, nil, itemPicker{
+			Prompt: fmt.Sprintf("🌳 Parent of [%d] %s", item.SequenceID, item.Name),
+			Keep:   func(candidate *plane.WorkItem) bool { return candidate.ID != item.ID },
+		})
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("✓ Parent: [%d] %s\n", items[0].SequenceID, items[0].Name)
+		return &items[0], nil
+	}
+
+	parent, err := searchAndSelectWorkItem(client, projectID, 60, nil)
+	if err != nil {
+		return nil, err
+	}
+	if parent.ID == item.ID {
+		return nil, fmt.Errorf("a work item can't be its own parent")
+	}
+	return parent, nil
 }
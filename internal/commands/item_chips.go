@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"plane-cli/internal/plane"
+)
+
+// projectChips holds the label names and member initials of a project
+type projectChips struct {
+	labels   map[string]string
+	initials map[string]string
+}
+
+// chipCache keeps the chips of each project for the rest of the session, so
+// repeated selection prompts in interactive mode don't fetch them again
+var chipCache = make(map[string]*projectChips)
+
+// chipResolver shows label names and assignee initials next to work items
+// in selection lists, so items with similar titles can be told apart
+type chipResolver struct {
+	chips *projectChips
+}
+
+// newChipResolver loads the chips of a project. Labels or members that
+// can't be fetched are simply not shown.
+func newChipResolver(client *plane.Client, projectID string) *chipResolver {
+	if chips, ok := chipCache[projectID]; ok {
+		return &chipResolver{chips: chips}
+	}
+
+	chips := &projectChips{labels: make(map[string]string), initials: make(map[string]string)}
+	if labels, err := client.GetLabels(projectID); err == nil {
+		for _, l := range labels {
+			chips.labels[l.ID] = l.Name
+		}
+	}
+	if members, err := client.GetProjectMembers(projectID); err == nil {
+		for _, m := range members {
+			chips.initials[m.ID] = memberInitials(m)
+		}
+	}
+	chipCache[projectID] = chips
+	return &chipResolver{chips: chips}
+}
+
+// option formats a work item for a selection list, e.g.
+// "[42] Fix login  {bug,auth} @AW"
+func (r *chipResolver) option(item *plane.WorkItem, width int) string {
+	s := fmt.Sprintf("[%d] %s", item.SequenceID, truncate(item.Name, width))
+	if chips := r.format(item); chips != "" {
+		s += "  " + chips
+	}
+	return s
+}
+
+// format returns the label and assignee chips of a work item
+func (r *chipResolver) format(item *plane.WorkItem) string {
+	if r == nil {
+		return ""
+	}
+
+	var parts []string
+	var labels []string
+	for _, id := range itemLabelIDs(item) {
+		if name, ok := r.chips.labels[id]; ok {
+			labels = append(labels, name)
+		}
+	}
+	if len(labels) > 0 {
+		parts = append(parts, "{"+strings.Join(labels, ",")+"}")
+	}
+
+	for _, id := range itemAssigneeIDs(item) {
+		if initials, ok := r.chips.initials[id]; ok {
+			parts = append(parts, "@"+initials)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// memberInitials returns the initials of a member's first and last name,
+// or the first two letters of their display name
+func memberInitials(m plane.Member) string {
+	var initials []rune
+	for _, name := range []string{m.FirstName, m.LastName} {
+		if r := []rune(strings.TrimSpace(name)); len(r) > 0 {
+			initials = append(initials, unicode.ToUpper(r[0]))
+		}
+	}
+	if len(initials) == 0 {
+		for _, r := range m.GetDisplayName() {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				initials = append(initials, unicode.ToUpper(r))
+			}
+			if len(initials) == 2 {
+				break
+			}
+		}
+	}
+	return string(initials)
+}
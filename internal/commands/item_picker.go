@@ -0,0 +1,334 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/sahilm/fuzzy"
+	"plane-cli/internal/console"
+	"plane-cli/internal/plane"
+	"plane-cli/internal/query"
+)
+
+// itemPicker is a full-screen work item picker in the style of fzf: work
+// items are listed as the pages of the listing arrive and the list narrows
+// to the fuzzy matches of what is typed
+type itemPicker struct {
+	// Prompt says what is being picked
+	Prompt string
+	// Multi lets tab mark several work items
+	Multi bool
+	// Query is the text typed in advance
+	Query string
+	// Keep, when not nil, leaves out the work items it rejects
+	Keep func(*plane.WorkItem) bool
+	// Chips, when not nil, adds labels and assignees to each row
+	Chips *chipResolver
+}
+
+// pickerAvailable reports whether the live picker can run, which needs a
+// terminal on both ends
+func pickerAvailable() bool {
+	return console.IsTerminal(os.Stdin) && console.IsTerminal(os.Stdout)
+}
+
+// pick runs the picker over the work items of pager and returns those
+// chosen. Cancelling returns an error saying so.
+func (p itemPicker) pick(pager *plane.WorkItemsPager) ([]plane.WorkItem, error) {
+	m := &pickerModel{picker: p, input: textinput.New(), marked: make(map[string]bool)}
+	m.input.Prompt = "> "
+	m.input.SetValue(p.Query)
+	m.input.Focus()
+	m.refilter()
+
+	program := tea.NewProgram(m, tea.WithAltScreen())
+	stop := make(chan struct{})
+	go func() {
+		for pager.More() {
+			page, err := pager.Next()
+			if err != nil {
+				program.Send(pickerPageMsg{err: err})
+				return
+			}
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			program.Send(pickerPageMsg{items: page, total: pager.Total(), done: !pager.More()})
+		}
+	}()
+	_, err := program.Run()
+	close(stop)
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("picker failed: %w", err)
+	case m.err != nil:
+		return nil, fmt.Errorf("failed to fetch work items: %w", m.err)
+	case m.chosen == nil:
+		return nil, errors.New("cancelled by user")
+	}
+	return m.chosen, nil
+}
+
+// pickProjectWorkItems runs the picker over the work items of a project
+// matching where, which may be nil
+func pickProjectWorkItems(client *plane.Client, projectID string, where *query.Query, p itemPicker) ([]plane.WorkItem, error) {
+	if where != nil {
+		ctx, err := loadQueryContext(client, projectID, where)
+		if err != nil {
+			return nil, err
+		}
+		keep := p.Keep
+		p.Keep = func(item *plane.WorkItem) bool {
+			return where.Match(item, ctx) && (keep == nil || keep(item))
+		}
+	}
+	if p.Chips == nil {
+		p.Chips = newChipResolver(client, projectID)
+	}
+	return p.pick(client.WorkItemsPager(projectID, nil))
+}
+
+// pickerPageMsg carries a page of the listing, or the error fetching it
+type pickerPageMsg struct {
+	items []plane.WorkItem
+	total int
+	done  bool
+	err   error
+}
+
+// pickerModel is the state of the picker program
+type pickerModel struct {
+	picker itemPicker
+	input  textinput.Model
+
+	// items are the work items received so far and texts what is matched
+	// against for each
+	items []plane.WorkItem
+	texts []string
+	// matches are the items matching the typed text, best first
+	matches        []fuzzy.Match
+	cursor, offset int
+	// marked holds the IDs of the work items marked with tab
+	marked map[string]bool
+
+	loaded, total int
+	done          bool
+	err           error
+	width, height int
+
+	// chosen is set when the user confirms
+	chosen []plane.WorkItem
+}
+
+func (m *pickerModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m *pickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.input.Width = max(m.width-30, 10)
+		m.scroll()
+	case pickerPageMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+		m.loaded += len(msg.items)
+		m.total, m.done = msg.total, msg.done
+		for i := range msg.items {
+			item := &msg.items[i]
+			if m.picker.Keep != nil && !m.picker.Keep(item) {
+				continue
+			}
+			m.items = append(m.items, *item)
+			m.texts = append(m.texts, fmt.Sprintf("[%d] %s", item.SequenceID, item.Name))
+		}
+		m.refilter()
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			return m, tea.Quit
+		case "enter":
+			m.chosen = m.selection()
+			if len(m.chosen) == 0 {
+				return m, nil
+			}
+			return m, tea.Quit
+		case "up", "ctrl+p", "ctrl+k":
+			m.move(-1)
+		case "down", "ctrl+n", "ctrl+j":
+			m.move(1)
+		case "pgup":
+			m.move(-m.rows())
+		case "pgdown":
+			m.move(m.rows())
+		case "tab":
+			if m.picker.Multi && m.cursor < len(m.matches) {
+				id := m.items[m.matches[m.cursor].Index].ID
+				m.marked[id] = !m.marked[id]
+				m.move(1)
+			}
+		case "ctrl+a":
+			if m.picker.Multi {
+				m.markAll()
+			}
+		default:
+			before := m.input.Value()
+			var cmd tea.Cmd
+			m.input, cmd = m.input.Update(msg)
+			if m.input.Value() != before {
+				m.refilter()
+			}
+			return m, cmd
+		}
+	}
+	return m, nil
+}
+
+// refilter matches the typed text against the work items. Without text
+// they are listed in the order they arrived.
+func (m *pickerModel) refilter() {
+	text := strings.TrimSpace(m.input.Value())
+	if text == "" {
+		m.matches = m.matches[:0]
+		for i, s := range m.texts {
+			m.matches = append(m.matches, fuzzy.Match{Str: s, Index: i})
+		}
+	} else {
+		m.matches = fuzzy.Find(text, m.texts)
+	}
+	m.cursor = min(m.cursor, max(len(m.matches)-1, 0))
+	m.scroll()
+}
+
+func (m *pickerModel) move(delta int) {
+	m.cursor = max(min(m.cursor+delta, len(m.matches)-1), 0)
+	m.scroll()
+}
+
+// markAll marks every match, or unmarks them when all are marked
+func (m *pickerModel) markAll() {
+	all := slices.ContainsFunc(m.matches, func(match fuzzy.Match) bool { return !m.marked[m.items[match.Index].ID] })
+	for _, match := range m.matches {
+		m.marked[m.items[match.Index].ID] = all
+	}
+}
+
+// markedItems returns the marked work items
+func (m *pickerModel) markedItems() []plane.WorkItem {
+	var marked []plane.WorkItem
+	for _, item := range m.items {
+		if m.marked[item.ID] {
+			marked = append(marked, item)
+		}
+	}
+	return marked
+}
+
+// selection returns the marked work items, or the one under the cursor
+// when none are
+func (m *pickerModel) selection() []plane.WorkItem {
+	chosen := m.markedItems()
+	if len(chosen) == 0 && m.cursor < len(m.matches) {
+		chosen = append(chosen, m.items[m.matches[m.cursor].Index])
+	}
+	return chosen
+}
+
+// rows is the number of work items that fit the screen
+func (m *pickerModel) rows() int {
+	return max(m.height-4, 1)
+}
+
+func (m *pickerModel) scroll() {
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+m.rows() {
+		m.offset = m.cursor - m.rows() + 1
+	}
+}
+
+var pickerMatchStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("6"))
+
+func (m *pickerModel) View() string {
+	if m.width == 0 {
+		return ""
+	}
+
+	count := fmt.Sprintf("%d/%d", len(m.matches), len(m.items))
+	if !m.done {
+		count += fmt.Sprintf("  📥 %d", m.loaded)
+		if m.total > 0 {
+			count += fmt.Sprintf(" of %d", m.total)
+		}
+	}
+	if marked := len(m.markedItems()); marked > 0 {
+		count += fmt.Sprintf("  (%d marked)", marked)
+	}
+	lines := []string{
+		boardHeaderStyle.Render(m.picker.Prompt) + "  " + boardDimStyle.Render(count),
+		m.input.View(),
+	}
+
+	end := min(m.offset+m.rows(), len(m.matches))
+	for i := m.offset; i < end; i++ {
+		match := m.matches[i]
+		item := &m.items[match.Index]
+		mark := "  "
+		if m.marked[item.ID] {
+			mark = "● "
+		}
+		row := highlightMatch(match)
+		if chips := m.picker.Chips.format(item); chips != "" {
+			row += "  " + boardDimStyle.Render(chips)
+		}
+		row = ansi.Truncate(mark+row, m.width-2, "…")
+		if i == m.cursor {
+			row = "▸ " + boardSelectedStyle.Render(ansi.Strip(row))
+		} else {
+			row = "  " + row
+		}
+		lines = append(lines, row)
+	}
+	if len(m.matches) == 0 && m.done {
+		lines = append(lines, boardDimStyle.Render("  No matching work items"))
+	}
+
+	help := "type to filter  ↑/↓ move  enter choose  esc cancel"
+	if m.picker.Multi {
+		help = "type to filter  ↑/↓ move  tab mark  ctrl+a mark all  enter choose  esc cancel"
+	}
+	for len(lines) < m.height-1 {
+		lines = append(lines, "")
+	}
+	return strings.Join(append(lines, boardDimStyle.Render(help)), "\n")
+}
+
+// highlightMatch renders the matched text with the matched characters
+// emphasised
+func highlightMatch(match fuzzy.Match) string {
+	if len(match.MatchedIndexes) == 0 {
+		return match.Str
+	}
+	var b strings.Builder
+	for i, r := range match.Str {
+		if slices.Contains(match.MatchedIndexes, i) {
+			b.WriteString(pickerMatchStyle.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
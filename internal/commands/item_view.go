@@ -0,0 +1,228 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"plane-cli/internal/markdown"
+	"plane-cli/internal/plane"
+)
+
+const outputTemplateHelp = `Output templates (--template) are Go templates executed once per work item:
+  {{.Key}} {{.Identifier}} {{.SequenceID}} {{.ID}} {{.Name}} {{.State}}
+  {{.StateGroup}} {{.Priority}} {{.Assignees}} {{.Labels}} {{.StartDate}}
+  {{.TargetDate}} {{.CreatedAt}} {{.UpdatedAt}} {{.Description}} {{.Item}}
+Functions: join, upper, lower, truncate, pad, date, json.
+Prefix the value with @ to read the template from a file.`
+
+// itemLookup resolves the IDs on work items to display names
+type itemLookup struct {
+	projectName       string
+	projectIdentifier string
+	stateNames        map[string]string
+	stateGroups       map[string]string
+	stateColors       map[string]string
+	labelNames        map[string]string
+	labelColors       map[string]string
+	memberNames       map[string]string
+}
+
+func newItemLookup(client *plane.Client, projectID string) (*itemLookup, error) {
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get states: %w", err)
+	}
+	labels, err := client.GetLabels(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+	members, err := client.GetProjectMembers(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members: %w", err)
+	}
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		project = nil
+	}
+	return buildItemLookup(projectID, project, states, labels, members), nil
+}
+
+// buildItemLookup indexes project metadata already at hand. Without the
+// project its ID is shown as its name and identifier.
+func buildItemLookup(projectID string, project *plane.Project, states []plane.State, labels []plane.Label, members []plane.Member) *itemLookup {
+	l := &itemLookup{
+		projectName:       projectID,
+		projectIdentifier: projectID,
+		stateNames:        make(map[string]string),
+		stateGroups:       make(map[string]string),
+		stateColors:       make(map[string]string),
+		labelNames:        make(map[string]string),
+		labelColors:       make(map[string]string),
+		memberNames:       make(map[string]string),
+	}
+	if project != nil {
+		l.projectName = project.Name
+		l.projectIdentifier = project.Identifier
+	}
+	for _, s := range states {
+		l.stateNames[s.ID] = s.Name
+		l.stateGroups[s.ID] = s.Group
+		l.stateColors[s.ID] = s.Color
+	}
+	for _, lb := range labels {
+		l.labelNames[lb.ID] = lb.Name
+		l.labelColors[lb.ID] = lb.Color
+	}
+	for _, m := range members {
+		l.memberNames[m.ID] = m.GetDisplayName()
+	}
+	return l
+}
+
+func (l *itemLookup) stateGroup(item *plane.WorkItem) string {
+	return l.stateGroups[itemStateID(item)]
+}
+
+// stateColor returns the hex color of the item's state, or ""
+func (l *itemLookup) stateColor(item *plane.WorkItem) string {
+	return l.stateColors[itemStateID(item)]
+}
+
+// labelColorList returns the hex colors of the item's labels, in the order
+// of their names in view
+func (l *itemLookup) labelColorList(item *plane.WorkItem) []string {
+	ids := itemLabelIDs(item)
+	colors := make([]string, len(ids))
+	for i, id := range ids {
+		colors[i] = l.labelColors[id]
+	}
+	return colors
+}
+
+// assigneeNames returns the assignee names, or unassignedLabel if there are none
+func (l *itemLookup) assigneeNames(item *plane.WorkItem) []string {
+	names := l.names(itemAssigneeIDs(item), l.memberNames)
+	if len(names) == 0 {
+		return []string{unassignedLabel}
+	}
+	return names
+}
+
+func (l *itemLookup) names(ids []string, names map[string]string) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = nameOrID(names, id)
+	}
+	return result
+}
+
+// workItemView is the data passed to output templates
+type workItemView struct {
+	ID          string
+	Key         string
+	Identifier  string
+	SequenceID  int
+	Name        string
+	State       string
+	StateGroup  string
+	Priority    string
+	Assignees   []string
+	Labels      []string
+	StartDate   string
+	TargetDate  string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Description string
+	Item        *plane.WorkItem
+}
+
+// view builds the template data for a work item
+func (l *itemLookup) view(item *plane.WorkItem) workItemView {
+	return workItemView{
+		ID:          item.ID,
+		Key:         fmt.Sprintf("%s-%d", l.projectIdentifier, item.SequenceID),
+		Identifier:  l.projectIdentifier,
+		SequenceID:  item.SequenceID,
+		Name:        item.Name,
+		State:       nameOrID(l.stateNames, itemStateID(item)),
+		StateGroup:  l.stateGroup(item),
+		Priority:    item.Priority,
+		Assignees:   l.names(itemAssigneeIDs(item), l.memberNames),
+		Labels:      l.names(itemLabelIDs(item), l.labelNames),
+		StartDate:   dateValue(item.StartDate),
+		TargetDate:  dateValue(item.TargetDate),
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+		Description: markdown.FromHTML(item.DescriptionHTML),
+		Item:        item,
+	}
+}
+
+var outputTemplateFuncs = template.FuncMap{
+	"join":     func(sep string, values []string) string { return strings.Join(values, sep) },
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"truncate": func(n int, s string) string { return truncate(s, n) },
+	"pad":      func(n int, s string) string { return fmt.Sprintf("%-*s", n, s) },
+	"date": func(layout string, t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Local().Format(layout)
+	},
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// parseOutputTemplate parses a --template value. A value starting with @ is
+// read from a file.
+func parseOutputTemplate(text string) (*template.Template, error) {
+	if strings.HasPrefix(text, "@") {
+		data, err := os.ReadFile(text[1:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template: %w", err)
+		}
+		text = string(data)
+	}
+
+	tmpl, err := template.New("output").Funcs(outputTemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// executeItemTemplate renders one work item, ending the output with a newline
+func executeItemTemplate(w io.Writer, tmpl *template.Template, view workItemView) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, view); err != nil {
+		return fmt.Errorf("failed to render template: %w", err)
+	}
+	if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// printItemsWithTemplate renders every work item to stdout
+func printItemsWithTemplate(client *plane.Client, projectID string, items []plane.WorkItem, tmpl *template.Template) error {
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+	for i := range items {
+		if err := executeItemTemplate(os.Stdout, tmpl, lookup.view(&items[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
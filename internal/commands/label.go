@@ -71,18 +71,18 @@ func init() {
 	labelCmd.AddCommand(labelInteractiveCmd)
 
 	// List flags
-	labelListCmd.Flags().String("project", "", "Project identifier (required)")
+	labelListCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	labelListCmd.MarkFlagRequired("project")
 
 	// Create flags
-	labelCreateCmd.Flags().String("project", "", "Project identifier (required)")
+	labelCreateCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	labelCreateCmd.Flags().String("name", "", "Label name (required)")
 	labelCreateCmd.Flags().String("color", "", "Label color (hex code, e.g., #ff0000)")
 	labelCreateCmd.MarkFlagRequired("project")
 	labelCreateCmd.MarkFlagRequired("name")
 
 	// Update flags
-	labelUpdateCmd.Flags().String("project", "", "Project identifier (required)")
+	labelUpdateCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	labelUpdateCmd.Flags().String("id", "", "Label ID (required)")
 	labelUpdateCmd.Flags().String("name", "", "New label name")
 	labelUpdateCmd.Flags().String("color", "", "New label color")
@@ -90,7 +90,7 @@ func init() {
 	labelUpdateCmd.MarkFlagRequired("id")
 
 	// Delete flags
-	labelDeleteCmd.Flags().String("project", "", "Project identifier (required)")
+	labelDeleteCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	labelDeleteCmd.Flags().String("id", "", "Label ID (required)")
 	labelDeleteCmd.MarkFlagRequired("project")
 	labelDeleteCmd.MarkFlagRequired("id")
@@ -103,17 +103,14 @@ func runLabelList(cmd *cobra.Command, args []string) error {
 	}
 
 	projectID, _ := cmd.Flags().GetString("project")
-	workspace, _ := cmd.Flags().GetString("workspace")
-
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
 	}
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	workspace := resolveWorkspace(cmd, cfg)
+
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -124,6 +121,10 @@ func runLabelList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get labels: %w", err)
 	}
 
+	if format != "" {
+		return render(format, labels)
+	}
+
 	if len(labels) == 0 {
 		fmt.Println("No labels found in this project.")
 		return nil
@@ -154,17 +155,10 @@ func runLabelCreate(cmd *cobra.Command, args []string) error {
 	projectID, _ := cmd.Flags().GetString("project")
 	name, _ := cmd.Flags().GetString("name")
 	color, _ := cmd.Flags().GetString("color")
-	workspace, _ := cmd.Flags().GetString("workspace")
 
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -200,17 +194,10 @@ func runLabelUpdate(cmd *cobra.Command, args []string) error {
 	labelID, _ := cmd.Flags().GetString("id")
 	name, _ := cmd.Flags().GetString("name")
 	color, _ := cmd.Flags().GetString("color")
-	workspace, _ := cmd.Flags().GetString("workspace")
 
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -244,17 +231,10 @@ func runLabelDelete(cmd *cobra.Command, args []string) error {
 
 	projectID, _ := cmd.Flags().GetString("project")
 	labelID, _ := cmd.Flags().GetString("id")
-	workspace, _ := cmd.Flags().GetString("workspace")
 
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -266,7 +246,7 @@ func runLabelDelete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get label: %w", err)
 	}
 
-	confirmed, err := confirm(fmt.Sprintf("Are you sure you want to delete label '%s'?", label.Name))
+	confirmed, err := confirmDestructive(labelDeleteImpact(client, projectID, label))
 	if err != nil {
 		return err
 	}
@@ -290,17 +270,9 @@ func runLabelInteractive(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
-	workspace, _ := cmd.Flags().GetString("workspace")
-
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -493,7 +465,7 @@ func deleteLabelInteractive(client *plane.Client, projectID string) error {
 
 	label := labels[idx]
 
-	confirmed, err := confirm(fmt.Sprintf("Delete label '%s'?", label.Name))
+	confirmed, err := confirmDestructive(labelDeleteImpact(client, projectID, &label))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"plane-cli/internal/config"
+	"plane-cli/internal/console"
+)
+
+// Link styles for work item references
+const (
+	linkStylePlain    = "plain"
+	linkStyleOSC8     = "osc8"
+	linkStyleMarkdown = "markdown"
+)
+
+// itemLinker prints work item references in the configured link style. A
+// nil linker prints plain references.
+type itemLinker struct {
+	style     string
+	terminal  bool
+	webURL    string
+	workspace string
+	pending   []pendingLink
+}
+
+// pendingLink is a table cell to turn into a terminal link once the table
+// is aligned
+type pendingLink struct {
+	text string
+	url  string
+}
+
+// newItemLinker returns a linker for the link style in the configuration
+func newItemLinker(cfg *config.Config, workspace string) (*itemLinker, error) {
+	style := strings.ToLower(strings.TrimSpace(cfg.LinkStyle))
+	switch style {
+	case "":
+		style = linkStylePlain
+	case linkStylePlain, linkStyleOSC8, linkStyleMarkdown:
+	default:
+		return nil, fmt.Errorf("unknown output.link_style '%s' (use plain, osc8 or markdown)", cfg.LinkStyle)
+	}
+
+	return &itemLinker{
+		style:     style,
+		terminal:  console.SupportsANSI(),
+		webURL:    webAppURL(cfg),
+		workspace: workspace,
+	}, nil
+}
+
+// webAppURL returns the address of the Plane web app, without a trailing
+// slash: output.web_url, or a guess from the API base URL
+func webAppURL(cfg *config.Config) string {
+	webURL := cfg.WebURL
+	if webURL == "" {
+		webURL = webURLFromBase(cfg.PlaneBaseURL)
+	}
+	return strings.TrimRight(webURL, "/")
+}
+
+// projectWebURL returns the web address of a page of a project in the web
+// app, e.g. projectWebURL(base, ws, projectID, "cycles", cycleID)
+func projectWebURL(webURL, workspace, projectID string, path ...string) string {
+	return fmt.Sprintf("%s/%s/projects/%s/%s/", webURL, workspace, projectID, strings.Join(path, "/"))
+}
+
+// webURLFromBase guesses the web app address from the API base URL; Plane
+// Cloud serves the API from api.plane.so and the app from app.plane.so
+func webURLFromBase(base string) string {
+	u, err := url.Parse(base)
+	if err != nil || u.Host == "" {
+		return base
+	}
+	if strings.HasPrefix(u.Host, "api.") {
+		u.Host = "app." + strings.TrimPrefix(u.Host, "api.")
+	}
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/api")
+	return u.String()
+}
+
+// url returns the web address of a work item, or "" when links are off
+func (l *itemLinker) url(projectID, itemID string) string {
+	if l == nil || l.style == linkStylePlain || projectID == "" || itemID == "" {
+		return ""
+	}
+	return projectWebURL(l.webURL, l.workspace, projectID, "issues", itemID)
+}
+
+// format returns a reference for free text output
+func (l *itemLinker) format(text, projectID, itemID string) string {
+	u := l.url(projectID, itemID)
+	switch {
+	case u == "":
+		return text
+	case l.style == linkStyleMarkdown:
+		return fmt.Sprintf("[%s](%s)", text, u)
+	case l.style == linkStyleOSC8 && l.terminal:
+		return osc8Link(text, u)
+	}
+	return text
+}
+
+// cell returns a reference for the first column of an aligned table.
+// Terminal links are added by writeTable after alignment, as tabwriter
+// would count their escape codes as visible text.
+func (l *itemLinker) cell(text, projectID, itemID string) string {
+	u := l.url(projectID, itemID)
+	switch {
+	case u == "":
+		return text
+	case l.style == linkStyleMarkdown:
+		return fmt.Sprintf("[%s](%s)", text, u)
+	case l.style == linkStyleOSC8 && l.terminal:
+		l.pending = append(l.pending, pendingLink{text: text, url: u})
+	}
+	return text
+}
+
+// writeTable writes an aligned table, turning the cells registered with
+// cell into terminal links. Each row must start with its cell.
+func (l *itemLinker) writeTable(w io.Writer, table []byte) error {
+	if l == nil || len(l.pending) == 0 {
+		_, err := w.Write(table)
+		return err
+	}
+
+	lines := bytes.SplitAfter(table, []byte("\n"))
+	for _, line := range lines {
+		if len(l.pending) > 0 {
+			next := l.pending[0]
+			trimmed := bytes.TrimLeft(line, " ")
+			if bytes.HasPrefix(trimmed, []byte(next.text)) {
+				indent := len(line) - len(trimmed)
+				line = append(append(append([]byte{}, line[:indent]...), osc8Link(next.text, next.url)...), trimmed[len(next.text):]...)
+				l.pending = l.pending[1:]
+			}
+		}
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+	}
+	l.pending = nil
+	return nil
+}
+
+// osc8Link wraps text in an OSC 8 terminal hyperlink
+func osc8Link(text, u string) string {
+	return "\x1b]8;;" + u + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
@@ -1,13 +1,17 @@
 package commands
 
 import (
+	"bytes"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
+	"text/template"
 
 	"github.com/spf13/cobra"
 	"plane-cli/internal/config"
 	"plane-cli/internal/plane"
+	"plane-cli/internal/query"
 )
 
 var listCmd = &cobra.Command{
@@ -26,7 +30,36 @@ Examples:
   plane-cli list --project my-project --priority high
 
   # Limit results
-  plane-cli list --project my-project --limit 20`,
+  plane-cli list --project my-project --limit 20
+
+  # Filter with a query
+  plane-cli list --project my-project -q 'state:"In Progress" priority>=high label:bug assignee:me updated<7d'
+
+  # Add age, time since update, time in state and due date columns
+  plane-cli list --project my-project --show-timings
+
+  # Shape the output with a Go template
+  plane-cli list --project my-project --template '{{.Identifier}}-{{.SequenceID}} {{.State}} {{.Name}}'
+
+  # Write every matching work item to a spreadsheet
+  plane-cli list --project my-project --format csv --out items.csv
+
+  # Read the copy downloaded by 'plane-cli sync' instead of the API
+  plane-cli list --project my-project --offline -q 'assignee:me login'
+
+Query syntax:
+  field:value      match a value (state, group, priority, label, assignee, title,
+                   cycle, module)
+  field:a,b        match any of several values
+  -field:value     exclude matches (field!=value works too)
+  priority>=high   compare priorities (none < low < medium < high < urgent)
+  updated<7d       updated within the last 7 days (also created; h, d, w units)
+  due<2024-06-01   compare dates (also start; today, tomorrow, or +7d)
+  assignee:me      items assigned to you (label:none, assignee:none for unset)
+  prop.severity=s1 match a custom property (any operator; numbers compare numerically)
+  word             bare words must appear in the title
+
+` + outputTemplateHelp,
 	RunE: runList,
 }
 
@@ -34,7 +67,7 @@ func init() {
 	rootCmd.AddCommand(listCmd)
 
 	// Required flags
-	listCmd.Flags().StringP("project", "p", "", "Project identifier (required)")
+	listCmd.Flags().StringP("project", "p", "", "Project identifier (required unless defaults.project is set)")
 	listCmd.MarkFlagRequired("project")
 
 	// Filter flags
@@ -42,6 +75,7 @@ func init() {
 	listCmd.Flags().String("priority", "", "Filter by priority (urgent, high, medium, low)")
 	listCmd.Flags().StringSlice("labels", nil, "Filter by label IDs")
 	listCmd.Flags().String("assignee", "", "Filter by assignee ID")
+	listCmd.Flags().StringP("query", "q", "", "Filter with a query, e.g. 'state:\"In Progress\" priority>=high'")
 
 	// Pagination
 	listCmd.Flags().Int("limit", 50, "Maximum number of results")
@@ -49,6 +83,25 @@ func init() {
 
 	// Display options
 	listCmd.Flags().Bool("show-description", false, "Show descriptions (may be truncated)")
+	listCmd.Flags().Bool("show-timings", false, "Show age, time since update, time in state and due date columns")
+	listCmd.Flags().String("template", "", "Format each work item with a Go template")
+	listCmd.Flags().String("format", "table", "Output format: table or csv (csv lists all items unless --limit is set)")
+	listCmd.Flags().String("out", "", "Write CSV output to this file instead of stdout")
+	listCmd.Flags().Bool("offline", false, "List from the copy downloaded by 'plane-cli sync'")
+}
+
+// listOutput selects how list prints the work items it found
+type listOutput struct {
+	tmpl    *template.Template
+	format  string // json or yaml from --output
+	csv     bool
+	csvFile string
+}
+
+// table reports whether the work items are printed as a table, along with
+// progress messages
+func (o listOutput) table() bool {
+	return o.tmpl == nil && o.format == "" && !o.csv
 }
 
 func runList(cmd *cobra.Command, args []string) error {
@@ -65,30 +118,71 @@ func runList(cmd *cobra.Command, args []string) error {
 	limit, _ := cmd.Flags().GetInt("limit")
 	offset, _ := cmd.Flags().GetInt("offset")
 	showDescription, _ := cmd.Flags().GetBool("show-description")
-	workspace, _ := cmd.Flags().GetString("workspace")
-
-	// Get workspace - priority: flag > env > extract from URL
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
+	showTimings, _ := cmd.Flags().GetBool("show-timings")
+	queryStr, _ := cmd.Flags().GetString("query")
+	templateStr, _ := cmd.Flags().GetString("template")
+	listFormat, _ := cmd.Flags().GetString("format")
+	csvFile, _ := cmd.Flags().GetString("out")
+	offlineMode, _ := cmd.Flags().GetBool("offline")
+
+	var out listOutput
+	if out.format, err = structuredOutput(cmd); err != nil {
+		return err
+	}
+	switch listFormat {
+	case "", "table":
+	case "csv":
+		out.csv, out.csvFile = true, csvFile
+	default:
+		return fmt.Errorf("unknown --format '%s' (use table or csv)", listFormat)
+	}
+	if out.format != "" && templateStr != "" {
+		return fmt.Errorf("--template cannot be combined with --output %s", out.format)
+	}
+	if out.csv && (out.format != "" || templateStr != "") {
+		return fmt.Errorf("--format csv cannot be combined with --template or --output")
+	}
+	if csvFile != "" && !out.csv {
+		return fmt.Errorf("--out requires --format csv")
+	}
+	if out.csv && !cmd.Flags().Changed("limit") {
+		limit = 0
+	}
+
+	if templateStr != "" {
+		if out.tmpl, err = parseOutputTemplate(templateStr); err != nil {
+			return err
+		}
+	}
+
+	workspace := resolveWorkspace(cmd, cfg)
+
+	links, err := newItemLinker(cfg, workspace)
+	if err != nil {
+		return err
+	}
+
+	if offlineMode {
+		if showTimings || out.csv || out.tmpl != nil {
+			return fmt.Errorf("--offline cannot be combined with --show-timings, --template or --format csv")
 		}
+		return runListOffline(cmd, project, state, priorityStr, queryStr, limit, offset, showDescription, out, links)
 	}
 
 	// Create Plane client
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 	client.SetWorkspace(workspace)
 
-	// Build query options
-	options := map[string]string{
-		"limit":  fmt.Sprintf("%d", limit),
-		"offset": fmt.Sprintf("%d", offset),
+	if queryStr != "" {
+		return runListQuery(client, project, queryStr, limit, offset, showDescription, showTimings, out, links)
 	}
 
+	// Build query options
+	options := map[string]string{}
+
 	if state != "" {
 		options["state"] = state
 	}
@@ -101,56 +195,257 @@ func runList(cmd *cobra.Command, args []string) error {
 	// Note: Labels and assignee filtering may need custom handling
 	// depending on Plane API capabilities
 
+	// Only request the columns the table shows; templates and other
+	// formats may use any field
+	if out.table() {
+		plane.SelectFields(options, listFields(showDescription, showTimings), nil)
+	}
+
 	// Fetch work items
-	fmt.Printf("Fetching work items from project '%s'...\n\n", project)
-	response, err := client.GetWorkItems(project, options)
+	if out.table() {
+		fmt.Printf("Fetching work items from project '%s'...\n\n", project)
+	}
+	// Follow the cursor until offset+limit items are collected
+	if limit > 0 && offset+limit < 100 {
+		options["per_page"] = fmt.Sprintf("%d", offset+limit)
+	}
+	pager := client.WorkItemsPager(project, options)
+	var items []plane.WorkItem
+	for pager.More() && (limit <= 0 || len(items) < offset+limit) {
+		page, err := pager.Next()
+		if err != nil {
+			return fmt.Errorf("failed to fetch work items: %w", err)
+		}
+		items = append(items, page...)
+	}
+	items = items[min(offset, len(items)):]
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+
+	if !out.table() {
+		return out.write(client, project, items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No work items found.")
+		return nil
+	}
+
+	var timings map[string]itemTimings
+	if showTimings {
+		timings = fetchItemTimings(client, project, items)
+	}
+	printWorkItemTable(items, project, showDescription, timings, links, tableLookup(client, project))
+
+	// Show pagination info
+	fmt.Printf("\nShowing %d of %d work items\n", len(items), pager.Total())
+	if offset+len(items) < pager.Total() {
+		fmt.Printf("More results available. Use --offset %d to see the next page.\n", offset+len(items))
+	}
+
+	return nil
+}
+
+// runListQuery lists the work items matching a query
+func runListQuery(client *plane.Client, project, queryStr string, limit, offset int, showDescription, showTimings bool, out listOutput, links *itemLinker) error {
+	q, err := query.Parse(queryStr)
+	if err != nil {
+		return fmt.Errorf("invalid query: %w", err)
+	}
+
+	ctx, err := loadQueryContext(client, project, q)
+	if err != nil {
+		return err
+	}
+
+	if out.table() {
+		fmt.Printf("Fetching work items from project '%s'...\n\n", project)
+	}
+	var fields []string
+	if out.table() {
+		fields = listFields(showDescription, showTimings)
+	}
+	items, err := fetchMatchingWorkItems(client, project, q, ctx, fields)
 	if err != nil {
 		return fmt.Errorf("failed to fetch work items: %w", err)
 	}
 
-	if len(response.Results) == 0 {
+	total := len(items)
+	if offset > len(items) {
+		offset = len(items)
+	}
+	items = items[offset:]
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+
+	if !out.table() {
+		return out.write(client, project, items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No work items found.")
+		return nil
+	}
+
+	var timings map[string]itemTimings
+	if showTimings {
+		timings = fetchItemTimings(client, project, items)
+	}
+	printWorkItemTable(items, project, showDescription, timings, links, tableLookup(client, project))
+	fmt.Printf("\nShowing %d of %d matching work items\n", len(items), total)
+	return nil
+}
+
+// runListOffline lists the work items of the copy downloaded by sync. The
+// state and priority flags and queries are applied as they are online,
+// except for custom properties, which are not synced.
+func runListOffline(cmd *cobra.Command, project, state, priorityStr, queryStr string, limit, offset int, showDescription bool, out listOutput, links *itemLinker) error {
+	store, err := loadOfflineStore(cmd, project)
+	if err != nil {
+		return err
+	}
+
+	var q *query.Query
+	var ctx *query.Context
+	if queryStr != "" {
+		if q, err = query.Parse(queryStr); err != nil {
+			return fmt.Errorf("invalid query: %w", err)
+		}
+		if len(q.Properties()) > 0 {
+			return fmt.Errorf("custom properties are not available offline")
+		}
+		ctx = offlineQueryContext(store)
+	}
+
+	items := []plane.WorkItem{}
+	for i := range store.WorkItems {
+		item := &store.WorkItems[i]
+		if state != "" && itemStateID(item) != state && !strings.EqualFold(offlineStateName(store, itemStateID(item)), state) {
+			continue
+		}
+		if priorityStr != "" && !strings.EqualFold(item.Priority, priorityStr) {
+			continue
+		}
+		if q != nil && !q.Match(item, ctx) {
+			continue
+		}
+		items = append(items, *item)
+	}
+
+	total := len(items)
+	items = items[min(offset, len(items)):]
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+
+	if !out.table() {
+		return render(out.format, items)
+	}
+	if len(items) == 0 {
 		fmt.Println("No work items found.")
 		return nil
 	}
 
-	// Display results
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	lookup := buildItemLookup(store.Project.ID, &store.Project, store.States, store.Labels, store.Members)
+	printWorkItemTable(items, store.Project.Identifier, showDescription, nil, links, lookup)
+	fmt.Printf("\nShowing %d of %d work items\n", len(items), total)
+	if offset+len(items) < total {
+		fmt.Printf("More results available. Use --offset %d to see the next page.\n", offset+len(items))
+	}
+	return nil
+}
+
+// write prints work items with a template, as JSON or YAML, or as CSV
+func (o listOutput) write(client *plane.Client, project string, items []plane.WorkItem) error {
+	switch {
+	case o.tmpl != nil:
+		return printItemsWithTemplate(client, project, items, o.tmpl)
+	case o.csv:
+		return writeItemsCSV(client, project, items, o.csvFile)
+	}
+	return render(o.format, items)
+}
+
+// listFields returns the work item fields shown by the list table
+func listFields(showDescription, showTimings bool) []string {
+	fields := []string{"sequence_id", "name", "state", "priority", "assignees", "labels"}
+	if showDescription {
+		fields = append(fields, "description", "description_html")
+	}
+	if showTimings {
+		fields = append(fields, "created_at", "updated_at", "target_date")
+	}
+	return fields
+}
+
+// tableLookup resolves the state and label names shown by the list table.
+// Without it the table shows their IDs.
+func tableLookup(client *plane.Client, project string) *itemLookup {
+	lookup, err := newItemLookup(client, project)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: showing state and label IDs: %v\n", err)
+		return nil
+	}
+	return lookup
+}
+
+// printWorkItemTable prints work items as an aligned table. Timing columns
+// are added when timings is not nil; IDs are printed in the style of links.
+// States and labels are named by lookup, when not nil, with a swatch in
+// their color on terminals that show color.
+func printWorkItemTable(items []plane.WorkItem, project string, showDescription bool, timings map[string]itemTimings, links *itemLinker, lookup *itemLookup) {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	colors := newCellPainter()
 
 	// Header
+	header := "ID\tTITLE\tSTATE\tPRIORITY\tASSIGNEES\tLABELS"
+	if timings != nil {
+		header += "\tAGE\tUPDATED\tIN STATE\tDUE"
+	}
 	if showDescription {
-		fmt.Fprintln(w, "ID\tTITLE\tSTATE\tPRIORITY\tASSIGNEES\tDESCRIPTION")
-	} else {
-		fmt.Fprintln(w, "ID\tTITLE\tSTATE\tPRIORITY\tASSIGNEES")
+		header += "\tDESCRIPTION"
 	}
+	fmt.Fprintln(w, header)
 
 	// Rows
-	for _, item := range response.Results {
-		id := fmt.Sprintf("%s-%d", project, item.SequenceID)
+	for i, item := range items {
+		id := links.cell(fmt.Sprintf("%s-%d", project, item.SequenceID), project, item.ID)
 		title := truncate(item.Name, 40)
-		state := item.State
+		state := itemStateID(&item)
 		priority := item.Priority
-		assignees := fmt.Sprintf("%d", len(item.Assignees))
+		assignees := fmt.Sprintf("%d", len(itemAssigneeIDs(&item)))
+		labels := strings.Join(itemLabelIDs(&item), ", ")
+		if lookup != nil {
+			view := lookup.view(&item)
+			state = colors.swatch(view.State)
+			colors.paint(i, 2, lookup.stateColor(&item))
+			for j := range view.Labels {
+				view.Labels[j] = colors.swatch(view.Labels[j])
+			}
+			labels = strings.Join(view.Labels, ", ")
+			colors.paint(i, 5, lookup.labelColorList(&item)...)
+		}
 
+		row := fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s", id, title, emptyAsDash(state), priority, assignees, emptyAsDash(labels))
+		if timings != nil {
+			t := timings[item.ID]
+			row += fmt.Sprintf("\t%s\t%s\t%s\t%s", formatAge(t.Age), formatAge(t.SinceUpdate), formatAge(t.InState), formatDue(t.DueInDays))
+		}
 		if showDescription {
 			desc := ""
 			if item.Description != "" {
 				desc = truncate(stripHTML(item.Description), 50)
 			}
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", id, title, state, priority, assignees, desc)
-		} else {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", id, title, state, priority, assignees)
+			row += "\t" + desc
 		}
+		fmt.Fprintln(w, row)
 	}
 
 	w.Flush()
-
-	// Show pagination info
-	fmt.Printf("\nShowing %d of %d work items\n", len(response.Results), response.TotalCount)
-	if response.NextPageResults && response.NextCursor != nil {
-		fmt.Printf("More results available. Use cursor-based pagination.\n")
-	}
-
-	return nil
+	links.writeTable(os.Stdout, colors.apply(buf.Bytes()))
 }
 
 func truncate(s string, maxLen int) string {
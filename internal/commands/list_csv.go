@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"plane-cli/internal/plane"
+)
+
+// itemsCSVHeader lists the columns written by list --format csv
+var itemsCSVHeader = []string{"id", "title", "state", "priority", "assignees", "labels", "estimate", "start_date", "target_date", "created_at", "updated_at"}
+
+// writeItemsCSV writes work items as CSV with state, label and member names
+// resolved, to file or to stdout when file is empty
+func writeItemsCSV(client *plane.Client, projectID string, items []plane.WorkItem, file string) error {
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+	points, err := estimatePoints(client, projectID)
+	if err != nil {
+		points = nil
+	}
+
+	var w io.Writer = os.Stdout
+	if file != "" {
+		f, err := os.Create(file)
+		if err != nil {
+			return fmt.Errorf("failed to create CSV file: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Write(itemsCSVHeader)
+	for i := range items {
+		item := &items[i]
+		view := lookup.view(item)
+
+		estimate := ""
+		if item.EstimatePoint != nil && *item.EstimatePoint != "" {
+			estimate = *item.EstimatePoint
+			if v, ok := points[estimate]; ok {
+				estimate = strconv.FormatFloat(v, 'f', -1, 64)
+			}
+		}
+
+		cw.Write([]string{
+			view.Key,
+			view.Name,
+			view.State,
+			view.Priority,
+			strings.Join(view.Assignees, ", "),
+			strings.Join(view.Labels, ", "),
+			estimate,
+			view.StartDate,
+			view.TargetDate,
+			view.CreatedAt.Local().Format("2006-01-02 15:04"),
+			view.UpdatedAt.Local().Format("2006-01-02 15:04"),
+		})
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	if file != "" {
+		fmt.Printf("✅ Wrote %d work item(s) to %s\n", len(items), file)
+	}
+	return nil
+}
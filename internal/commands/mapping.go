@@ -0,0 +1,379 @@
+package commands
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/plane"
+)
+
+var mappingCmd = &cobra.Command{
+	Use:   "mapping",
+	Short: "Manage import mapping profiles",
+	Long: `Mapping profiles describe how the statuses, priorities and users of an
+import source (Jira, GitHub, a spreadsheet) map to the states, priorities and
+members of this workspace, so the same mapping can be reused for every import.
+
+A profile is a YAML file in <templates directory>/mappings/<name>.yaml (or any
+path given to --profile):
+
+  name: Jira
+  source: jira
+  columns:                 # source CSV columns (defaults: status/state,
+    state: Status          # priority, assignee/assignees)
+    priority: Priority
+    assignee: Assignee
+  states:
+    To Do: Backlog
+    In Progress: In Progress
+    Done: Done
+  priorities:
+    Highest: urgent
+    High: high
+    Medium: medium
+    Low: low
+  users:
+    jdoe@old-company.com: jane@example.com
+  defaults:                # used for source values missing above
+    priority: none
+
+Source values are matched ignoring case. Use the profile with
+'sync-csv --mapping <name>'.`,
+}
+
+var mappingValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check a mapping profile before an import",
+	Long: `Check that every state, priority and member a mapping profile maps to
+exists in the project, and - with --file - that every status, priority and
+user in the source export is mapped. Run it before an import to catch
+unmapped values while nothing has been created yet.
+
+The command exits with an error when anything is missing, which makes it
+usable in scripts.
+
+Examples:
+  plane-cli mapping validate --project <project-id> --profile jira
+  plane-cli mapping validate --project <project-id> --profile jira --file jira-export.csv`,
+	RunE: runMappingValidate,
+}
+
+// MappingProfile maps the values of an import source to this workspace
+type MappingProfile struct {
+	Name       string            `yaml:"name,omitempty"`
+	Source     string            `yaml:"source,omitempty"`
+	Columns    MappingColumns    `yaml:"columns,omitempty"`
+	States     map[string]string `yaml:"states,omitempty"`
+	Priorities map[string]string `yaml:"priorities,omitempty"`
+	Users      map[string]string `yaml:"users,omitempty"`
+	Defaults   MappingDefaults   `yaml:"defaults,omitempty"`
+}
+
+// MappingColumns names the source CSV columns holding each mapped field
+type MappingColumns struct {
+	State    string `yaml:"state,omitempty"`
+	Priority string `yaml:"priority,omitempty"`
+	Assignee string `yaml:"assignee,omitempty"`
+}
+
+// MappingDefaults are used for source values the profile doesn't map
+type MappingDefaults struct {
+	State    string `yaml:"state,omitempty"`
+	Priority string `yaml:"priority,omitempty"`
+	User     string `yaml:"user,omitempty"`
+}
+
+// validPriorities are the priority values Plane accepts
+var validPriorities = []string{"urgent", "high", "medium", "low", "none"}
+
+func init() {
+	rootCmd.AddCommand(mappingCmd)
+	mappingCmd.AddCommand(mappingValidateCmd)
+
+	mappingValidateCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+	mappingValidateCmd.Flags().String("profile", "", "Mapping profile name or YAML file (required)")
+	mappingValidateCmd.Flags().String("file", "", "Source CSV export to check for unmapped values")
+	mappingValidateCmd.MarkFlagRequired("project")
+	mappingValidateCmd.MarkFlagRequired("profile")
+}
+
+func runMappingValidate(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	profileName, _ := cmd.Flags().GetString("profile")
+	file, _ := cmd.Flags().GetString("file")
+
+	profile, err := loadMappingProfile(resolveMappingFile(profileName))
+	if err != nil {
+		return err
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project states: %w", err)
+	}
+	var members []plane.Member
+	if len(profile.Users) > 0 || profile.Defaults.User != "" {
+		if members, err = client.GetWorkspaceMembers(); err != nil {
+			return fmt.Errorf("failed to get workspace members: %w", err)
+		}
+	}
+
+	fmt.Printf("\n🔍 Validating mapping profile '%s'\n", profileName)
+	fmt.Println(strings.Repeat("=", 70))
+
+	problems := 0
+	problems += printMappingTargets("States", profile.States, profile.Defaults.State, func(target string) string {
+		if _, err := stateIDByName(states, target); err != nil {
+			return "state not found in project"
+		}
+		return ""
+	})
+	problems += printMappingTargets("Priorities", profile.Priorities, profile.Defaults.Priority, func(target string) string {
+		if !slices.Contains(validPriorities, strings.ToLower(target)) {
+			return "not a priority (use " + strings.Join(validPriorities, ", ") + ")"
+		}
+		return ""
+	})
+	problems += printMappingTargets("Users", profile.Users, profile.Defaults.User, func(target string) string {
+		if _, err := matchMember(members, target); err != nil {
+			return err.Error()
+		}
+		return ""
+	})
+
+	if file != "" {
+		values, rows, err := readMappingSourceValues(file, profile.Columns)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\nSource values (%s, %d rows)\n", file, rows)
+		fmt.Println(strings.Repeat("-", 70))
+		problems += printUnmappedValues("status", values["state"], profile.States, profile.Defaults.State)
+		problems += printUnmappedValues("priority", values["priority"], profile.Priorities, profile.Defaults.Priority)
+		problems += printUnmappedValues("user", values["assignee"], profile.Users, profile.Defaults.User)
+	}
+
+	fmt.Println(strings.Repeat("=", 70))
+	if problems > 0 {
+		return fmt.Errorf("mapping profile has %d problem(s)", problems)
+	}
+	fmt.Println("✅ Mapping profile is valid.")
+	return nil
+}
+
+// resolveMappingFile maps a profile name to its file in the templates
+// directory. Paths to existing files are used as-is.
+func resolveMappingFile(name string) string {
+	if _, err := os.Stat(name); err == nil {
+		return name
+	}
+	return filepath.Join(getTemplatesDir(), "mappings", name+".yaml")
+}
+
+// loadMappingProfile reads a mapping profile
+func loadMappingProfile(filename string) (*MappingProfile, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mapping profile: %w", err)
+	}
+
+	var profile MappingProfile
+	if err := yaml.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse mapping profile: %w", err)
+	}
+	if len(profile.States) == 0 && len(profile.Priorities) == 0 && len(profile.Users) == 0 {
+		return nil, fmt.Errorf("mapping profile %s maps no states, priorities or users", filename)
+	}
+	return &profile, nil
+}
+
+// mapValue maps a source value, ignoring case, falling back to def. ok is
+// false when the value is neither mapped nor covered by a default.
+func mapValue(mapping map[string]string, def, value string) (string, bool) {
+	if target, ok := mapping[value]; ok {
+		return target, true
+	}
+	for source, target := range mapping {
+		if strings.EqualFold(source, value) {
+			return target, true
+		}
+	}
+	if def != "" {
+		return def, true
+	}
+	return "", false
+}
+
+// apply rewrites the state and priority cells of CSV rows with the mapped
+// values. Every unmapped value is reported in the error.
+func (p *MappingProfile) apply(rows []*csvRow) error {
+	var unmapped []string
+	for _, row := range rows {
+		for _, f := range []struct {
+			field   string
+			mapping map[string]string
+			def     string
+		}{
+			{"state", p.States, p.Defaults.State},
+			{"priority", p.Priorities, p.Defaults.Priority},
+		} {
+			value, ok := row.Fields[f.field]
+			if !ok || len(f.mapping) == 0 && f.def == "" {
+				continue
+			}
+			target, ok := mapValue(f.mapping, f.def, value)
+			if !ok {
+				unmapped = append(unmapped, fmt.Sprintf("line %d: %s '%s'", row.Line, f.field, value))
+				continue
+			}
+			row.Fields[f.field] = target
+		}
+	}
+	if len(unmapped) > 0 {
+		return fmt.Errorf("%d value(s) not covered by the mapping profile:\n  %s\n\n💡 Run 'plane-cli mapping validate --file' to list them all",
+			len(unmapped), strings.Join(unmapped[:min(len(unmapped), 10)], "\n  "))
+	}
+	return nil
+}
+
+// printMappingTargets lists the mappings of one kind and reports targets
+// that check rejects. It returns the number of problems.
+func printMappingTargets(title string, mapping map[string]string, def string, check func(target string) string) int {
+	if len(mapping) == 0 && def == "" {
+		return 0
+	}
+
+	fmt.Printf("\n%s (%d mapped)\n", title, len(mapping))
+	fmt.Println(strings.Repeat("-", 70))
+
+	sources := make([]string, 0, len(mapping))
+	for source := range mapping {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	problems := 0
+	report := func(source, target string) {
+		if reason := check(target); reason != "" {
+			fmt.Printf("  ❌ %-25s → %s (%s)\n", source, target, reason)
+			problems++
+			return
+		}
+		fmt.Printf("  ✅ %-25s → %s\n", source, target)
+	}
+	for _, source := range sources {
+		report(source, mapping[source])
+	}
+	if def != "" {
+		report("(default)", def)
+	}
+	return problems
+}
+
+// printUnmappedValues reports source values the profile doesn't map. It
+// returns the number of unmapped values.
+func printUnmappedValues(kind string, values map[string]int, mapping map[string]string, def string) int {
+	var unmapped []string
+	for value := range values {
+		if _, ok := mapValue(mapping, def, value); !ok {
+			unmapped = append(unmapped, value)
+		}
+	}
+	if len(values) == 0 {
+		return 0
+	}
+	if len(unmapped) == 0 {
+		fmt.Printf("  ✅ All %d %s value(s) are mapped\n", len(values), kind)
+		return 0
+	}
+
+	sort.Strings(unmapped)
+	fmt.Printf("  ⚠️  %d unmapped %s value(s):\n", len(unmapped), kind)
+	for _, value := range unmapped {
+		fmt.Printf("     • %s (%d row(s))\n", value, values[value])
+	}
+	return len(unmapped)
+}
+
+// readMappingSourceValues collects the distinct state, priority and
+// assignee values of a CSV export with their row counts. Assignee cells may
+// hold several comma-separated users.
+func readMappingSourceValues(path string, columns MappingColumns) (map[string]map[string]int, int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open CSV file: %w", err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	candidates := map[string][]string{
+		"state":    {columns.State, "status", "state"},
+		"priority": {columns.Priority, "priority"},
+		"assignee": {columns.Assignee, "assignee", "assignees"},
+	}
+	index := make(map[string]int)
+	for field, names := range candidates {
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+			if i := slices.IndexFunc(header, func(h string) bool {
+				return normalizeCSVHeader(h) == normalizeCSVHeader(name)
+			}); i >= 0 {
+				index[field] = i
+				break
+			}
+		}
+	}
+	if len(index) == 0 {
+		return nil, 0, fmt.Errorf("no status, priority or assignee column found in %s", path)
+	}
+
+	values := map[string]map[string]int{"state": {}, "priority": {}, "assignee": {}}
+	rows := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to read CSV line %d: %w", rows+2, err)
+		}
+		rows++
+		for field, i := range index {
+			if i >= len(record) {
+				continue
+			}
+			cells := []string{record[i]}
+			if field == "assignee" {
+				cells = strings.Split(record[i], ",")
+			}
+			for _, v := range cells {
+				if v = strings.TrimSpace(v); v != "" {
+					values[field][v]++
+				}
+			}
+		}
+	}
+	return values, rows, nil
+}
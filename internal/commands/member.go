@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var memberCmd = &cobra.Command{
+	Use:   "member",
+	Short: "List and find workspace and project members",
+	Long: `List and find members of the workspace or a project, to look up the IDs
+used by --assignees and other flags.
+
+Examples:
+  # List the members of the workspace
+  plane-cli member list
+
+  # List the members of a project
+  plane-cli member list --project <project-id>
+
+  # Find a member by email or name
+  plane-cli member find --email jane@example.com
+  plane-cli member find --name jane -o json`,
+}
+
+var memberListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the members of the workspace or a project",
+	RunE:  runMemberList,
+}
+
+var memberFindCmd = &cobra.Command{
+	Use:   "find",
+	Short: "Find members by email or name",
+	Long: `Find members by email or name. --email matches the whole address and
+--name any part of the display, first or last name, both ignoring case.
+
+Examples:
+  plane-cli member find --email jane@example.com
+  plane-cli member find --name jane --project <project-id>`,
+	RunE: runMemberFind,
+}
+
+func init() {
+	rootCmd.AddCommand(memberCmd)
+	memberCmd.AddCommand(memberListCmd)
+	memberCmd.AddCommand(memberFindCmd)
+
+	for _, c := range []*cobra.Command{memberListCmd, memberFindCmd} {
+		c.Flags().String("project", "", "Project ID (defaults to the workspace members)")
+	}
+	memberFindCmd.Flags().String("email", "", "Email address to find")
+	memberFindCmd.Flags().String("name", "", "Part of a name to find")
+}
+
+// memberEntry is a member as printed with --output json or yaml
+type memberEntry struct {
+	plane.Member
+	// RoleName is the readable role, when the API returns one
+	RoleName string `json:"role_name,omitempty"`
+}
+
+func runMemberList(cmd *cobra.Command, args []string) error {
+	members, err := fetchMembers(cmd)
+	if err != nil {
+		return err
+	}
+	return printMembers(cmd, members, "No members found.")
+}
+
+func runMemberFind(cmd *cobra.Command, args []string) error {
+	email, _ := cmd.Flags().GetString("email")
+	name, _ := cmd.Flags().GetString("name")
+	if email == "" && name == "" {
+		return fmt.Errorf("--email or --name is required")
+	}
+
+	members, err := fetchMembers(cmd)
+	if err != nil {
+		return err
+	}
+
+	var found []plane.Member
+	for _, m := range members {
+		if email != "" && !strings.EqualFold(m.Email, email) {
+			continue
+		}
+		if name != "" && !memberNameContains(m, name) {
+			continue
+		}
+		found = append(found, m)
+	}
+	return printMembers(cmd, found, "No matching members found.")
+}
+
+// fetchMembers returns the members of --project, or of the workspace
+func fetchMembers(cmd *cobra.Command) ([]plane.Member, error) {
+	projectID, _ := cmd.Flags().GetString("project")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []plane.Member
+	if projectID != "" {
+		members, err = client.GetProjectMembers(projectID)
+	} else {
+		members, err = client.GetWorkspaceMembers()
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		return strings.ToLower(members[i].GetDisplayName()) < strings.ToLower(members[j].GetDisplayName())
+	})
+	return members, nil
+}
+
+// memberNameContains reports whether any name of m contains s, ignoring case
+func memberNameContains(m plane.Member, s string) bool {
+	s = strings.ToLower(s)
+	for _, name := range []string{m.DisplayName, m.FirstName, m.LastName, m.FirstName + " " + m.LastName} {
+		if strings.Contains(strings.ToLower(name), s) {
+			return true
+		}
+	}
+	return false
+}
+
+func printMembers(cmd *cobra.Command, members []plane.Member, empty string) error {
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format != "" {
+		entries := make([]memberEntry, 0, len(members))
+		for _, m := range members {
+			entries = append(entries, memberEntry{Member: m, RoleName: plane.RoleName(m.Role)})
+		}
+		return render(format, entries)
+	}
+
+	if len(members) == 0 {
+		fmt.Println(empty)
+		return nil
+	}
+
+	fmt.Printf("\n👥 Members (%d):\n\n", len(members))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tEMAIL\tROLE")
+	for _, m := range members {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", m.ID, m.GetDisplayName(), emptyAsDash(m.Email), emptyAsDash(plane.RoleName(m.Role)))
+	}
+	w.Flush()
+	fmt.Println()
+	return nil
+}
@@ -71,30 +71,36 @@ func init() {
 	moduleCmd.AddCommand(moduleInteractiveCmd)
 
 	// List flags
-	moduleListCmd.Flags().String("project", "", "Project identifier (required)")
+	moduleListCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	moduleListCmd.MarkFlagRequired("project")
 
 	// Create flags
-	moduleCreateCmd.Flags().String("project", "", "Project identifier (required)")
+	moduleCreateCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	moduleCreateCmd.Flags().String("name", "", "Module name (required)")
 	moduleCreateCmd.Flags().String("description", "", "Module description")
 	moduleCreateCmd.Flags().String("color", "", "Module color (hex code)")
 	moduleCreateCmd.Flags().String("status", "backlog", "Module status (backlog, started, paused, completed, cancelled)")
+	moduleCreateCmd.Flags().String("start-date", "", "Start date (YYYY-MM-DD)")
+	moduleCreateCmd.Flags().String("target-date", "", "Target date (YYYY-MM-DD)")
+	moduleCreateCmd.Flags().Bool("yes", false, "Create even when the dates look wrong")
 	moduleCreateCmd.MarkFlagRequired("project")
 	moduleCreateCmd.MarkFlagRequired("name")
 
 	// Update flags
-	moduleUpdateCmd.Flags().String("project", "", "Project identifier (required)")
+	moduleUpdateCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	moduleUpdateCmd.Flags().String("id", "", "Module ID (required)")
 	moduleUpdateCmd.Flags().String("name", "", "New module name")
 	moduleUpdateCmd.Flags().String("description", "", "New module description")
 	moduleUpdateCmd.Flags().String("color", "", "New module color")
 	moduleUpdateCmd.Flags().String("status", "", "New module status")
+	moduleUpdateCmd.Flags().String("start-date", "", "New start date (YYYY-MM-DD)")
+	moduleUpdateCmd.Flags().String("target-date", "", "New target date (YYYY-MM-DD)")
+	moduleUpdateCmd.Flags().Bool("yes", false, "Update even when the dates look wrong")
 	moduleUpdateCmd.MarkFlagRequired("project")
 	moduleUpdateCmd.MarkFlagRequired("id")
 
 	// Delete flags
-	moduleDeleteCmd.Flags().String("project", "", "Project identifier (required)")
+	moduleDeleteCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	moduleDeleteCmd.Flags().String("id", "", "Module ID (required)")
 	moduleDeleteCmd.MarkFlagRequired("project")
 	moduleDeleteCmd.MarkFlagRequired("id")
@@ -107,17 +113,14 @@ func runModuleList(cmd *cobra.Command, args []string) error {
 	}
 
 	projectID, _ := cmd.Flags().GetString("project")
-	workspace, _ := cmd.Flags().GetString("workspace")
-
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
 	}
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	workspace := resolveWorkspace(cmd, cfg)
+
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -128,6 +131,10 @@ func runModuleList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get modules: %w", err)
 	}
 
+	if format != "" {
+		return render(format, modules)
+	}
+
 	if len(modules) == 0 {
 		fmt.Println("No modules found in this project.")
 		return nil
@@ -165,17 +172,33 @@ func runModuleCreate(cmd *cobra.Command, args []string) error {
 	description, _ := cmd.Flags().GetString("description")
 	color, _ := cmd.Flags().GetString("color")
 	status, _ := cmd.Flags().GetString("status")
-	workspace, _ := cmd.Flags().GetString("workspace")
+	startStr, _ := cmd.Flags().GetString("start-date")
+	targetStr, _ := cmd.Flags().GetString("target-date")
+	yes, _ := cmd.Flags().GetBool("yes")
 
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
+	start, err := parseScheduleDate("start-date", startStr)
+	if err != nil {
+		return err
+	}
+	target, err := parseScheduleDate("target-date", targetStr)
+	if err != nil {
+		return err
+	}
+	if err := validateScheduleRange(start, target); err != nil {
+		return err
+	}
+	ok, err := confirmScheduleWarnings(moduleScheduleWarnings(target, status), yes, "Create the module")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println("❌ Module not created.")
+		return nil
 	}
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	workspace := resolveWorkspace(cmd, cfg)
+
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -186,6 +209,8 @@ func runModuleCreate(cmd *cobra.Command, args []string) error {
 		Description: description,
 		Color:       color,
 		Status:      status,
+		StartDate:   startStr,
+		TargetDate:  targetStr,
 	}
 
 	module, err := client.CreateModule(projectID, create)
@@ -215,17 +240,13 @@ func runModuleUpdate(cmd *cobra.Command, args []string) error {
 	description, _ := cmd.Flags().GetString("description")
 	color, _ := cmd.Flags().GetString("color")
 	status, _ := cmd.Flags().GetString("status")
-	workspace, _ := cmd.Flags().GetString("workspace")
+	startStr, _ := cmd.Flags().GetString("start-date")
+	targetStr, _ := cmd.Flags().GetString("target-date")
+	yes, _ := cmd.Flags().GetBool("yes")
 
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -245,6 +266,46 @@ func runModuleUpdate(cmd *cobra.Command, args []string) error {
 		update.Status = status
 	}
 
+	if startStr != "" || targetStr != "" {
+		start, err := parseScheduleDate("start-date", startStr)
+		if err != nil {
+			return err
+		}
+		target, err := parseScheduleDate("target-date", targetStr)
+		if err != nil {
+			return err
+		}
+
+		// Check the dates the module will have after the update
+		current, err := client.GetModule(projectID, moduleID)
+		if err != nil {
+			return fmt.Errorf("failed to get module: %w", err)
+		}
+		if start.IsZero() {
+			start = scheduleDate(current.StartDate)
+		}
+		if target.IsZero() {
+			target = scheduleDate(current.TargetDate)
+		}
+		if status == "" {
+			status = current.Status
+		}
+		if err := validateScheduleRange(start, target); err != nil {
+			return err
+		}
+		ok, err := confirmScheduleWarnings(moduleScheduleWarnings(target, status), yes, "Update the module")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("❌ Module not updated.")
+			return nil
+		}
+
+		update.StartDate = startStr
+		update.TargetDate = targetStr
+	}
+
 	module, err := client.UpdateModule(projectID, moduleID, update)
 	if err != nil {
 		return fmt.Errorf("failed to update module: %w", err)
@@ -265,17 +326,10 @@ func runModuleDelete(cmd *cobra.Command, args []string) error {
 
 	projectID, _ := cmd.Flags().GetString("project")
 	moduleID, _ := cmd.Flags().GetString("id")
-	workspace, _ := cmd.Flags().GetString("workspace")
 
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -287,7 +341,7 @@ func runModuleDelete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get module: %w", err)
 	}
 
-	confirmed, err := confirm(fmt.Sprintf("Are you sure you want to delete module '%s'?", module.Name))
+	confirmed, err := confirmDestructive(moduleDeleteImpact(client, projectID, module))
 	if err != nil {
 		return err
 	}
@@ -311,17 +365,9 @@ func runModuleInteractive(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
-	workspace, _ := cmd.Flags().GetString("workspace")
-
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -551,7 +597,7 @@ func deleteModuleInteractive(client *plane.Client, projectID string) error {
 
 	module := modules[idx]
 
-	confirmed, err := confirm(fmt.Sprintf("Delete module '%s'?", module.Name))
+	confirmed, err := confirmDestructive(moduleDeleteImpact(client, projectID, &module))
 	if err != nil {
 		return err
 	}
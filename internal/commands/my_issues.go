@@ -0,0 +1,166 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var myIssuesCmd = &cobra.Command{
+	Use:   "my-issues",
+	Short: "List the work items assigned to you",
+	Long: `List the work items assigned to the signed-in user, grouped by project and
+state in workflow order.
+
+Without --project every project of the workspace is searched, several at
+once; with defaults.project set, --all-projects does so. Completed and
+cancelled work items are left out unless --closed is given. --state keeps
+one state, by name or by group (backlog, unstarted, started, completed,
+cancelled).
+
+Examples:
+  plane-cli my-issues
+  plane-cli my-issues --state "In Progress"
+  plane-cli my-issues --project <project-id> --state started
+  plane-cli my-issues --all-projects --closed -o json`,
+	Args: cobra.NoArgs,
+	RunE: runMyIssues,
+}
+
+func init() {
+	rootCmd.AddCommand(myIssuesCmd)
+
+	myIssuesCmd.Flags().String("project", "", "Only this project (default: every project unless defaults.project is set)")
+	myIssuesCmd.Flags().Bool("all-projects", false, "Search every project, even when defaults.project is set")
+	myIssuesCmd.Flags().String("state", "", "Only work items in this state, by name or group")
+	myIssuesCmd.Flags().Bool("closed", false, "Include completed and cancelled work items")
+}
+
+func runMyIssues(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	allProjects, _ := cmd.Flags().GetBool("all-projects")
+	state, _ := cmd.Flags().GetString("state")
+	closed, _ := cmd.Flags().GetBool("closed")
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	links, err := newItemLinker(cfg, resolveWorkspace(cmd, cfg))
+	if err != nil {
+		return err
+	}
+	me, err := client.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	fields := append(listFields(false, false), "target_date")
+	var index *workspaceIndex
+	if projectID != "" && !allProjects {
+		project, err := client.GetProject(projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+		index = buildProjectsIndex(client, []plane.Project{*project}, fields)
+	} else if index, err = buildWorkspaceIndex(client, fields); err != nil {
+		return err
+	}
+
+	found := []workspaceItem{}
+	for _, item := range index.Items {
+		s := index.States[itemStateID(&item.WorkItem)]
+		if !slices.Contains(itemAssigneeIDs(&item.WorkItem), me.ID) {
+			continue
+		}
+		if state != "" && !strings.EqualFold(s.Name, state) && !strings.EqualFold(s.Group, state) && itemStateID(&item.WorkItem) != state {
+			continue
+		}
+		if state == "" && !closed && (s.Group == "completed" || s.Group == "cancelled") {
+			continue
+		}
+		found = append(found, item)
+	}
+
+	// Group by project, then state in workflow order, then priority
+	sort.SliceStable(found, func(i, j int) bool {
+		a, b := &found[i], &found[j]
+		if a.ProjectIdentifier != b.ProjectIdentifier {
+			return a.ProjectIdentifier < b.ProjectIdentifier
+		}
+		sa, sb := index.States[itemStateID(&a.WorkItem)], index.States[itemStateID(&b.WorkItem)]
+		if ga, gb := slices.Index(stateGroupOrder, sa.Group), slices.Index(stateGroupOrder, sb.Group); ga != gb {
+			return ga < gb
+		}
+		if sa.Name != sb.Name {
+			return sa.Name < sb.Name
+		}
+		// Unset priorities go last
+		pa, oka := priorityRank[a.Priority]
+		pb, okb := priorityRank[b.Priority]
+		if oka != okb {
+			return oka
+		}
+		if pa != pb {
+			return pa < pb
+		}
+		return a.SequenceID < b.SequenceID
+	})
+
+	if format != "" {
+		return render(format, found)
+	}
+
+	if len(found) == 0 {
+		fmt.Printf("Nothing assigned to %s.\n", me.GetDisplayName())
+		return nil
+	}
+	projects := make(map[string]bool)
+	for _, item := range found {
+		projects[item.ProjectIdentifier] = true
+	}
+	fmt.Printf("\n📌 Assigned to %s: %d work items in %d project(s)\n", me.GetDisplayName(), len(found), len(projects))
+	fmt.Println(strings.Repeat("=", 70))
+
+	currentProject, currentState := "", ""
+	for _, item := range found {
+		if item.ProjectIdentifier != currentProject {
+			currentProject, currentState = item.ProjectIdentifier, ""
+			fmt.Printf("\n%s · %s\n", item.ProjectIdentifier, index.Projects[item.ProjectID].Name)
+		}
+		if stateID := itemStateID(&item.WorkItem); stateID != currentState {
+			currentState = stateID
+			count := 0
+			for _, other := range found {
+				if other.ProjectIdentifier == currentProject && itemStateID(&other.WorkItem) == currentState {
+					count++
+				}
+			}
+			name := index.States[currentState].Name
+			if name == "" {
+				name = currentState
+			}
+			fmt.Printf("  %s (%d)\n", name, count)
+		}
+		// Pad by the plain key, as links add invisible characters
+		key := links.format(item.Key, item.ProjectID, item.ID) + strings.Repeat(" ", max(10-len(item.Key), 0))
+		line := fmt.Sprintf("    %s %s", key, truncate(item.Name, 60))
+		if item.Priority != "" && item.Priority != "none" {
+			line += "  [" + item.Priority + "]"
+		}
+		if due := dateValue(item.TargetDate); due != "" {
+			line += "  due " + due
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+	return nil
+}
@@ -0,0 +1,166 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/console"
+	"plane-cli/internal/plane"
+)
+
+var openCmd = &cobra.Command{
+	Use:   "open <work-item>",
+	Short: "Open a work item, module, cycle or page in the browser",
+	Long: `Open a work item in the Plane web app with the default browser ($BROWSER
+when set). The address is built from the web app URL (output.web_url, or
+guessed from PLANE_BASE_URL), the workspace, the project and the item.
+
+Use --print to write the address instead, e.g. to paste it or when no
+browser is available.
+
+Examples:
+  plane-cli open PROJ-321
+  plane-cli open PROJ-321 --print
+  plane-cli open module "Payments" --project <project-id>
+  plane-cli open cycle "Sprint 14" --project <project-id>
+  plane-cli open page "Release checklist" --project <project-id>`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOpen,
+}
+
+var openModuleCmd = &cobra.Command{
+	Use:   "module <name-or-id>",
+	Short: "Open a module in the browser",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runOpenModule,
+}
+
+var openCycleCmd = &cobra.Command{
+	Use:   "cycle <name-or-id>",
+	Short: "Open a cycle in the browser",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runOpenCycle,
+}
+
+var openPageCmd = &cobra.Command{
+	Use:   "page <name-or-id>",
+	Short: "Open a page in the browser",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runOpenPage,
+}
+
+func init() {
+	rootCmd.AddCommand(openCmd)
+	openCmd.AddCommand(openModuleCmd)
+	openCmd.AddCommand(openCycleCmd)
+	openCmd.AddCommand(openPageCmd)
+
+	openCmd.PersistentFlags().Bool("print", false, "Print the address instead of opening the browser")
+	for _, c := range []*cobra.Command{openModuleCmd, openCycleCmd, openPageCmd} {
+		c.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+		c.MarkFlagRequired("project")
+	}
+}
+
+func runOpen(cmd *cobra.Command, args []string) error {
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, projectID, err := itemByIdentifier(client, args[0])
+	if err != nil {
+		return err
+	}
+	u := projectWebURL(webAppURL(cfg), resolveWorkspace(cmd, cfg), projectID, "issues", item.ID)
+	return openWebURL(cmd, u, fmt.Sprintf("%s %s", strings.ToUpper(args[0]), item.Name))
+}
+
+func runOpenModule(cmd *cobra.Command, args []string) error {
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	projectID, _ := cmd.Flags().GetString("project")
+
+	modules, err := client.GetProjectModules(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch modules: %w", err)
+	}
+	var module *plane.Module
+	for i := range modules {
+		if modules[i].ID == args[0] || strings.EqualFold(modules[i].Name, args[0]) {
+			module = &modules[i]
+			break
+		}
+	}
+	if module == nil {
+		return fmt.Errorf("module '%s' not found", args[0])
+	}
+
+	u := projectWebURL(webAppURL(cfg), resolveWorkspace(cmd, cfg), projectID, "modules", module.ID)
+	return openWebURL(cmd, u, "module "+module.Name)
+}
+
+func runOpenCycle(cmd *cobra.Command, args []string) error {
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	projectID, _ := cmd.Flags().GetString("project")
+
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch cycles: %w", err)
+	}
+	cycle, err := findCycle(cycles, args[0])
+	if err != nil {
+		return err
+	}
+
+	u := projectWebURL(webAppURL(cfg), resolveWorkspace(cmd, cfg), projectID, "cycles", cycle.ID)
+	return openWebURL(cmd, u, "cycle "+cycle.Name)
+}
+
+func runOpenPage(cmd *cobra.Command, args []string) error {
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	projectID, _ := cmd.Flags().GetString("project")
+
+	pages, err := client.GetPages(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get pages: %w", err)
+	}
+	var page *plane.Page
+	for i := range pages {
+		if pages[i].ID == args[0] || strings.EqualFold(strings.TrimSpace(pages[i].Name), strings.TrimSpace(args[0])) {
+			page = &pages[i]
+			break
+		}
+	}
+	if page == nil {
+		return fmt.Errorf("page '%s' not found", args[0])
+	}
+
+	u := projectWebURL(webAppURL(cfg), resolveWorkspace(cmd, cfg), projectID, "pages", page.ID)
+	return openWebURL(cmd, u, "page "+page.Name)
+}
+
+// openWebURL prints u with --print, and opens it in the browser otherwise.
+// The address is printed as well when no browser can be started.
+func openWebURL(cmd *cobra.Command, u, what string) error {
+	if printOnly, _ := cmd.Flags().GetBool("print"); printOnly {
+		fmt.Println(u)
+		return nil
+	}
+
+	if err := console.OpenURL(u); err != nil {
+		fmt.Println(u)
+		return fmt.Errorf("failed to open the browser: %w (use --print)", err)
+	}
+	fmt.Printf("🌐 Opened %s: %s\n", what, u)
+	return nil
+}
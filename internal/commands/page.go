@@ -30,6 +30,9 @@ Examples:
   # Delete a page
   plane-cli page delete --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 --id <page-id>
 
+  # Create a whole page hierarchy from a page set
+  plane-cli page scaffold --project c20fcc54-c675-47c4-85db-a4acdde3c9e1 --set onboarding
+
   # Interactive page management
   plane-cli page interactive`,
 }
@@ -74,32 +77,38 @@ func init() {
 	pageCmd.AddCommand(pageInteractiveCmd)
 
 	// List flags
-	pageListCmd.Flags().String("project", "", "Project identifier (required)")
+	pageListCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	pageListCmd.MarkFlagRequired("project")
 
 	// Create flags
-	pageCreateCmd.Flags().String("project", "", "Project identifier (required)")
+	pageCreateCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	pageCreateCmd.Flags().String("name", "", "Page name (required)")
 	pageCreateCmd.Flags().String("description", "", "Page content/description")
 	pageCreateCmd.Flags().String("description-file", "", "Read page content from file")
 	pageCreateCmd.Flags().String("parent", "", "Parent page ID")
 	pageCreateCmd.Flags().String("access", "public", "Page access (public, private)")
+	pageCreateCmd.Flags().Bool("upload-assets", true, "Upload local images referenced by the content")
+	pageCreateCmd.Flags().Bool("externalize-images", false, "Upload images embedded as data URIs as assets and link them instead")
+	pageCreateCmd.Flags().Bool("split", false, "Split content larger than request.max_content_kb into child pages at its top-level headings")
 	pageCreateCmd.MarkFlagRequired("project")
 	pageCreateCmd.MarkFlagRequired("name")
 
 	// Update flags
-	pageUpdateCmd.Flags().String("project", "", "Project identifier (required)")
+	pageUpdateCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	pageUpdateCmd.Flags().String("id", "", "Page ID (required)")
 	pageUpdateCmd.Flags().String("name", "", "New page name")
 	pageUpdateCmd.Flags().String("description", "", "New page content")
 	pageUpdateCmd.Flags().String("description-file", "", "Read new content from file")
 	pageUpdateCmd.Flags().String("parent", "", "New parent page ID")
 	pageUpdateCmd.Flags().String("access", "", "New access level")
+	pageUpdateCmd.Flags().Bool("upload-assets", true, "Upload local images referenced by the content")
+	pageUpdateCmd.Flags().Bool("externalize-images", false, "Upload images embedded as data URIs as assets and link them instead")
+	pageUpdateCmd.Flags().Bool("split", false, "Split content larger than request.max_content_kb into child pages at its top-level headings")
 	pageUpdateCmd.MarkFlagRequired("project")
 	pageUpdateCmd.MarkFlagRequired("id")
 
 	// Delete flags
-	pageDeleteCmd.Flags().String("project", "", "Project identifier (required)")
+	pageDeleteCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
 	pageDeleteCmd.Flags().String("id", "", "Page ID (required)")
 	pageDeleteCmd.MarkFlagRequired("project")
 	pageDeleteCmd.MarkFlagRequired("id")
@@ -112,17 +121,14 @@ func runPageList(cmd *cobra.Command, args []string) error {
 	}
 
 	projectID, _ := cmd.Flags().GetString("project")
-	workspace, _ := cmd.Flags().GetString("workspace")
-
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
 	}
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	workspace := resolveWorkspace(cmd, cfg)
+
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -133,6 +139,10 @@ func runPageList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get pages: %w", err)
 	}
 
+	if format != "" {
+		return render(format, pages)
+	}
+
 	if len(pages) == 0 {
 		fmt.Println("No pages found in this project.")
 		return nil
@@ -167,7 +177,9 @@ func runPageCreate(cmd *cobra.Command, args []string) error {
 	descriptionFile, _ := cmd.Flags().GetString("description-file")
 	parent, _ := cmd.Flags().GetString("parent")
 	access, _ := cmd.Flags().GetString("access")
-	workspace, _ := cmd.Flags().GetString("workspace")
+	uploadAssets, _ := cmd.Flags().GetBool("upload-assets")
+	externalize, _ := cmd.Flags().GetBool("externalize-images")
+	split, _ := cmd.Flags().GetBool("split")
 
 	// Read from file if specified
 	if descriptionFile != "" {
@@ -178,20 +190,25 @@ func runPageCreate(cmd *cobra.Command, args []string) error {
 		description = string(content)
 	}
 
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 	client.SetWorkspace(workspace)
 
+	if uploadAssets && description != "" {
+		if description, _, err = uploadLocalImages(client, projectID, description, contentDir(descriptionFile)); err != nil {
+			return err
+		}
+	}
+	parts, err := pageContentParts(client, cfg, projectID, markdownToHTML(description), externalize, split)
+	if err != nil {
+		return err
+	}
+	description = parts[0]
+
 	create := &plane.PageCreate{
 		Name:            name,
 		Description:     description,
@@ -212,7 +229,7 @@ func runPageCreate(cmd *cobra.Command, args []string) error {
 		fmt.Printf("   Content: %d characters\n", len(description))
 	}
 
-	return nil
+	return createContentParts(client, projectID, page, parts[1:])
 }
 
 func runPageUpdate(cmd *cobra.Command, args []string) error {
@@ -228,7 +245,9 @@ func runPageUpdate(cmd *cobra.Command, args []string) error {
 	descriptionFile, _ := cmd.Flags().GetString("description-file")
 	parent, _ := cmd.Flags().GetString("parent")
 	access, _ := cmd.Flags().GetString("access")
-	workspace, _ := cmd.Flags().GetString("workspace")
+	uploadAssets, _ := cmd.Flags().GetBool("upload-assets")
+	externalize, _ := cmd.Flags().GetBool("externalize-images")
+	split, _ := cmd.Flags().GetBool("split")
 
 	// Read from file if specified
 	if descriptionFile != "" {
@@ -239,20 +258,25 @@ func runPageUpdate(cmd *cobra.Command, args []string) error {
 		description = string(content)
 	}
 
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 	client.SetWorkspace(workspace)
 
+	if uploadAssets && description != "" {
+		if description, _, err = uploadLocalImages(client, projectID, description, contentDir(descriptionFile)); err != nil {
+			return err
+		}
+	}
+	parts, err := pageContentParts(client, cfg, projectID, markdownToHTML(description), externalize, split)
+	if err != nil {
+		return err
+	}
+	description = parts[0]
+
 	update := &plane.PageUpdate{}
 	if name != "" {
 		update.Name = name
@@ -277,7 +301,7 @@ func runPageUpdate(cmd *cobra.Command, args []string) error {
 	fmt.Printf("   ID: %s\n", page.ID)
 	fmt.Printf("   Name: %s\n", page.Name)
 
-	return nil
+	return createContentParts(client, projectID, page, parts[1:])
 }
 
 func runPageDelete(cmd *cobra.Command, args []string) error {
@@ -288,17 +312,10 @@ func runPageDelete(cmd *cobra.Command, args []string) error {
 
 	projectID, _ := cmd.Flags().GetString("project")
 	pageID, _ := cmd.Flags().GetString("id")
-	workspace, _ := cmd.Flags().GetString("workspace")
 
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -310,7 +327,7 @@ func runPageDelete(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get page: %w", err)
 	}
 
-	confirmed, err := confirm(fmt.Sprintf("Are you sure you want to delete page '%s'?", page.Name))
+	confirmed, err := confirmDestructive(pageDeleteImpact(client, projectID, page))
 	if err != nil {
 		return err
 	}
@@ -334,17 +351,9 @@ func runPageInteractive(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
-	workspace, _ := cmd.Flags().GetString("workspace")
-
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -493,7 +502,7 @@ func createPageInteractive(client *plane.Client, projectID string) error {
 				content = string(fileContent)
 			} else {
 				// Custom path
-				path, err := input("Enter file path:")
+				path, err := inputPath("Enter file path:")
 				if err != nil {
 					return err
 				}
@@ -504,7 +513,7 @@ func createPageInteractive(client *plane.Client, projectID string) error {
 				content = string(fileContent)
 			}
 		} else {
-			path, err := input("Enter file path:")
+			path, err := inputPath("Enter file path:")
 			if err != nil {
 				return err
 			}
@@ -547,7 +556,7 @@ func createPageInteractive(client *plane.Client, projectID string) error {
 	create := &plane.PageCreate{
 		Name:            name,
 		Description:     content,
-		DescriptionHTML: content,
+		DescriptionHTML: markdownToHTML(content),
 		Access:          access,
 	}
 
@@ -617,7 +626,7 @@ func updatePageInteractive(client *plane.Client, projectID string) error {
 		var content string
 		switch contentIdx {
 		case 0:
-			path, err := input("Enter file path:")
+			path, err := inputPath("Enter file path:")
 			if err != nil {
 				return err
 			}
@@ -642,7 +651,7 @@ func updatePageInteractive(client *plane.Client, projectID string) error {
 		}
 
 		update.Description = content
-		update.DescriptionHTML = content
+		update.DescriptionHTML = markdownToHTML(content)
 	}
 
 	updated, err := client.UpdatePage(projectID, page.ID, update)
@@ -677,7 +686,7 @@ func deletePageInteractive(client *plane.Client, projectID string) error {
 
 	page := pages[idx]
 
-	confirmed, err := confirm(fmt.Sprintf("Delete page '%s'?", page.Name))
+	confirmed, err := confirmDestructive(pageDeleteImpact(client, projectID, &page))
 	if err != nil {
 		return err
 	}
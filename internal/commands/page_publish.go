@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+// defaultReadmePageName is the page publish-readme keeps up to date
+const defaultReadmePageName = "Repository README"
+
+var pagePublishReadmeCmd = &cobra.Command{
+	Use:   "publish-readme",
+	Short: "Create or update a page from the repository README",
+	Long: `Mirror the repository README into a project page, meant to run on every
+release. The page is found by name and updated in place, or created when
+it doesn't exist yet.
+
+The page ends with a footer recording the README revision, so running the
+command again without changes to the README does nothing - local images
+are only uploaded when the content actually changed.
+
+Examples:
+  plane-cli page publish-readme --project <project-id>
+  plane-cli page publish-readme --project <project-id> --file docs/README.md --name "Handbook"
+  plane-cli page publish-readme --project <project-id> --dry-run`,
+	RunE: runPagePublishReadme,
+}
+
+func init() {
+	pageCmd.AddCommand(pagePublishReadmeCmd)
+
+	pagePublishReadmeCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+	pagePublishReadmeCmd.Flags().String("file", "README.md", "README file to publish")
+	pagePublishReadmeCmd.Flags().String("name", defaultReadmePageName, "Name of the page to create or update")
+	pagePublishReadmeCmd.Flags().String("access", "", "Page access when creating (public, private)")
+	pagePublishReadmeCmd.Flags().Bool("upload-assets", true, "Upload local images and rewrite their URLs")
+	pagePublishReadmeCmd.Flags().Bool("force", false, "Update the page even when the README is unchanged")
+	pagePublishReadmeCmd.Flags().Bool("dry-run", false, "Show what would change without publishing")
+	pagePublishReadmeCmd.MarkFlagRequired("project")
+}
+
+func runPagePublishReadme(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	file, _ := cmd.Flags().GetString("file")
+	name, _ := cmd.Flags().GetString("name")
+	access, _ := cmd.Flags().GetString("access")
+	uploadAssets, _ := cmd.Flags().GetBool("upload-assets")
+	force, _ := cmd.Flags().GetBool("force")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read README: %w", err)
+	}
+	content := strings.TrimSpace(string(data))
+	if content == "" {
+		return fmt.Errorf("%s is empty", file)
+	}
+	marker := readmeRevisionMarker(file, data)
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	existing, err := findPageByName(client, projectID, name)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil && !force && strings.Contains(existing.DescriptionHTML, marker) {
+		fmt.Printf("✅ Page '%s' is already up to date with %s\n", name, file)
+		return nil
+	}
+
+	if dryRun {
+		if existing != nil {
+			fmt.Printf("📝 Dry run - would update page '%s' (%s) from %s (%d characters)\n", name, existing.ID, file, len(content))
+		} else {
+			fmt.Printf("📝 Dry run - would create page '%s' from %s (%d characters)\n", name, file, len(content))
+		}
+		return nil
+	}
+
+	if uploadAssets {
+		if content, _, err = uploadLocalImages(client, projectID, content, contentDir(file)); err != nil {
+			return err
+		}
+	}
+	content = markdownToHTML(content + "\n\n---\n\n_" + marker + "_")
+
+	if existing != nil {
+		if _, err := client.UpdatePage(projectID, existing.ID, &plane.PageUpdate{DescriptionHTML: content}); err != nil {
+			return fmt.Errorf("failed to update page: %w", err)
+		}
+		fmt.Printf("✅ Updated page '%s' (%s) from %s\n", name, existing.ID, file)
+		return nil
+	}
+
+	page, err := client.CreatePage(projectID, &plane.PageCreate{
+		Name:            name,
+		DescriptionHTML: content,
+		Access:          access,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create page: %w", err)
+	}
+	fmt.Printf("✅ Created page '%s' (%s) from %s\n", page.Name, page.ID, file)
+	return nil
+}
+
+// readmeRevisionMarker returns the footer identifying the published README
+// content; the revision is a short hash of the file
+func readmeRevisionMarker(file string, data []byte) string {
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf("Published from %s (revision %s)", filepath.Base(file), hex.EncodeToString(sum[:])[:12])
+}
+
+// findPageByName returns the page with the given name, with its content, or
+// nil when there is none
+func findPageByName(client *plane.Client, projectID, name string) (*plane.Page, error) {
+	pages, err := client.GetPages(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pages: %w", err)
+	}
+	for _, p := range pages {
+		if strings.EqualFold(strings.TrimSpace(p.Name), strings.TrimSpace(name)) {
+			page, err := client.GetPage(projectID, p.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get page: %w", err)
+			}
+			return page, nil
+		}
+	}
+	return nil, nil
+}
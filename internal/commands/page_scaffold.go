@@ -0,0 +1,236 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/plane"
+)
+
+var pageScaffoldCmd = &cobra.Command{
+	Use:   "scaffold",
+	Short: "Create a hierarchy of pages from a page set",
+	Long: `Create a whole tree of pages in one go from a page set - for example the
+same wiki skeleton for every new project.
+
+A page set is a YAML file in <templates directory>/page-sets/<name>.yaml (or
+any path given to --set):
+
+  pages:
+    - name: "{{.Project}} Handbook"
+      file: handbook.md          # relative to the set file
+      children:
+        - name: Getting Started
+          content: |
+            # Getting Started
+            Welcome to {{.Project}}!
+        - name: Runbooks
+
+Page names and content are Go templates. {{.Project}} and {{.Identifier}}
+are set from the project; add more with --var key=value.
+
+Examples:
+  plane-cli page scaffold --project <project-id> --set onboarding
+  plane-cli page scaffold --project <project-id> --set onboarding --var team=Payments --dry-run`,
+	RunE: runPageScaffold,
+}
+
+// PageSet describes a hierarchy of pages created by page scaffold
+type PageSet struct {
+	Name        string         `yaml:"name,omitempty"`
+	Description string         `yaml:"description,omitempty"`
+	Pages       []PageSetEntry `yaml:"pages"`
+}
+
+// PageSetEntry is one page of a page set and its children
+type PageSetEntry struct {
+	Name     string         `yaml:"name"`
+	Content  string         `yaml:"content,omitempty"`
+	File     string         `yaml:"file,omitempty"`
+	Access   string         `yaml:"access,omitempty"`
+	Children []PageSetEntry `yaml:"children,omitempty"`
+}
+
+func init() {
+	pageCmd.AddCommand(pageScaffoldCmd)
+
+	pageScaffoldCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+	pageScaffoldCmd.Flags().String("set", "", "Page set name or YAML file (required)")
+	pageScaffoldCmd.Flags().StringToString("var", nil, "Template variables (key=value)")
+	pageScaffoldCmd.Flags().String("parent", "", "Create the pages under this page ID")
+	pageScaffoldCmd.Flags().Bool("dry-run", false, "Show the pages without creating them")
+	pageScaffoldCmd.MarkFlagRequired("project")
+	pageScaffoldCmd.MarkFlagRequired("set")
+}
+
+func runPageScaffold(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	setName, _ := cmd.Flags().GetString("set")
+	vars, _ := cmd.Flags().GetStringToString("var")
+	parent, _ := cmd.Flags().GetString("parent")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	setFile := resolvePageSetFile(setName)
+	set, err := loadPageSet(setFile)
+	if err != nil {
+		return err
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	values := map[string]string{
+		"Project":    project.Name,
+		"Identifier": project.Identifier,
+	}
+	for k, v := range vars {
+		values[k] = v
+	}
+
+	// Render everything first so template errors stop before anything is created
+	pages, err := renderPageSet(set.Pages, filepath.Dir(setFile), values)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n📋 Page set '%s' (%d pages):\n", setName, countPages(pages))
+	fmt.Println(strings.Repeat("-", 70))
+	printPageTree(pages, "")
+	fmt.Println(strings.Repeat("-", 70))
+
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no pages created.")
+		return nil
+	}
+
+	created, err := createPageTree(client, projectID, pages, parent, "")
+	fmt.Printf("\n✅ Created %d page(s)\n", created)
+	return err
+}
+
+// resolvePageSetFile maps a set name to its file in the templates directory.
+// Paths to existing files are used as-is.
+func resolvePageSetFile(set string) string {
+	if _, err := os.Stat(set); err == nil {
+		return set
+	}
+	return filepath.Join(getTemplatesDir(), "page-sets", set+".yaml")
+}
+
+// loadPageSet reads and validates a page set
+func loadPageSet(filename string) (*PageSet, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read page set: %w", err)
+	}
+
+	var set PageSet
+	if err := yaml.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse page set: %w", err)
+	}
+	if len(set.Pages) == 0 {
+		return nil, fmt.Errorf("page set %s defines no pages", filename)
+	}
+	return &set, nil
+}
+
+// renderPageSet renders the names and content of every page. Content files
+// are read relative to dir.
+func renderPageSet(entries []PageSetEntry, dir string, values map[string]string) ([]PageSetEntry, error) {
+	rendered := make([]PageSetEntry, len(entries))
+	for i, e := range entries {
+		if e.Name == "" {
+			return nil, fmt.Errorf("a page in the set has no name")
+		}
+
+		content := e.Content
+		if e.File != "" {
+			path := e.File
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(dir, path)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("page '%s': failed to read content: %w", e.Name, err)
+			}
+			content = string(data)
+		}
+
+		name, err := renderIntakeText("page name", e.Name, values)
+		if err != nil {
+			return nil, err
+		}
+		if content, err = renderIntakeText("content of "+name, content, values); err != nil {
+			return nil, err
+		}
+
+		children, err := renderPageSet(e.Children, dir, values)
+		if err != nil {
+			return nil, err
+		}
+
+		rendered[i] = PageSetEntry{Name: name, Content: content, Access: e.Access, Children: children}
+	}
+	return rendered, nil
+}
+
+// createPageTree creates pages depth-first so each child can reference its
+// parent. It returns the number of pages created.
+func createPageTree(client *plane.Client, projectID string, entries []PageSetEntry, parentID, indent string) (int, error) {
+	created := 0
+	for _, e := range entries {
+		create := &plane.PageCreate{
+			Name:     e.Name,
+			ParentID: parentID,
+			Access:   e.Access,
+		}
+		if e.Content != "" {
+			create.DescriptionHTML = markdownToHTML(e.Content)
+		}
+
+		page, err := client.CreatePage(projectID, create)
+		if err != nil {
+			fmt.Printf("%s❌ %s: %v\n", indent, e.Name, err)
+			return created, fmt.Errorf("failed to create page '%s': %w", e.Name, err)
+		}
+		fmt.Printf("%s✅ %s\n", indent, page.Name)
+		created++
+
+		n, err := createPageTree(client, projectID, e.Children, page.ID, indent+"   ")
+		created += n
+		if err != nil {
+			return created, err
+		}
+	}
+	return created, nil
+}
+
+func printPageTree(entries []PageSetEntry, indent string) {
+	for _, e := range entries {
+		size := ""
+		if e.Content != "" {
+			size = fmt.Sprintf(" (%d characters)", len(e.Content))
+		}
+		fmt.Printf("%s📄 %s%s\n", indent, e.Name, size)
+		printPageTree(e.Children, indent+"   ")
+	}
+}
+
+func countPages(entries []PageSetEntry) int {
+	n := len(entries)
+	for _, e := range entries {
+		n += countPages(e.Children)
+	}
+	return n
+}
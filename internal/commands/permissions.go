@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"plane-cli/internal/plane"
+)
+
+// menuEntry is an option of an interactive menu. Mutating entries create,
+// change or delete data and are hidden from read-only members.
+type menuEntry struct {
+	Label    string
+	Mutating bool
+	Run      func() error
+}
+
+// visibleMenuEntries drops the mutating entries when readOnly is set
+func visibleMenuEntries(entries []menuEntry, readOnly bool) []menuEntry {
+	if !readOnly {
+		return entries
+	}
+	var visible []menuEntry
+	for _, e := range entries {
+		if !e.Mutating {
+			visible = append(visible, e)
+		}
+	}
+	return visible
+}
+
+// currentWorkspaceRole returns the workspace role of the token owner
+func currentWorkspaceRole(client *plane.Client) (int, error) {
+	user, err := client.GetCurrentUser()
+	if err != nil {
+		return 0, err
+	}
+	members, err := client.GetWorkspaceMembers()
+	if err != nil {
+		return 0, err
+	}
+	for _, m := range members {
+		if m.ID == user.ID || (m.Email != "" && m.Email == user.Email) {
+			return m.Role, nil
+		}
+	}
+	return 0, fmt.Errorf("current user is not listed as a workspace member")
+}
+
+// detectReadOnly reports whether the token owner may only view data. When
+// the role cannot be determined every option stays available and the API
+// has the final word.
+func detectReadOnly(client *plane.Client) bool {
+	role, err := currentWorkspaceRole(client)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: could not determine your workspace role: %v\n", err)
+		return false
+	}
+	return role > 0 && role < plane.RoleMember
+}
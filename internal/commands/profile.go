@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+)
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage configuration profiles",
+	Long: `Switch between Plane instances with named profiles. Each profile holds
+a base URL, API token and workspace; all are stored in
+~/.config/plane-cli/profiles.yaml.
+
+The profile in use is the one given with --profile, else PLANE_PROFILE,
+else the current profile set with 'profile use'. Without any, credentials
+come from .env and the environment as before.
+
+Examples:
+  # Create profiles
+  plane-cli configure --profile work
+  plane-cli configure --profile personal
+
+  # Run one command against another instance
+  plane-cli project list --profile personal
+
+  # Make a profile the default
+  plane-cli profile use work
+  plane-cli profile list`,
+}
+
+var profileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List configuration profiles",
+	Args:  cobra.NoArgs,
+	RunE:  runProfileList,
+}
+
+var profileUseCmd = &cobra.Command{
+	Use:   "use <name>",
+	Short: "Make a profile the current one",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProfileUse,
+}
+
+func init() {
+	rootCmd.AddCommand(profileCmd)
+	profileCmd.AddCommand(profileListCmd)
+	profileCmd.AddCommand(profileUseCmd)
+}
+
+func runProfileList(cmd *cobra.Command, args []string) error {
+	profiles, err := config.LoadProfiles()
+	if err != nil {
+		return err
+	}
+	if len(profiles.Profiles) == 0 {
+		fmt.Println("No profiles yet. Create one with: plane-cli configure --profile <name>")
+		return nil
+	}
+
+	active := config.ActiveProfile()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tNAME\tBASE URL\tWORKSPACE\tTOKEN")
+	for _, name := range profiles.Names() {
+		p := profiles.Profiles[name]
+		marker := ""
+		if name == active {
+			marker = "*"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", marker, name, emptyAsDash(p.BaseURL), emptyAsDash(p.Workspace), maskToken(p.APIToken))
+	}
+	w.Flush()
+
+	if active != "" && active != profiles.Current {
+		fmt.Printf("\n* in use for this command (current profile: %s)\n", emptyAsDash(profiles.Current))
+	}
+	return nil
+}
+
+func runProfileUse(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	profiles, err := config.LoadProfiles()
+	if err != nil {
+		return err
+	}
+	if _, ok := profiles.Profiles[name]; !ok {
+		return fmt.Errorf("profile '%s' not found (create it with 'plane-cli configure --profile %s')", name, name)
+	}
+
+	profiles.Current = name
+	if err := profiles.Save(); err != nil {
+		return err
+	}
+	fmt.Printf("✅ Now using profile '%s'\n", name)
+	return nil
+}
+
+// maskToken shows only the end of an API token
+func maskToken(token string) string {
+	if token == "" {
+		return "-"
+	}
+	if len(token) <= 8 {
+		return "****"
+	}
+	return "****" + token[len(token)-4:]
+}
@@ -12,7 +12,7 @@ import (
 var projectCmd = &cobra.Command{
 	Use:   "project",
 	Short: "Manage projects",
-	Long: `List and select projects from your Plane workspace.
+	Long: `List, select, create, update and delete projects in your Plane workspace.
 
 Examples:
   # List all projects
@@ -21,8 +21,19 @@ Examples:
   # Search projects
   plane-cli project list --search "admin"
 
+  # Show open work items and the distribution of states per project
+  plane-cli project list --with-stats
+
   # Select project for commands
-  plane-cli project select`,
+  plane-cli project select
+
+  # Make a project the default for commands taking --project
+  plane-cli project use PROJ
+
+  # Create, rename and delete projects
+  plane-cli project create --name "Mobile App" --identifier MOB
+  plane-cli project update MOB --name "Mobile Apps"
+  plane-cli project delete MOB`,
 }
 
 var projectListCmd = &cobra.Command{
@@ -40,13 +51,29 @@ This is useful for setting a default project for subsequent commands.`,
 	RunE: runProjectSelect,
 }
 
+var projectUseCmd = &cobra.Command{
+	Use:   "use <project>",
+	Short: "Set the default project",
+	Long: `Make a project the default for every command that takes --project, by
+storing its ID as defaults.project in config.yaml. The project can be given
+by ID, identifier or name.
+
+The nearest project config.yaml is updated, or the per-user one
+(~/.config/plane-cli/config.yaml) when there is none. --project still wins
+over the default.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectUse,
+}
+
 func init() {
 	rootCmd.AddCommand(projectCmd)
 	projectCmd.AddCommand(projectListCmd)
 	projectCmd.AddCommand(projectSelectCmd)
+	projectCmd.AddCommand(projectUseCmd)
 
 	// List flags
 	projectListCmd.Flags().String("search", "", "Search projects by name")
+	projectListCmd.Flags().Bool("with-stats", false, "Show open work items and a bar per state (one request per project)")
 }
 
 func runProjectList(cmd *cobra.Command, args []string) error {
@@ -56,17 +83,15 @@ func runProjectList(cmd *cobra.Command, args []string) error {
 	}
 
 	search, _ := cmd.Flags().GetString("search")
-	workspace, _ := cmd.Flags().GetString("workspace")
-
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
+	withStats, _ := cmd.Flags().GetBool("with-stats")
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
 	}
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	workspace := resolveWorkspace(cmd, cfg)
+
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -83,6 +108,22 @@ func runProjectList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to fetch projects: %w", err)
 	}
 
+	var stats []*projectStats
+	if withStats && len(projects) > 0 {
+		stats = fetchProjectStats(client, projects)
+	}
+
+	if format != "" {
+		if !withStats {
+			return render(format, projects)
+		}
+		rows := make([]projectWithStats, len(projects))
+		for i, p := range projects {
+			rows[i] = projectWithStats{Project: p, Stats: stats[i]}
+		}
+		return render(format, rows)
+	}
+
 	if len(projects) == 0 {
 		if search != "" {
 			fmt.Printf("No projects found matching '%s'.\n", search)
@@ -93,6 +134,10 @@ func runProjectList(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("\nAvailable projects (%d):\n\n", len(projects))
+	if withStats {
+		printProjectStatsTable(projects, stats)
+		return nil
+	}
 	fmt.Printf("%-5s %-20s %-30s %s\n", "#", "IDENTIFIER", "NAME", "DESCRIPTION")
 	fmt.Println(strings.Repeat("-", 90))
 
@@ -114,16 +159,9 @@ func runProjectSelect(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
-	workspace, _ := cmd.Flags().GetString("workspace")
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -158,14 +196,14 @@ func runProjectSelect(cmd *cobra.Command, args []string) error {
 	selected := projects[idx]
 	fmt.Printf("\n✓ Selected project: %s (%s)\n", selected.Name, selected.Identifier)
 	fmt.Printf("\nUse this project with: --project %s\n", selected.Identifier)
-	fmt.Printf("Or set as default in config.yaml:\n  defaults:\n    project: \"%s\"\n", selected.Identifier)
+	fmt.Printf("Or make it the default: plane-cli project use %s\n", selected.Identifier)
 
 	return nil
 }
 
 // InteractiveProjectSelector allows selecting a project interactively
 func InteractiveProjectSelector(client *plane.Client) (*plane.Project, error) {
-	projects, err := client.GetProjects()
+	projects, err := pickerProjects(client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch projects: %w", err)
 	}
@@ -192,3 +230,37 @@ func InteractiveProjectSelector(client *plane.Client) (*plane.Project, error) {
 
 	return &projects[idx], nil
 }
+
+func runProjectUse(cmd *cobra.Command, args []string) error {
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	projects, err := client.GetProjects()
+	if err != nil {
+		return fmt.Errorf("failed to fetch projects: %w", err)
+	}
+	project, err := findProject(projects, args[0])
+	if err != nil {
+		return err
+	}
+
+	path, err := config.SetDefaultProject(project.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ Default project: %s (%s), saved to %s\n", project.Name, project.Identifier, path)
+	return nil
+}
+
+// findProject returns the project with the given ID, identifier or name
+func findProject(projects []plane.Project, ref string) (*plane.Project, error) {
+	ref = strings.TrimSpace(ref)
+	for i, p := range projects {
+		if p.ID == ref || strings.EqualFold(p.Identifier, ref) || strings.EqualFold(p.Name, ref) {
+			return &projects[i], nil
+		}
+	}
+	return nil, fmt.Errorf("project '%s' not found (see 'plane-cli project list')", ref)
+}
@@ -0,0 +1,189 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/plane"
+)
+
+var projectExportBlueprintCmd = &cobra.Command{
+	Use:   "export-blueprint",
+	Short: "Export the structure of a project as a YAML blueprint",
+	Long: `Export the structure of a project - states, labels, modules, estimate
+scale and member roles - into a YAML blueprint. Work items are not included.
+
+The blueprint can be kept in version control and replayed to set up new
+projects with the same workflow.
+
+Examples:
+  # Write the blueprint to a file
+  plane-cli project export-blueprint --project <project-id> --out blueprint.yaml
+
+  # Print the blueprint to stdout
+  plane-cli project export-blueprint --project <project-id>`,
+	RunE: runProjectExportBlueprint,
+}
+
+// Blueprint describes the reusable structure of a project
+type Blueprint struct {
+	Version  int                `yaml:"version"`
+	Project  BlueprintProject   `yaml:"project"`
+	States   []BlueprintState   `yaml:"states,omitempty"`
+	Labels   []BlueprintLabel   `yaml:"labels,omitempty"`
+	Modules  []BlueprintModule  `yaml:"modules,omitempty"`
+	Estimate *BlueprintEstimate `yaml:"estimate,omitempty"`
+	Members  []BlueprintMember  `yaml:"members,omitempty"`
+}
+
+// BlueprintProject holds the descriptive fields of the source project
+type BlueprintProject struct {
+	Name        string `yaml:"name"`
+	Identifier  string `yaml:"identifier"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// BlueprintState is a workflow state in a blueprint
+type BlueprintState struct {
+	Name  string `yaml:"name"`
+	Group string `yaml:"group"`
+	Color string `yaml:"color,omitempty"`
+}
+
+// BlueprintLabel is a label in a blueprint
+type BlueprintLabel struct {
+	Name  string `yaml:"name"`
+	Color string `yaml:"color,omitempty"`
+}
+
+// BlueprintModule is a module in a blueprint
+type BlueprintModule struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	Status      string `yaml:"status,omitempty"`
+}
+
+// BlueprintEstimate is the estimate scale of a blueprint
+type BlueprintEstimate struct {
+	Name   string   `yaml:"name"`
+	Type   string   `yaml:"type,omitempty"`
+	Points []string `yaml:"points"`
+}
+
+// BlueprintMember is a member role assignment in a blueprint
+type BlueprintMember struct {
+	Email       string `yaml:"email"`
+	DisplayName string `yaml:"display_name,omitempty"`
+	Role        string `yaml:"role,omitempty"`
+}
+
+func init() {
+	projectCmd.AddCommand(projectExportBlueprintCmd)
+
+	projectExportBlueprintCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+	projectExportBlueprintCmd.Flags().String("out", "", "Output file (default: stdout)")
+	projectExportBlueprintCmd.MarkFlagRequired("project")
+}
+
+func runProjectExportBlueprint(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	out, _ := cmd.Flags().GetString("out")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	blueprint, err := buildBlueprint(client, projectID)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(blueprint)
+	if err != nil {
+		return fmt.Errorf("failed to encode blueprint: %w", err)
+	}
+
+	if out == "" {
+		fmt.Print(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(out, data, 0644); err != nil {
+		return fmt.Errorf("failed to write blueprint: %w", err)
+	}
+
+	fmt.Printf("✅ Blueprint written to %s\n", out)
+	fmt.Printf("   States: %d | Labels: %d | Modules: %d | Members: %d\n",
+		len(blueprint.States), len(blueprint.Labels), len(blueprint.Modules), len(blueprint.Members))
+	return nil
+}
+
+// buildBlueprint collects the structure of a project
+func buildBlueprint(client *plane.Client, projectID string) (*Blueprint, error) {
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	blueprint := &Blueprint{
+		Version: 1,
+		Project: BlueprintProject{
+			Name:        project.Name,
+			Identifier:  project.Identifier,
+			Description: project.Description,
+		},
+	}
+
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get states: %w", err)
+	}
+	for _, s := range states {
+		blueprint.States = append(blueprint.States, BlueprintState{Name: s.Name, Group: s.Group, Color: s.Color})
+	}
+
+	labels, err := client.GetLabels(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+	for _, l := range labels {
+		blueprint.Labels = append(blueprint.Labels, BlueprintLabel{Name: l.Name, Color: l.Color})
+	}
+
+	modules, err := client.GetModules(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get modules: %w", err)
+	}
+	for _, m := range modules {
+		blueprint.Modules = append(blueprint.Modules, BlueprintModule{Name: m.Name, Description: m.Description, Status: m.Status})
+	}
+
+	// Estimates are optional - projects without an estimate scale are common
+	if estimates, err := client.GetEstimates(projectID); err == nil && len(estimates) > 0 {
+		e := estimates[0]
+		estimate := &BlueprintEstimate{Name: e.Name, Type: e.Type}
+		for _, p := range e.Points {
+			estimate.Points = append(estimate.Points, p.Value)
+		}
+		blueprint.Estimate = estimate
+	} else if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: estimate scale not exported: %v\n", err)
+	}
+
+	members, err := client.GetProjectMembers(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members: %w", err)
+	}
+	for _, m := range members {
+		blueprint.Members = append(blueprint.Members, BlueprintMember{
+			Email:       m.Email,
+			DisplayName: m.GetDisplayName(),
+			Role:        plane.RoleName(m.Role),
+		})
+	}
+
+	return blueprint, nil
+}
@@ -0,0 +1,348 @@
+package commands
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+	"plane-cli/internal/plane"
+)
+
+var projectCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a new project",
+	Long: `Create a project in the workspace. The identifier prefixes the keys of its
+work items (MOB-12); without --identifier it is made from the first
+letters of the name, as the Plane web app does.
+
+Examples:
+  plane-cli project create --name "Mobile App" --identifier MOB
+  plane-cli project create --name "Internal Tools" --private --description "Scripts and dashboards"`,
+	Args: cobra.NoArgs,
+	RunE: runProjectCreate,
+}
+
+var projectUpdateCmd = &cobra.Command{
+	Use:   "update <project>",
+	Short: "Update a project",
+	Long: `Update the name, identifier or description of a project, given by ID,
+identifier or name. Changing the identifier changes the keys of all its
+work items.
+
+Examples:
+  plane-cli project update MOB --name "Mobile Apps"
+  plane-cli project update MOB --identifier APP`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectUpdate,
+}
+
+var projectDeleteCmd = &cobra.Command{
+	Use:   "delete <project>",
+	Short: "Delete a project and all its work items",
+	Long: `Delete a project, given by ID, identifier or name, with all its work items,
+cycles, modules and pages. The number of work items is shown and must be
+confirmed unless --yes is given.
+
+Before deleting, the full JSON of every work item and its comments is saved
+to a timestamped file in the snapshots directory next to the configuration
+(e.g. ~/.config/plane-cli/snapshots/). Pass --no-snapshot to skip it.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectDelete,
+}
+
+// projectIdentifierPattern matches the identifiers Plane accepts
+var projectIdentifierPattern = regexp.MustCompile(`^[A-Z0-9]{1,12}$`)
+
+func init() {
+	projectCmd.AddCommand(projectCreateCmd)
+	projectCmd.AddCommand(projectUpdateCmd)
+	projectCmd.AddCommand(projectDeleteCmd)
+
+	projectCreateCmd.Flags().String("name", "", "Project name (required)")
+	projectCreateCmd.Flags().String("identifier", "", "Work item key prefix, up to 12 letters or digits (default: from the name)")
+	projectCreateCmd.Flags().String("description", "", "Project description")
+	projectCreateCmd.Flags().Bool("private", false, "Only invited members can see the project")
+	projectCreateCmd.MarkFlagRequired("name")
+
+	projectUpdateCmd.Flags().String("name", "", "New project name")
+	projectUpdateCmd.Flags().String("identifier", "", "New work item key prefix")
+	projectUpdateCmd.Flags().String("description", "", "New project description")
+
+	projectDeleteCmd.Flags().Bool("yes", false, "Skip confirmation prompt")
+	projectDeleteCmd.Flags().Bool("no-snapshot", false, "Don't save a JSON snapshot of the work items before deleting")
+}
+
+func runProjectCreate(cmd *cobra.Command, args []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	identifier, _ := cmd.Flags().GetString("identifier")
+	description, _ := cmd.Flags().GetString("description")
+	private, _ := cmd.Flags().GetBool("private")
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("project name is required")
+	}
+	if identifier == "" {
+		identifier = suggestProjectIdentifier(name)
+	}
+	identifier, err := normalizeProjectIdentifier(identifier)
+	if err != nil {
+		return err
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	create := &plane.ProjectCreate{
+		Name:        name,
+		Identifier:  identifier,
+		Description: description,
+		Network:     plane.ProjectNetworkPublic,
+	}
+	if private {
+		create.Network = plane.ProjectNetworkPrivate
+	}
+	project, err := client.CreateProject(create)
+	if err != nil {
+		return projectError(err, identifier)
+	}
+
+	fmt.Printf("\n✅ Created project:\n")
+	fmt.Printf("   ID: %s\n", project.ID)
+	fmt.Printf("   Name: %s\n", project.Name)
+	fmt.Printf("   Identifier: %s\n", project.Identifier)
+	fmt.Printf("\n💡 Make it the default with: plane-cli project use %s\n", project.Identifier)
+	return nil
+}
+
+func runProjectUpdate(cmd *cobra.Command, args []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	identifier, _ := cmd.Flags().GetString("identifier")
+	description, _ := cmd.Flags().GetString("description")
+
+	update := &plane.ProjectUpdate{
+		Name:        strings.TrimSpace(name),
+		Description: description,
+	}
+	if identifier != "" {
+		var err error
+		if update.Identifier, err = normalizeProjectIdentifier(identifier); err != nil {
+			return err
+		}
+	}
+	if *update == (plane.ProjectUpdate{}) {
+		return fmt.Errorf("nothing to update: give --name, --identifier or --description")
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	projects, err := client.GetProjects()
+	if err != nil {
+		return fmt.Errorf("failed to fetch projects: %w", err)
+	}
+	current, err := findProject(projects, args[0])
+	if err != nil {
+		return err
+	}
+
+	project, err := client.UpdateProject(current.ID, update)
+	if err != nil {
+		return projectError(err, update.Identifier)
+	}
+
+	fmt.Printf("\n✅ Updated project:\n")
+	fmt.Printf("   ID: %s\n", project.ID)
+	fmt.Printf("   Name: %s\n", project.Name)
+	fmt.Printf("   Identifier: %s\n", project.Identifier)
+	return nil
+}
+
+func runProjectDelete(cmd *cobra.Command, args []string) error {
+	yes, _ := cmd.Flags().GetBool("yes")
+	noSnapshot, _ := cmd.Flags().GetBool("no-snapshot")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	projects, err := client.GetProjects()
+	if err != nil {
+		return fmt.Errorf("failed to fetch projects: %w", err)
+	}
+	project, err := findProject(projects, args[0])
+	if err != nil {
+		return err
+	}
+
+	impact := projectDeleteImpact(client, project)
+	if yes {
+		impact.print()
+	} else {
+		confirmed, err := confirmDestructive(impact)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("❌ Deletion cancelled.")
+			return nil
+		}
+	}
+
+	snapshot := ""
+	if !noSnapshot {
+		items, err := fetchAllWorkItemsForProject(client, project.ID)
+		if err == nil {
+			snapshot, err = writeItemSnapshot(client, project.ID, project.Identifier, "project-delete", items)
+		}
+		if err != nil {
+			return fmt.Errorf("%w (nothing deleted; pass --no-snapshot to delete without one)", err)
+		}
+	}
+
+	if err := client.DeleteProject(project.ID); err != nil {
+		return err
+	}
+
+	if snapshot != "" {
+		fmt.Printf("\n💾 Snapshot: %s\n", snapshot)
+	}
+	fmt.Printf("\n✅ Project %s (%s) deleted.\n", project.Name, project.Identifier)
+	if config.DefaultProject() == project.ID {
+		fmt.Println("⚠️  It was defaults.project; pick another with: plane-cli project use <project>")
+	}
+	return nil
+}
+
+// projectDeleteImpact describes deleting a project with its work items
+func projectDeleteImpact(client *plane.Client, project *plane.Project) impactSummary {
+	action := fmt.Sprintf("Delete project '%s' (%s) with all its work items, cycles, modules and pages.", project.Name, project.Identifier)
+
+	count, err := client.WorkItemsPager(project.ID, nil).Count()
+	if err != nil {
+		return impactSummary{Action: action, Notes: []string{fmt.Sprintf("work items could not be counted: %v", err)}}
+	}
+	return impactSummary{Action: action, Items: count, CountOnly: true, Notes: []string{"this cannot be undone"}}
+}
+
+// suggestProjectIdentifier makes an identifier from the first five letters
+// and digits of a project name
+func suggestProjectIdentifier(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(name) {
+		if r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			b.WriteRune(r)
+		}
+		if b.Len() == 5 {
+			break
+		}
+	}
+	return b.String()
+}
+
+// normalizeProjectIdentifier upper-cases an identifier and checks Plane
+// accepts it
+func normalizeProjectIdentifier(identifier string) (string, error) {
+	identifier = strings.ToUpper(strings.TrimSpace(identifier))
+	if !projectIdentifierPattern.MatchString(identifier) {
+		return "", fmt.Errorf("invalid project identifier '%s': use 1 to 12 letters or digits", identifier)
+	}
+	return identifier, nil
+}
+
+// projectError explains an identifier already taken by another project
+func projectError(err error, identifier string) error {
+	if identifier != "" && plane.StatusCode(err) == http.StatusConflict {
+		return fmt.Errorf("the identifier %s is already used by another project in the workspace: %w", identifier, err)
+	}
+	return err
+}
+
+// createProjectInteractive asks for the details of a new project and
+// creates it
+func createProjectInteractive(client *plane.Client) error {
+	fmt.Println("\n" + strings.Repeat("-", 70))
+	fmt.Println("                    📁 NEW PROJECT")
+	fmt.Println(strings.Repeat("-", 70))
+
+	name, err := input("Project name:")
+	if err != nil {
+		return err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("project name is required")
+	}
+
+	var identifier string
+	for {
+		answer, err := inputWithDefault("Identifier (work item key prefix):", suggestProjectIdentifier(name))
+		if err != nil {
+			return err
+		}
+		if identifier, err = normalizeProjectIdentifier(answer); err == nil {
+			break
+		}
+		fmt.Printf("❌ %v\n", err)
+	}
+
+	description, err := inputWithDefault("Description (optional):", "")
+	if err != nil {
+		return err
+	}
+
+	visibility, err := selectOption("Who can see the project?", []string{
+		"Public - every workspace member",
+		"Private - only invited members",
+	})
+	if err != nil {
+		return err
+	}
+
+	create := &plane.ProjectCreate{
+		Name:        name,
+		Identifier:  identifier,
+		Description: description,
+		Network:     plane.ProjectNetworkPublic,
+	}
+	if visibility == 1 {
+		create.Network = plane.ProjectNetworkPrivate
+	}
+
+	fmt.Printf("\n📋 New project: %s (%s), %s\n", create.Name, create.Identifier, map[int]string{
+		plane.ProjectNetworkPublic:  "public",
+		plane.ProjectNetworkPrivate: "private",
+	}[create.Network])
+	confirmed, err := confirm("Create this project?")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("❌ Project not created.")
+		return nil
+	}
+
+	project, err := client.CreateProject(create)
+	if err != nil {
+		return projectError(err, identifier)
+	}
+	fmt.Printf("\n✅ Created project: %s (%s, ID: %s)\n", project.Name, project.Identifier, project.ID)
+
+	makeDefault, err := confirm("Make it the default project for commands?")
+	if err != nil || !makeDefault {
+		return err
+	}
+	path, err := config.SetDefaultProject(project.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("✅ Default project saved to %s\n", path)
+	return nil
+}
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"plane-cli/internal/console"
+	"plane-cli/internal/plane"
+)
+
+// projectStatsWorkers bounds the projects whose stats are fetched at once
+const projectStatsWorkers = 4
+
+// sparkBars are the bar heights of a state distribution, lowest first
+var sparkBars = []rune("▁▂▃▄▅▆▇█")
+
+// projectStats counts the work items of a project per state
+type projectStats struct {
+	Open   int          `json:"open_items"`
+	Total  int          `json:"total_items"`
+	States []stateCount `json:"states"`
+}
+
+// stateCount is the number of work items in one state
+type stateCount struct {
+	Name  string `json:"name"`
+	Group string `json:"group"`
+	Count int    `json:"count"`
+}
+
+// projectWithStats is a project row of 'project list --with-stats'
+type projectWithStats struct {
+	plane.Project
+	Stats *projectStats `json:"stats,omitempty"`
+}
+
+// fetchProjectStats fetches the stats of every project concurrently. A
+// project whose stats can't be fetched gets nil and a warning.
+func fetchProjectStats(client *plane.Client, projects []plane.Project) []*projectStats {
+	stats := make([]*projectStats, len(projects))
+	progress := console.IsTerminal(os.Stderr)
+
+	var mu sync.Mutex
+	done := 0
+	var warnings []string
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(projectStatsWorkers, len(projects)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				s, err := loadProjectStats(client, projects[i].ID)
+
+				mu.Lock()
+				stats[i] = s
+				if err != nil {
+					warnings = append(warnings, fmt.Sprintf("⚠️  Warning: no stats for %s: %v", projects[i].Identifier, err))
+				}
+				done++
+				if progress {
+					fmt.Fprintf(os.Stderr, "\r📊 Fetching project stats %d/%d...", done, len(projects))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for i := range projects {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if progress {
+		fmt.Fprintf(os.Stderr, "\r%s\r", strings.Repeat(" ", 50))
+	}
+	for _, w := range warnings {
+		fmt.Fprintln(os.Stderr, w)
+	}
+	return stats
+}
+
+// loadProjectStats counts the work items of a project per state, with
+// states in workflow order
+func loadProjectStats(client *plane.Client, projectID string) (*projectStats, error) {
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get states: %w", err)
+	}
+	slices.SortStableFunc(states, func(a, b plane.State) int {
+		return slices.Index(stateGroupOrder, a.Group) - slices.Index(stateGroupOrder, b.Group)
+	})
+
+	counts := make(map[string]int)
+	options := map[string]string{}
+	plane.SelectFields(options, []string{"state"}, nil)
+	pager := client.WorkItemsPager(projectID, options)
+	for pager.More() {
+		page, err := pager.Next()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch work items: %w", err)
+		}
+		for i := range page {
+			counts[itemStateID(&page[i])]++
+		}
+	}
+
+	stats := &projectStats{}
+	for _, s := range states {
+		n := counts[s.ID]
+		stats.States = append(stats.States, stateCount{Name: s.Name, Group: s.Group, Count: n})
+		stats.Total += n
+		if s.Group != "completed" && s.Group != "cancelled" {
+			stats.Open += n
+		}
+	}
+	return stats, nil
+}
+
+// sparkline draws the state distribution as one bar per state, scaled to
+// the fullest state; empty states are blank
+func (s *projectStats) sparkline() string {
+	counts := make([]float64, len(s.States))
+	for i, c := range s.States {
+		counts[i] = float64(c.Count)
+	}
+	return sparkline(counts)
+}
+
+// printProjectStatsTable prints the project list with open counts and state
+// distributions
+func printProjectStatsTable(projects []plane.Project, stats []*projectStats) {
+	fmt.Printf("%-5s %-20s %-30s %-8s %s\n", "#", "IDENTIFIER", "NAME", "OPEN", "STATES")
+	fmt.Println(strings.Repeat("-", 90))
+
+	for i, p := range projects {
+		open, bars := "?", "-"
+		if s := stats[i]; s != nil {
+			open = fmt.Sprintf("%d/%d", s.Open, s.Total)
+			bars = s.sparkline()
+		}
+		fmt.Printf("%-5d %-20s %-30s %-8s %s\n", i+1, p.Identifier, truncate(p.Name, 30), open, bars)
+	}
+
+	fmt.Println("\nOPEN counts work items outside completed and cancelled states; STATES")
+	fmt.Println("shows one bar per state in workflow order, from backlog to cancelled.")
+	fmt.Println()
+}
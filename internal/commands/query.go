@@ -0,0 +1,201 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"plane-cli/internal/plane"
+	"plane-cli/internal/query"
+)
+
+// loadQueryContext fetches the project metadata a query refers to
+func loadQueryContext(client *plane.Client, projectID string, q *query.Query) (*query.Context, error) {
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get states: %w", err)
+	}
+
+	var labels []plane.Label
+	if q.Uses("label") {
+		if labels, err = client.GetLabels(projectID); err != nil {
+			return nil, fmt.Errorf("failed to get labels: %w", err)
+		}
+	}
+
+	var members []plane.Member
+	if q.Uses("assignee") {
+		if members, err = client.GetProjectMembers(projectID); err != nil {
+			return nil, fmt.Errorf("failed to get members: %w", err)
+		}
+	}
+
+	ctx := query.NewContext(states, labels, members)
+
+	if q.UsesValue("assignee", "me") {
+		me, err := client.GetCurrentUser()
+		if err != nil {
+			return nil, err
+		}
+		ctx.Me = me.ID
+	}
+
+	if q.Uses("cycle") {
+		if err := loadQueryCycles(client, projectID, q, ctx); err != nil {
+			return nil, err
+		}
+	}
+	if q.Uses("module") {
+		if err := loadQueryModules(client, projectID, q, ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if names := q.Properties(); len(names) > 0 {
+		if err := loadQueryProperties(client, projectID, names, ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return ctx, nil
+}
+
+// loadQueryCycles indexes the cycles of a project and the work items of the
+// cycles the query names. All cycles are loaded for cycle:none.
+func loadQueryCycles(client *plane.Client, projectID string, q *query.Query, ctx *query.Context) error {
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get cycles: %w", err)
+	}
+
+	ctx.Cycles = make(map[string]plane.Cycle)
+	ctx.ItemCycles = make(map[string][]string)
+	for _, c := range cycles {
+		ctx.Cycles[c.ID] = c
+		if !q.UsesValue("cycle", "none") && !q.UsesValue("cycle", c.ID) && !q.UsesValue("cycle", c.Name) {
+			continue
+		}
+		items, err := client.GetCycleWorkItems(projectID, c.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get work items of cycle '%s': %w", c.Name, err)
+		}
+		for _, item := range items {
+			ctx.ItemCycles[item.ID] = append(ctx.ItemCycles[item.ID], c.ID)
+		}
+	}
+	return nil
+}
+
+// loadQueryModules indexes the modules of a project and the work items of
+// the modules the query names. All modules are loaded for module:none.
+func loadQueryModules(client *plane.Client, projectID string, q *query.Query, ctx *query.Context) error {
+	modules, err := client.GetModules(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get modules: %w", err)
+	}
+
+	ctx.Modules = make(map[string]plane.Module)
+	ctx.ItemModules = make(map[string][]string)
+	for _, m := range modules {
+		ctx.Modules[m.ID] = m
+		if !q.UsesValue("module", "none") && !q.UsesValue("module", m.ID) && !q.UsesValue("module", m.Name) {
+			continue
+		}
+		items, err := client.GetModuleWorkItems(projectID, m.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get work items of module '%s': %w", m.Name, err)
+		}
+		for _, item := range items {
+			ctx.ItemModules[item.ID] = append(ctx.ItemModules[item.ID], m.ID)
+		}
+	}
+	return nil
+}
+
+// loadQueryProperties indexes the custom properties of every work item type
+// and checks that the properties the query names exist
+func loadQueryProperties(client *plane.Client, projectID string, names []string, ctx *query.Context) error {
+	types, err := client.GetWorkItemTypes(projectID)
+	if err != nil {
+		return fmt.Errorf("custom properties are not available in this project: %w", err)
+	}
+
+	ctx.Properties = make(map[string][]plane.WorkItemProperty)
+	for _, t := range types {
+		properties, err := client.GetWorkItemProperties(projectID, t.ID)
+		if err != nil {
+			return err
+		}
+		for _, p := range properties {
+			if p.TypeID == "" {
+				p.TypeID = t.ID
+			}
+			name, display := strings.ToLower(p.Name), strings.ToLower(p.DisplayName)
+			ctx.Properties[name] = append(ctx.Properties[name], p)
+			if display != "" && display != name {
+				ctx.Properties[display] = append(ctx.Properties[display], p)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := ctx.Properties[strings.ToLower(name)]; !ok {
+			return fmt.Errorf("unknown custom property %q", name)
+		}
+	}
+
+	ctx.PropertyValues = func(item *plane.WorkItem, property *plane.WorkItemProperty) ([]string, error) {
+		return client.GetWorkItemPropertyValues(projectID, item.ID, property.ID)
+	}
+	return nil
+}
+
+// fetchMatchingWorkItems fetches the work items of a project that match a
+// query. Filters the API understands are sent with the request; the full
+// query is then applied client-side. When fields is not empty only those
+// fields (plus the ones the query reads) are requested.
+func fetchMatchingWorkItems(client *plane.Client, projectID string, q *query.Query, ctx *query.Context, fields []string) ([]plane.WorkItem, error) {
+	options := q.APIFilters(ctx)
+	if len(fields) > 0 {
+		plane.SelectFields(options, append(fields, q.APIFields()...), nil)
+	}
+
+	return fetchPages(client.WorkItemsPager(projectID, options), func(item *plane.WorkItem) bool {
+		return q.Match(item, ctx)
+	})
+}
+
+// splitSearch separates the filter terms of a search such as
+// 'assignee:me group:started login' from the words matched against titles.
+// The terms are added to the conditions of where, which may be nil; the
+// returned query is nil when there are none. A search that doesn't parse
+// is all title words, and err says why.
+func splitSearch(search string, where *query.Query) (*query.Query, string, error) {
+	q, err := query.Parse(search)
+	if err != nil {
+		return where, search, err
+	}
+	text := strings.Join(q.Words, " ")
+	if where == nil && len(q.Terms) == 0 {
+		return nil, text, nil
+	}
+	combined := &query.Query{Terms: q.Terms}
+	if where != nil {
+		combined.Terms = append(append([]query.Term{}, where.Terms...), q.Terms...)
+	}
+	return combined, text, nil
+}
+
+// filterWorkItems keeps the work items of a project matching q
+func filterWorkItems(client *plane.Client, projectID string, q *query.Query, items []plane.WorkItem) ([]plane.WorkItem, error) {
+	ctx, err := loadQueryContext(client, projectID, q)
+	if err != nil {
+		return nil, err
+	}
+	var matching []plane.WorkItem
+	for i := range items {
+		if q.Match(&items[i], ctx) {
+			matching = append(matching, items[i])
+		}
+	}
+	return matching, nil
+}
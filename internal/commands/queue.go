@@ -0,0 +1,370 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+	"plane-cli/internal/offline"
+	"plane-cli/internal/plane"
+)
+
+var queueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Replay changes queued while the API was unreachable",
+	Long: `Commands given --queue (create, update, delete and comment add) save
+themselves to a local queue when they fail because the API is unreachable,
+instead of failing. Once the connection is back, 'queue flush' runs them
+again in the order they were queued.
+
+A queued change to a work item that was updated on the server after it was
+queued is a conflict: it is kept in the queue, along with the later changes
+to the same work item, until it is flushed with --force or dropped.
+
+Examples:
+  plane-cli update --id WEB-12 --state Done --queue
+  plane-cli queue list
+  plane-cli queue flush
+  plane-cli queue flush --force
+  plane-cli queue drop 3`,
+}
+
+var queueListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the queued changes",
+	RunE:  runQueueList,
+}
+
+var queueFlushCmd = &cobra.Command{
+	Use:   "flush",
+	Short: "Replay the queued changes in order",
+	RunE:  runQueueFlush,
+}
+
+var queueDropCmd = &cobra.Command{
+	Use:   "drop <number>...",
+	Short: "Remove queued changes without running them",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runQueueDrop,
+}
+
+func init() {
+	rootCmd.AddCommand(queueCmd)
+	queueCmd.AddCommand(queueListCmd)
+	queueCmd.AddCommand(queueFlushCmd)
+	queueCmd.AddCommand(queueDropCmd)
+
+	queueFlushCmd.Flags().Bool("force", false, "Replay changes to work items updated since they were queued")
+	queueFlushCmd.Flags().Bool("dry-run", false, "Show what would be replayed and the conflicts without running anything")
+}
+
+// addQueueFlag adds --queue to a command changing the work items returned
+// by targets, which may be nil for commands creating them. With the flag,
+// the command is queued instead of failing when the API is unreachable.
+func addQueueFlag(cmd *cobra.Command, targets func(cmd *cobra.Command, args []string) []string) {
+	cmd.Flags().Bool("queue", false, "Queue the change for 'plane-cli queue flush' when the API is unreachable")
+	if targets == nil {
+		targets = func(*cobra.Command, []string) []string { return nil }
+	}
+	run := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		err := run(cmd, args)
+		if err != nil && queueCommand(cmd, targets(cmd, args), err) {
+			return nil
+		}
+		return err
+	}
+}
+
+// queueCommand saves a command that failed because the API is unreachable
+// to the queue, when it was given --queue, and reports whether it did
+func queueCommand(cmd *cobra.Command, targets []string, runErr error) bool {
+	if queue, _ := cmd.Flags().GetBool("queue"); !queue || classifyFailure(runErr) != failureNetwork {
+		return false
+	}
+
+	var replay []string
+	for _, arg := range expandAlias(os.Args[1:]) {
+		switch arg {
+		case "--queue", "--queue=true":
+			continue
+		case "-", "@-":
+			fmt.Fprintln(os.Stderr, "⚠️  Not queued: input read from stdin can't be replayed")
+			return false
+		}
+		replay = append(replay, arg)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return false
+	}
+	dir, err := offlineDir()
+	if err != nil {
+		return false
+	}
+	queue, err := offline.LoadQueue(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Not queued: %v\n", err)
+		return false
+	}
+	wd, _ := os.Getwd()
+	project, _ := cmd.Flags().GetString("project")
+	op := queue.Add(offline.Operation{
+		Args:      replay,
+		Dir:       wd,
+		Profile:   config.ActiveProfile(),
+		Workspace: resolveWorkspace(cmd, cfg),
+		Project:   project,
+		Targets:   targets,
+		QueuedAt:  time.Now(),
+		Error:     runErr.Error(),
+	})
+	if err := queue.Save(dir); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Not queued: %v\n", err)
+		return false
+	}
+
+	fmt.Fprintf(os.Stderr, "\n📥 The API is unreachable (%v)\n", runErr)
+	fmt.Fprintf(os.Stderr, "   Queued as #%d (%d pending); replay with: plane-cli queue flush\n", op.ID, len(queue.Operations))
+	return true
+}
+
+// loadQueue reads the queue and the directory holding it
+func loadQueue() (*offline.Queue, string, error) {
+	dir, err := offlineDir()
+	if err != nil {
+		return nil, "", err
+	}
+	queue, err := offline.LoadQueue(dir)
+	if err != nil {
+		return nil, "", err
+	}
+	return queue, dir, nil
+}
+
+func runQueueList(cmd *cobra.Command, args []string) error {
+	queue, _, err := loadQueue()
+	if err != nil {
+		return err
+	}
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format != "" {
+		return render(format, queue.Operations)
+	}
+
+	if len(queue.Operations) == 0 {
+		fmt.Println("No queued changes.")
+		return nil
+	}
+	fmt.Printf("\n📥 Queued changes (%d):\n\n", len(queue.Operations))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tQUEUED\tCOMMAND")
+	for _, op := range queue.Operations {
+		fmt.Fprintf(w, "%d\t%s\t%s\n", op.ID, op.QueuedAt.Local().Format("2006-01-02 15:04"), truncate(queueCommandLine(op), 80))
+	}
+	w.Flush()
+	fmt.Println()
+	return nil
+}
+
+// queueCommandLine returns a queued command as it would be typed
+func queueCommandLine(op offline.Operation) string {
+	return rootCmd.Name() + " " + shellJoin(redactArgs(op.Args))
+}
+
+func runQueueFlush(cmd *cobra.Command, args []string) error {
+	force, _ := cmd.Flags().GetBool("force")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	queue, dir, err := loadQueue()
+	if err != nil {
+		return err
+	}
+	if len(queue.Operations) == 0 {
+		fmt.Println("✅ Nothing queued.")
+		return nil
+	}
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to find the plane-cli executable: %w", err)
+	}
+
+	fmt.Printf("\n📤 Replaying %d queued change(s)...\n", len(queue.Operations))
+	var kept []offline.Operation
+	// held are the work items with a change kept in the queue; later
+	// changes to them are kept too, to stay in order
+	held := make(map[string]bool)
+	// replayedItems are the work items changed by this flush, whose new
+	// update time is not a conflict
+	replayedItems := make(map[string]bool)
+	replayed, conflicts := 0, 0
+	var failure error
+	for _, op := range queue.Operations {
+		fmt.Printf("\n▶️  #%d %s\n", op.ID, queueCommandLine(op))
+		if failure != nil {
+			kept = append(kept, op)
+			continue
+		}
+
+		if target := heldTarget(op, held); target != "" {
+			fmt.Printf("   ⏸️  Kept: an earlier change to %s is still queued\n", target)
+			kept = append(kept, op)
+			continue
+		}
+		if !force {
+			conflict, err := queueConflict(client, op, replayedItems)
+			if err != nil {
+				fmt.Printf("   ❌ The API is still unreachable: %v\n", err)
+				failure = fmt.Errorf("#%d couldn't be checked", op.ID)
+				kept = append(kept, op)
+				continue
+			}
+			if conflict != "" {
+				fmt.Printf("   ⚠️  Conflict: %s\n", conflict)
+				kept = append(kept, op)
+				holdTargets(op, held)
+				conflicts++
+				continue
+			}
+		}
+		if dryRun {
+			fmt.Println("   📝 Would replay")
+			kept = append(kept, op)
+			continue
+		}
+
+		replay := exec.CommandContext(cmd.Context(), exe, op.Args...)
+		replay.Dir = op.Dir
+		replay.Stdin, replay.Stdout, replay.Stderr = os.Stdin, os.Stdout, os.Stderr
+		if op.Profile != "" {
+			replay.Env = append(os.Environ(), "PLANE_PROFILE="+op.Profile)
+		}
+		if err := replay.Run(); err != nil {
+			// Later changes may depend on this one: stop here
+			fmt.Printf("   ❌ Failed: %v\n", err)
+			failure = fmt.Errorf("#%d failed", op.ID)
+			kept = append(kept, op)
+			continue
+		}
+		replayed++
+		holdTargets(op, replayedItems)
+	}
+
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no changes made.")
+		return nil
+	}
+	queue.Operations = kept
+	if err := queue.Save(dir); err != nil {
+		return err
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Printf("✅ Replayed %d of %d queued change(s)\n", replayed, replayed+len(kept))
+	if conflicts > 0 {
+		fmt.Printf("⚠️  %d conflict(s): check the work items, then run queue flush --force or queue drop <number>\n", conflicts)
+	}
+	if failure != nil {
+		return fmt.Errorf("%w; %d change(s) left in the queue", failure, len(kept))
+	}
+	if len(kept) > 0 {
+		return fmt.Errorf("%d change(s) left in the queue", len(kept))
+	}
+	return nil
+}
+
+// queueConflict describes why a queued change may overwrite someone else's:
+// a work item it changes was updated on the server, or deleted, since it
+// was queued. Work items in skip were changed by earlier queued changes.
+// It returns "" when there is no conflict, and an error when the API can't
+// be reached.
+func queueConflict(client *plane.Client, op offline.Operation, skip map[string]bool) (string, error) {
+	client.SetWorkspace(op.Workspace)
+	for _, ref := range op.Targets {
+		if skip[strings.ToUpper(ref)] {
+			continue
+		}
+		item, err := queuedItem(client, op.Project, ref)
+		switch {
+		case plane.IsNotFound(err):
+			return fmt.Sprintf("%s no longer exists", ref), nil
+		case err != nil && classifyFailure(err) == failureNetwork:
+			return "", err
+		case err != nil:
+			return fmt.Sprintf("couldn't check %s: %v", ref, err), nil
+		}
+		if item.UpdatedAt.After(op.QueuedAt) {
+			return fmt.Sprintf("%s was updated on %s, after the change was queued on %s", ref,
+				item.UpdatedAt.Local().Format("2006-01-02 15:04"), op.QueuedAt.Local().Format("2006-01-02 15:04")), nil
+		}
+	}
+	return "", nil
+}
+
+// queuedItem fetches a work item given as PROJ-12, or as a sequence number
+// or UUID in projectID
+func queuedItem(client *plane.Client, projectID, ref string) (*plane.WorkItem, error) {
+	if isUUID(ref) {
+		return client.GetWorkItem(projectID, ref)
+	}
+	if _, err := strconv.Atoi(ref); err == nil {
+		project, err := client.GetProject(projectID)
+		if err != nil {
+			return nil, err
+		}
+		ref = project.Identifier + "-" + ref
+	}
+	return client.GetWorkItemByIdentifier(strings.ToUpper(ref))
+}
+
+// heldTarget returns the first work item of op in held, or ""
+func heldTarget(op offline.Operation, held map[string]bool) string {
+	for _, ref := range op.Targets {
+		if held[strings.ToUpper(ref)] {
+			return ref
+		}
+	}
+	return ""
+}
+
+// holdTargets adds the work items of op to held
+func holdTargets(op offline.Operation, held map[string]bool) {
+	for _, ref := range op.Targets {
+		held[strings.ToUpper(ref)] = true
+	}
+}
+
+func runQueueDrop(cmd *cobra.Command, args []string) error {
+	queue, dir, err := loadQueue()
+	if err != nil {
+		return err
+	}
+	for _, arg := range args {
+		id, err := strconv.Atoi(strings.TrimPrefix(arg, "#"))
+		if err != nil {
+			return fmt.Errorf("invalid queue number '%s'", arg)
+		}
+		if !queue.Remove(id) {
+			return fmt.Errorf("no queued change #%d (see plane-cli queue list)", id)
+		}
+	}
+	if err := queue.Save(dir); err != nil {
+		return err
+	}
+	fmt.Printf("🗑️  Dropped %d queued change(s); %d left\n", len(args), len(queue.Operations))
+	return nil
+}
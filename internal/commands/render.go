@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+)
+
+// Output formats for the global --output flag
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// outputFormat returns the format selected with --output
+func outputFormat(cmd *cobra.Command) (string, error) {
+	format, _ := cmd.Flags().GetString("output")
+	format = strings.ToLower(strings.TrimSpace(format))
+	switch format {
+	case "":
+		return outputTable, nil
+	case outputTable, outputJSON, outputYAML:
+		return format, nil
+	}
+	return "", fmt.Errorf("unknown output format '%s' (use table, json or yaml)", format)
+}
+
+// structuredOutput returns the --output format when it is json or yaml, and
+// "" for tables. Commands print their tables when it returns "".
+func structuredOutput(cmd *cobra.Command) (string, error) {
+	format, err := outputFormat(cmd)
+	if err != nil || format == outputTable {
+		return "", err
+	}
+	return format, nil
+}
+
+// render writes v to stdout as JSON or YAML. Both use the JSON field names
+// of the API types, so jq filters and YAML keys match.
+func render(format string, v any) error {
+	return renderTo(os.Stdout, format, v)
+}
+
+func renderTo(w io.Writer, format string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode output: %w", err)
+	}
+
+	if format == outputJSON {
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+
+	// JSON is valid YAML; decoding it into a node keeps the field order
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return fmt.Errorf("failed to encode output: %w", err)
+	}
+	blockStyle(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return fmt.Errorf("failed to encode output: %w", err)
+	}
+	enc.Close()
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// blockStyle clears the flow style the JSON syntax left on a YAML node tree
+func blockStyle(n *yaml.Node) {
+	n.Style &^= yaml.FlowStyle
+	if n.Kind == yaml.ScalarNode && n.Style&(yaml.DoubleQuotedStyle) != 0 && n.Tag == "!!str" {
+		n.Style &^= yaml.DoubleQuotedStyle
+	}
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
@@ -0,0 +1,421 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+	"plane-cli/internal/report"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate sprint and workload reports",
+	Long: `Generate reports that can be shared with stakeholders outside the terminal.
+
+Reports are printed as text by default. Use --format html to write a
+standalone HTML page, or --format pdf to convert that page to PDF with a
+headless renderer (wkhtmltopdf or Chromium/Chrome, found on PATH or given
+with --pdf-renderer).
+
+Examples:
+  # Sprint summary in the terminal
+  plane-cli report sprint --project <project-id> --cycle "Sprint 12"
+
+  # Share it as HTML or PDF
+  plane-cli report sprint --project <project-id> --cycle "Sprint 12" --format html
+  plane-cli report sprint --project <project-id> --cycle "Sprint 12" --format pdf --out sprint-12.pdf
+
+  # Open work per assignee
+  plane-cli report workload --project <project-id> --format html
+
+  # Items created and completed per day over the last 12 weeks
+  plane-cli report heatmap --project <project-id> --weeks 12 --format svg`,
+}
+
+var reportSprintCmd = &cobra.Command{
+	Use:   "sprint",
+	Short: "Summarize the progress of a cycle",
+	RunE:  runReportSprint,
+}
+
+var reportWorkloadCmd = &cobra.Command{
+	Use:   "workload",
+	Short: "Summarize open work per assignee",
+	RunE:  runReportWorkload,
+}
+
+// unassignedLabel stands in for the assignee of unassigned work items
+const unassignedLabel = "(unassigned)"
+
+// stateGroupOrder lists Plane state groups in workflow order
+var stateGroupOrder = []string{"backlog", "unstarted", "started", "completed", "cancelled"}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+	reportCmd.AddCommand(reportSprintCmd)
+	reportCmd.AddCommand(reportWorkloadCmd)
+
+	for _, c := range []*cobra.Command{reportSprintCmd, reportWorkloadCmd} {
+		c.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+		c.Flags().String("format", report.FormatText, "Output format: text, html or pdf")
+		c.Flags().String("out", "", "Output file for html/pdf (default: <report>.<format>)")
+		c.Flags().String("pdf-renderer", "", "Path to wkhtmltopdf or a Chromium-based browser")
+		c.MarkFlagRequired("project")
+	}
+	reportSprintCmd.Flags().String("cycle", "", "Cycle name or ID (required)")
+	reportSprintCmd.MarkFlagRequired("cycle")
+}
+
+func runReportSprint(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	cycleRef, _ := cmd.Flags().GetString("cycle")
+	format, _ := cmd.Flags().GetString("format")
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	links, err := newItemLinker(cfg, resolveWorkspace(cmd, cfg))
+	if err != nil {
+		return err
+	}
+
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get cycles: %w", err)
+	}
+	cycle, err := findCycle(cycles, cycleRef)
+	if err != nil {
+		return err
+	}
+
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+
+	items, err := client.GetCycleWorkItems(projectID, cycle.ID)
+	if err != nil {
+		return err
+	}
+
+	doc := sprintReport(cycle, items, lookup, links, projectID, format)
+	return writeReport(cmd, doc, "sprint-"+slugify(cycle.Name), links)
+}
+
+// sprintReport summarizes the progress of a cycle: totals, state groups,
+// progress per assignee and every work item, open work first
+func sprintReport(cycle *plane.Cycle, items []plane.WorkItem, lookup *itemLookup, links *itemLinker, projectID, format string) *report.Document {
+	doc := &report.Document{
+		Title:       "Sprint report: " + cycle.Name,
+		Subtitle:    lookup.projectName,
+		GeneratedAt: time.Now(),
+	}
+	if start, end := dateValue(cycle.StartDate), dateValue(cycle.EndDate); start != "" || end != "" {
+		doc.Subtitle += fmt.Sprintf(" · %s → %s", emptyAsDash(start), emptyAsDash(end))
+	}
+
+	// Progress by state group
+	groups := make(map[string]int)
+	for i := range items {
+		groups[lookup.stateGroup(&items[i])]++
+	}
+	done := groups["completed"]
+	scope := len(items) - groups["cancelled"]
+	completion := 0.0
+	if scope > 0 {
+		completion = float64(done) / float64(scope) * 100
+	}
+
+	summary := report.Section{
+		Heading: "Summary",
+		Metrics: []report.Metric{
+			{Label: "Work items", Value: fmt.Sprintf("%d", len(items))},
+			{Label: "Completed", Value: fmt.Sprintf("%d", done)},
+			{Label: "In progress", Value: fmt.Sprintf("%d", groups["started"])},
+			{Label: "Not started", Value: fmt.Sprintf("%d", groups["backlog"]+groups["unstarted"])},
+			{Label: "Completion", Value: fmt.Sprintf("%.0f%%", completion)},
+		},
+	}
+	if cycle.EndDate != nil {
+		if end, err := time.ParseInLocation("2006-01-02", dateValue(cycle.EndDate), time.Local); err == nil {
+			days := int(time.Until(end.Add(24*time.Hour)).Hours() / 24)
+			if days >= 0 {
+				summary.Metrics = append(summary.Metrics, report.Metric{Label: "Days left", Value: fmt.Sprintf("%d", days)})
+			}
+		}
+	}
+	doc.Sections = append(doc.Sections, summary)
+
+	byGroup := report.Section{Heading: "By state group"}
+	for _, g := range stateGroupOrder {
+		byGroup.Bars = append(byGroup.Bars, report.Bar{
+			Label: g,
+			Value: float64(groups[g]),
+			Max:   float64(len(items)),
+			Text:  fmt.Sprintf("%d", groups[g]),
+		})
+	}
+	doc.Sections = append(doc.Sections, byGroup)
+
+	// Progress per assignee
+	type progress struct{ done, total int }
+	perAssignee := make(map[string]*progress)
+	for i := range items {
+		group := lookup.stateGroup(&items[i])
+		if group == "cancelled" {
+			continue
+		}
+		for _, name := range lookup.assigneeNames(&items[i]) {
+			p := perAssignee[name]
+			if p == nil {
+				p = &progress{}
+				perAssignee[name] = p
+			}
+			p.total++
+			if group == "completed" {
+				p.done++
+			}
+		}
+	}
+	byAssignee := report.Section{Heading: "By assignee"}
+	for _, name := range sortedMapKeys(perAssignee) {
+		p := perAssignee[name]
+		byAssignee.Bars = append(byAssignee.Bars, report.Bar{
+			Label: name,
+			Value: float64(p.done),
+			Max:   float64(p.total),
+			Text:  fmt.Sprintf("%d/%d done", p.done, p.total),
+		})
+	}
+	doc.Sections = append(doc.Sections, byAssignee)
+
+	// Every item, open work first
+	sorted := append([]plane.WorkItem(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		gi := groupRank(lookup.stateGroup(&sorted[i]))
+		gj := groupRank(lookup.stateGroup(&sorted[j]))
+		if gi != gj {
+			return gi < gj
+		}
+		return sorted[i].SequenceID < sorted[j].SequenceID
+	})
+	table := &report.Table{Columns: []string{"ID", "Title", "State", "Priority", "Assignees"}}
+	for i := range sorted {
+		item := &sorted[i]
+		key := fmt.Sprintf("%s-%d", lookup.projectIdentifier, item.SequenceID)
+		if strings.EqualFold(format, report.FormatText) {
+			key = links.cell(key, projectID, item.ID)
+		}
+		table.Links = append(table.Links, links.url(projectID, item.ID))
+		table.Rows = append(table.Rows, []string{
+			key,
+			truncate(item.Name, 60),
+			lookup.stateNames[itemStateID(item)],
+			item.Priority,
+			strings.Join(lookup.assigneeNames(item), ", "),
+		})
+	}
+	doc.Sections = append(doc.Sections, report.Section{Heading: "Work items", Table: table})
+	return doc
+}
+
+func runReportWorkload(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "📥 Fetching work items from project '%s'...\n", projectID)
+	items, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	type workload struct{ open, started, urgent, high int }
+	perAssignee := make(map[string]*workload)
+	open := 0
+	for i := range items {
+		group := lookup.stateGroup(&items[i])
+		if group == "completed" || group == "cancelled" {
+			continue
+		}
+		open++
+		for _, name := range lookup.assigneeNames(&items[i]) {
+			w := perAssignee[name]
+			if w == nil {
+				w = &workload{}
+				perAssignee[name] = w
+			}
+			w.open++
+			if group == "started" {
+				w.started++
+			}
+			switch items[i].Priority {
+			case "urgent":
+				w.urgent++
+			case "high":
+				w.high++
+			}
+		}
+	}
+
+	names := sortedMapKeys(perAssignee)
+	sort.SliceStable(names, func(i, j int) bool {
+		return perAssignee[names[i]].open > perAssignee[names[j]].open
+	})
+
+	most := 0
+	for _, w := range perAssignee {
+		most = max(most, w.open)
+	}
+
+	bars := report.Section{Heading: "Open work items per assignee"}
+	table := &report.Table{Columns: []string{"Assignee", "Open", "In progress", "Urgent", "High"}}
+	for _, name := range names {
+		w := perAssignee[name]
+		bars.Bars = append(bars.Bars, report.Bar{
+			Label: name,
+			Value: float64(w.open),
+			Max:   float64(most),
+			Text:  fmt.Sprintf("%d", w.open),
+		})
+		table.Rows = append(table.Rows, []string{
+			name,
+			fmt.Sprintf("%d", w.open),
+			fmt.Sprintf("%d", w.started),
+			fmt.Sprintf("%d", w.urgent),
+			fmt.Sprintf("%d", w.high),
+		})
+	}
+
+	unassigned := 0
+	if w, ok := perAssignee[unassignedLabel]; ok {
+		unassigned = w.open
+	}
+
+	doc := &report.Document{
+		Title:       "Workload report",
+		Subtitle:    lookup.projectName,
+		GeneratedAt: time.Now(),
+		Sections: []report.Section{
+			{
+				Heading: "Summary",
+				Metrics: []report.Metric{
+					{Label: "Open work items", Value: fmt.Sprintf("%d", open)},
+					{Label: "Assignees", Value: fmt.Sprintf("%d", len(perAssignee)-min(unassigned, 1))},
+					{Label: "Unassigned", Value: fmt.Sprintf("%d", unassigned)},
+				},
+			},
+			bars,
+			{Heading: "Breakdown", Table: table},
+		},
+	}
+
+	return writeReport(cmd, doc, "workload", nil)
+}
+
+// writeReport renders the document in the format chosen by --format. Text
+// written to the terminal gets the links registered with links.
+func writeReport(cmd *cobra.Command, doc *report.Document, name string, links *itemLinker) error {
+	format, _ := cmd.Flags().GetString("format")
+	out, _ := cmd.Flags().GetString("out")
+	renderer, _ := cmd.Flags().GetString("pdf-renderer")
+
+	format = strings.ToLower(format)
+	if out == "" && format != report.FormatText {
+		out = name + "." + format
+	}
+
+	switch format {
+	case report.FormatText:
+		if out == "" {
+			var buf bytes.Buffer
+			if err := report.RenderText(&buf, doc); err != nil {
+				return err
+			}
+			return links.writeTable(os.Stdout, buf.Bytes())
+		}
+		f, err := os.Create(out)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", out, err)
+		}
+		defer f.Close()
+		if err := report.RenderText(f, doc); err != nil {
+			return err
+		}
+
+	case report.FormatHTML:
+		f, err := os.Create(out)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", out, err)
+		}
+		defer f.Close()
+		if err := report.RenderHTML(f, doc); err != nil {
+			return err
+		}
+
+	case report.FormatPDF:
+		if err := report.RenderPDF(doc, out, renderer); err != nil {
+			return err
+		}
+
+	case report.FormatSVG:
+		f, err := os.Create(out)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", out, err)
+		}
+		defer f.Close()
+		if err := report.RenderSVG(f, doc); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("unknown format '%s' (use text, html or pdf)", format)
+	}
+
+	fmt.Printf("✅ Report written to %s\n", out)
+	return nil
+}
+
+func groupRank(group string) int {
+	for i, g := range stateGroupOrder {
+		if g == group {
+			// Open work first, in reverse workflow order
+			if i < 3 {
+				return 2 - i
+			}
+			return i
+		}
+	}
+	return len(stateGroupOrder)
+}
+
+func sortedMapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func emptyAsDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
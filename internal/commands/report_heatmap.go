@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/report"
+)
+
+var reportHeatmapCmd = &cobra.Command{
+	Use:   "heatmap",
+	Short: "Show work items created and completed per day",
+	Long: `Draw GitHub-style activity heatmaps of a project: one for the work items
+created per day and one for those completed per day, over the last --weeks
+weeks. Darker cells are busier days, relative to the busiest day.
+
+Use --format svg to write both heatmaps as an image for slides, or html/pdf
+for a full report. Items completed before Plane recorded completion times
+count on the day they were last updated.
+
+Examples:
+  plane-cli report heatmap --project <project-id>
+  plane-cli report heatmap --project <project-id> --weeks 26 --format svg --out activity.svg`,
+	RunE: runReportHeatmap,
+}
+
+func init() {
+	reportCmd.AddCommand(reportHeatmapCmd)
+
+	reportHeatmapCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+	reportHeatmapCmd.Flags().Int("weeks", 12, "Number of weeks to show, ending with the current week")
+	reportHeatmapCmd.Flags().String("format", report.FormatText, "Output format: text, html, pdf or svg")
+	reportHeatmapCmd.Flags().String("out", "", "Output file for html/pdf/svg (default: heatmap.<format>)")
+	reportHeatmapCmd.Flags().String("pdf-renderer", "", "Path to wkhtmltopdf or a Chromium-based browser")
+	reportHeatmapCmd.MarkFlagRequired("project")
+}
+
+func runReportHeatmap(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	weeks, _ := cmd.Flags().GetInt("weeks")
+	if weeks < 1 || weeks > 53 {
+		return fmt.Errorf("--weeks must be between 1 and 53")
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "📥 Fetching work items from project '%s'...\n", projectID)
+	items, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	now := time.Now()
+	created := report.NewHeatmap(now, weeks)
+	completed := report.NewHeatmap(now, weeks)
+	for i := range items {
+		created.Add(items[i].CreatedAt)
+		switch {
+		case items[i].CompletedAt != nil:
+			completed.Add(*items[i].CompletedAt)
+		case lookup.stateGroup(&items[i]) == "completed":
+			completed.Add(items[i].UpdatedAt)
+		}
+	}
+
+	busiest := func(h *report.Heatmap) string {
+		day, count := h.Busiest()
+		if count == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%s (%d)", day.Format("Mon 2006-01-02"), count)
+	}
+	end := created.Start.AddDate(0, 0, len(created.Counts)-1)
+
+	doc := &report.Document{
+		Title:       "Activity heatmap",
+		Subtitle:    fmt.Sprintf("%s · %s → %s", lookup.projectName, created.Start.Format("2006-01-02"), end.Format("2006-01-02")),
+		GeneratedAt: now,
+		Sections: []report.Section{
+			{
+				Heading: "Summary",
+				Metrics: []report.Metric{
+					{Label: "Created", Value: fmt.Sprintf("%d", created.Total())},
+					{Label: "Completed", Value: fmt.Sprintf("%d", completed.Total())},
+					{Label: "Busiest day (created)", Value: busiest(created)},
+					{Label: "Busiest day (completed)", Value: busiest(completed)},
+				},
+			},
+			{Heading: "Created per day", Heatmap: created},
+			{Heading: "Completed per day", Heatmap: completed},
+		},
+	}
+
+	return writeReport(cmd, doc, "heatmap", nil)
+}
@@ -0,0 +1,284 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"plane-cli/internal/console"
+	"plane-cli/internal/fuzzy"
+	"plane-cli/internal/plane"
+)
+
+// uuidPattern matches the IDs the API uses for every object
+var uuidPattern = regexp.MustCompile(`^(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+func isUUID(s string) bool {
+	return uuidPattern.MatchString(s)
+}
+
+// memberResolver maps the assignees given in flags to member IDs. Members
+// are fetched once, the first time a value isn't already an ID.
+type memberResolver struct {
+	client    *plane.Client
+	projectID string
+	members   []plane.Member
+	loaded    bool
+}
+
+// newMemberResolver resolves against the members of projectID, or of the
+// workspace when projectID is empty or its members can't be listed
+func newMemberResolver(client *plane.Client, projectID string) *memberResolver {
+	return &memberResolver{client: client, projectID: projectID}
+}
+
+func (r *memberResolver) load() error {
+	if r.loaded {
+		return nil
+	}
+	var err error
+	if r.projectID != "" {
+		r.members, err = r.client.GetProjectMembers(r.projectID)
+	}
+	if r.projectID == "" || err != nil {
+		if r.members, err = r.client.GetWorkspaceMembers(); err != nil {
+			return fmt.Errorf("failed to get members: %w", err)
+		}
+	}
+	r.loaded = true
+	return nil
+}
+
+// resolve maps member IDs, emails, display names, full names or "me" to
+// member IDs. A name shared by several members is an error naming them.
+func (r *memberResolver) resolve(refs []string) ([]string, error) {
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		if isUUID(ref) {
+			ids = append(ids, ref)
+			continue
+		}
+		if strings.EqualFold(ref, "me") {
+			user, err := r.client.GetCurrentUser()
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, user.ID)
+			continue
+		}
+
+		if err := r.load(); err != nil {
+			return nil, err
+		}
+		member, err := matchMember(r.members, ref)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, member.ID)
+	}
+	return ids, nil
+}
+
+// matchMember finds the member with ref as email, display name or full
+// name, ignoring case
+func matchMember(members []plane.Member, ref string) (*plane.Member, error) {
+	for i, m := range members {
+		if m.ID == ref || strings.EqualFold(m.Email, ref) {
+			return &members[i], nil
+		}
+	}
+
+	var matches []*plane.Member
+	for i, m := range members {
+		fullName := strings.TrimSpace(m.FirstName + " " + m.LastName)
+		if strings.EqualFold(m.DisplayName, ref) || (fullName != "" && strings.EqualFold(fullName, ref)) {
+			matches = append(matches, &members[i])
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("unknown assignee '%s' (see plane-cli member list)", ref)
+	case 1:
+		return matches[0], nil
+	}
+	emails := make([]string, len(matches))
+	for i, m := range matches {
+		emails[i] = m.Email
+	}
+	return nil, fmt.Errorf("assignee '%s' matches several members (%s); use an email instead", ref, strings.Join(emails, ", "))
+}
+
+// labelResolver maps the labels given in flags to label IDs. Labels are
+// fetched once, the first time a value isn't already an ID.
+type labelResolver struct {
+	client    *plane.Client
+	projectID string
+	// create makes missing labels instead of failing; under dryRun they
+	// are only reported
+	create bool
+	dryRun bool
+	labels []plane.Label
+	loaded bool
+}
+
+func newLabelResolver(client *plane.Client, projectID string, create, dryRun bool) *labelResolver {
+	return &labelResolver{client: client, projectID: projectID, create: create, dryRun: dryRun}
+}
+
+// resolve maps label IDs or names, ignoring case, to label IDs
+func (r *labelResolver) resolve(refs []string) ([]string, error) {
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+		if isUUID(ref) {
+			ids = append(ids, ref)
+			continue
+		}
+
+		if !r.loaded {
+			if r.projectID == "" {
+				return nil, fmt.Errorf("--project is required to find label '%s' by name", ref)
+			}
+			labels, err := r.client.GetLabels(r.projectID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get labels: %w", err)
+			}
+			r.labels, r.loaded = labels, true
+		}
+
+		i := slices.IndexFunc(r.labels, func(l plane.Label) bool { return strings.EqualFold(l.Name, ref) })
+		switch {
+		case i >= 0:
+			ids = append(ids, r.labels[i].ID)
+		case !r.create:
+			return nil, fmt.Errorf("unknown label '%s' (see plane-cli label list, or pass --create-labels)", ref)
+		case r.dryRun:
+			fmt.Printf("📝 Would create label '%s'\n", ref)
+		default:
+			label, err := r.client.CreateLabel(r.projectID, &plane.LabelCreate{Name: ref})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create label '%s': %w", ref, err)
+			}
+			fmt.Printf("🏷️  Created label '%s'\n", label.Name)
+			r.labels = append(r.labels, *label)
+			ids = append(ids, label.ID)
+		}
+	}
+	return ids, nil
+}
+
+// resolveState maps a state ID or name, ignoring case, to the state ID
+func resolveState(client *plane.Client, projectID, ref string) (string, error) {
+	if ref == "" || isUUID(ref) {
+		return ref, nil
+	}
+	if projectID == "" {
+		return "", fmt.Errorf("--project is required to find state '%s' by name", ref)
+	}
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get states: %w", err)
+	}
+	id, err := stateIDByName(states, ref)
+	if err != nil {
+		return "", fmt.Errorf("%w (see plane-cli state list)", err)
+	}
+	return id, nil
+}
+
+// nameMatchScore is the fuzzy score a module or cycle name needs to be
+// offered for a flag value matching no name exactly
+const nameMatchScore = 60
+
+// resolveModule maps a module ID or name to the module ID
+func resolveModule(client *plane.Client, projectID, ref string) (string, error) {
+	if ref == "" || isUUID(ref) {
+		return ref, nil
+	}
+	if projectID == "" {
+		return "", fmt.Errorf("--project is required to find module '%s' by name", ref)
+	}
+	modules, err := client.GetModules(projectID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get modules: %w", err)
+	}
+	ids := make([]string, len(modules))
+	names := make([]string, len(modules))
+	for i, m := range modules {
+		ids[i], names[i] = m.ID, m.Name
+	}
+	return resolveNamed("module", ref, ids, names)
+}
+
+// resolveCycle maps a cycle ID or name to the cycle ID
+func resolveCycle(client *plane.Client, projectID, ref string) (string, error) {
+	if ref == "" || isUUID(ref) {
+		return ref, nil
+	}
+	if projectID == "" {
+		return "", fmt.Errorf("--project is required to find cycle '%s' by name", ref)
+	}
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get cycles: %w", err)
+	}
+	ids := make([]string, len(cycles))
+	names := make([]string, len(cycles))
+	for i, c := range cycles {
+		ids[i], names[i] = c.ID, c.Name
+	}
+	return resolveNamed("cycle", ref, ids, names)
+}
+
+// resolveNamed finds the ID of the object with ID or name ref, ignoring
+// case. Without an exact name the names matching fuzzily are candidates.
+// A single candidate is used; between several the user picks in a
+// terminal, elsewhere it is an error naming them.
+func resolveNamed(kind, ref string, ids, names []string) (string, error) {
+	var candidates []int
+	for i := range ids {
+		if ids[i] == ref {
+			return ids[i], nil
+		}
+		if strings.EqualFold(names[i], ref) {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		for _, match := range fuzzy.NewMatcher(nameMatchScore).FindMatches(ref, names) {
+			candidates = append(candidates, match.Index)
+		}
+		if len(candidates) == 1 {
+			fmt.Printf("🔎 Using %s '%s' for '%s'\n", kind, names[candidates[0]], ref)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("unknown %s '%s' (see plane-cli %s list)", kind, ref, kind)
+	case 1:
+		return ids[candidates[0]], nil
+	}
+
+	options := make([]string, len(candidates))
+	for i, c := range candidates {
+		options[i] = fmt.Sprintf("%s (%s)", names[c], ids[c])
+	}
+	if !console.IsTerminal(os.Stdin) {
+		return "", fmt.Errorf("%s '%s' matches several: %s; pass the ID instead", kind, ref, strings.Join(options, ", "))
+	}
+	idx, err := selectOption(fmt.Sprintf("Several %ss match '%s':", kind, ref), options)
+	if err != nil {
+		return "", err
+	}
+	return ids[candidates[idx]], nil
+}
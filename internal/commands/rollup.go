@@ -0,0 +1,363 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var rollupCmd = &cobra.Command{
+	Use:   "rollup",
+	Short: "Sum sub-item estimates and completion into their parents",
+	Long: `Sum the estimates and completion of every parent's sub-items, at any
+depth, so epic-level numbers stay current.
+
+For each work item with sub-items the table shows how many sub-items there
+are and how many are done (completed state group; cancelled ones are left
+out), and the estimate points in total and done. Progress is by points
+when sub-items are estimated, by count otherwise.
+
+The totals can be written back to the parents:
+  --write-description  keep a "Rollup" section at the end of the
+                       description up to date
+  --property NAME      set a custom property: the points total for a
+                       number property, the summary text otherwise
+
+Examples:
+  plane-cli rollup --project PROJ
+  plane-cli rollup --project PROJ --parent PROJ-5
+  plane-cli rollup --project PROJ --write-description --dry-run
+  plane-cli rollup --project PROJ --property "Rolled up points" --yes`,
+	RunE: runRollup,
+}
+
+func init() {
+	rootCmd.AddCommand(rollupCmd)
+
+	rollupCmd.Flags().String("project", "", "Project ID (required unless defaults.project is set)")
+	rollupCmd.MarkFlagRequired("project")
+	rollupCmd.Flags().String("parent", "", "Only roll up this work item, e.g. PROJ-5")
+	rollupCmd.Flags().Bool("write-description", false, "Write the totals into a section of each parent's description")
+	rollupCmd.Flags().String("property", "", "Write the totals into this custom property of each parent")
+	rollupCmd.Flags().Bool("dry-run", false, "Show what would be written without changing anything")
+	rollupCmd.Flags().Bool("yes", false, "Write without asking for confirmation")
+}
+
+// itemRollup is the sum of a parent's sub-items
+type itemRollup struct {
+	Key        string  `json:"key"`
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	SubItems   int     `json:"sub_items"`
+	Done       int     `json:"done"`
+	Cancelled  int     `json:"cancelled"`
+	Points     float64 `json:"points"`
+	DonePoints float64 `json:"done_points"`
+	Progress   int     `json:"progress"`
+
+	item *plane.WorkItem
+}
+
+// rollupSection matches the section written by --write-description, also
+// after the editor added attributes to its tags
+var rollupSection = regexp.MustCompile(`(?s)<h3[^>]*>Rollup</h3>.*?<em[^>]*>Rolled up by plane-cli</em></p>`)
+
+func runRollup(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	parentRef, _ := cmd.Flags().GetString("parent")
+	writeDescription, _ := cmd.Flags().GetBool("write-description")
+	propertyName, _ := cmd.Flags().GetString("property")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	skipConfirm, _ := cmd.Flags().GetBool("yes")
+
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format != "" && (writeDescription || propertyName != "") {
+		return fmt.Errorf("--output can't be combined with --write-description or --property")
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+	points, err := estimatePoints(client, projectID)
+	if err != nil {
+		// Without estimates, progress is counted by sub-items
+		points = nil
+	}
+
+	if format == "" {
+		fmt.Printf("📥 Fetching work items from project '%s'...\n", projectID)
+	}
+	items, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	children := make(map[string][]*plane.WorkItem)
+	for i := range items {
+		if items[i].ParentID != "" {
+			children[items[i].ParentID] = append(children[items[i].ParentID], &items[i])
+		}
+	}
+
+	var parents []*plane.WorkItem
+	if parentRef != "" {
+		parent, _, err := itemByIdentifier(client, parentRef)
+		if err != nil {
+			return err
+		}
+		for i := range items {
+			if items[i].ID == parent.ID {
+				parent = &items[i]
+			}
+		}
+		parents = append(parents, parent)
+	} else {
+		for i := range items {
+			if len(children[items[i].ID]) > 0 {
+				parents = append(parents, &items[i])
+			}
+		}
+		sort.Slice(parents, func(i, j int) bool { return parents[i].SequenceID < parents[j].SequenceID })
+	}
+
+	var rollups []itemRollup
+	for _, parent := range parents {
+		r := itemRollup{
+			Key:  fmt.Sprintf("%s-%d", lookup.projectIdentifier, parent.SequenceID),
+			ID:   parent.ID,
+			Name: parent.Name,
+			item: parent,
+		}
+		visited := map[string]bool{parent.ID: true}
+		sumSubItems(&r, children, parent.ID, lookup, points, visited)
+		r.Progress = r.progress()
+		rollups = append(rollups, r)
+	}
+
+	if format != "" {
+		if rollups == nil {
+			rollups = []itemRollup{}
+		}
+		return render(format, rollups)
+	}
+	if len(rollups) == 0 {
+		fmt.Println("\nNo work items with sub-items found.")
+		return nil
+	}
+
+	fmt.Println()
+	printRollupTable(rollups)
+
+	if !writeDescription && propertyName == "" {
+		return nil
+	}
+	return writeRollups(client, projectID, rollups, writeDescription, propertyName, dryRun, skipConfirm)
+}
+
+// sumSubItems adds the sub-items of parentID, at any depth, to r
+func sumSubItems(r *itemRollup, children map[string][]*plane.WorkItem, parentID string, lookup *itemLookup, points map[string]float64, visited map[string]bool) {
+	for _, child := range children[parentID] {
+		if visited[child.ID] {
+			continue
+		}
+		visited[child.ID] = true
+
+		group := lookup.stateGroup(child)
+		if group == "cancelled" {
+			r.Cancelled++
+		} else {
+			r.SubItems++
+			value := 0.0
+			if child.EstimatePoint != nil {
+				value = points[*child.EstimatePoint]
+			}
+			r.Points += value
+			if group == "completed" {
+				r.Done++
+				r.DonePoints += value
+			}
+		}
+		sumSubItems(r, children, child.ID, lookup, points, visited)
+	}
+}
+
+// progress returns the percentage done, by points when there are any
+func (r *itemRollup) progress() int {
+	switch {
+	case r.Points > 0:
+		return int(r.DonePoints * 100 / r.Points)
+	case r.SubItems > 0:
+		return r.Done * 100 / r.SubItems
+	}
+	return 0
+}
+
+// summary describes the totals in one line
+func (r *itemRollup) summary() string {
+	s := fmt.Sprintf("%d of %d sub-items done", r.Done, r.SubItems)
+	if r.Points > 0 {
+		s += fmt.Sprintf(", %s of %s points", formatPoints(r.DonePoints), formatPoints(r.Points))
+	}
+	s += fmt.Sprintf(" (%d%%)", r.Progress)
+	if r.Cancelled > 0 {
+		s += fmt.Sprintf(", %d cancelled", r.Cancelled)
+	}
+	return s
+}
+
+func formatPoints(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func printRollupTable(rollups []itemRollup) {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PARENT\tTITLE\tSUB-ITEMS\tDONE\tPOINTS\tDONE POINTS\tPROGRESS")
+	for _, r := range rollups {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s %d%%\n", r.Key, truncate(r.Name, 40), r.SubItems, r.Done,
+			formatPoints(r.Points), formatPoints(r.DonePoints), progressBar(r.Progress, 10), r.Progress)
+	}
+	w.Flush()
+	os.Stdout.Write(buf.Bytes())
+}
+
+// progressBar draws a percentage as a bar of width cells
+func progressBar(percent, width int) string {
+	filled := percent * width / 100
+	filled = max(0, min(filled, width))
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
+
+// rollupDescription returns description with its rollup section replaced,
+// or added at the end
+func rollupDescription(description string, r *itemRollup) string {
+	section := fmt.Sprintf("<h3>Rollup</h3><p>%s</p><p><em>Rolled up by plane-cli</em></p>", html.EscapeString(r.summary()))
+	if rollupSection.MatchString(description) {
+		return rollupSection.ReplaceAllLiteralString(description, section)
+	}
+	return description + section
+}
+
+// writeRollups writes the totals into the parents' descriptions or a custom
+// property, skipping parents that are up to date
+func writeRollups(client *plane.Client, projectID string, rollups []itemRollup, writeDescription bool, propertyName string, dryRun, skipConfirm bool) error {
+	var property *plane.WorkItemProperty
+	if propertyName != "" {
+		var err error
+		if property, err = findProperty(client, projectID, propertyName); err != nil {
+			return err
+		}
+	}
+
+	type pendingWrite struct {
+		rollup      *itemRollup
+		description string
+		values      []string
+	}
+	var writes []pendingWrite
+	for i := range rollups {
+		r := &rollups[i]
+		w := pendingWrite{rollup: r}
+		if writeDescription {
+			if d := rollupDescription(r.item.DescriptionHTML, r); d != r.item.DescriptionHTML {
+				w.description = d
+			}
+		}
+		if property != nil {
+			value := r.summary()
+			if property.PropertyType == "DECIMAL" {
+				value = formatPoints(r.Points)
+			}
+			current, err := client.GetWorkItemPropertyValues(projectID, r.ID, property.ID)
+			if err != nil || len(current) != 1 || current[0] != value {
+				w.values = []string{value}
+			}
+		}
+		if w.description != "" || w.values != nil {
+			writes = append(writes, w)
+		}
+	}
+
+	if len(writes) == 0 {
+		fmt.Println("\n✅ All parents are up to date.")
+		return nil
+	}
+	fmt.Printf("\n%d parent(s) to update:\n", len(writes))
+	for _, w := range writes {
+		fmt.Printf("  • %s: %s\n", w.rollup.Key, w.rollup.summary())
+	}
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no changes made.")
+		return nil
+	}
+	if !skipConfirm {
+		confirmed, err := confirm(fmt.Sprintf("\nWrite the totals to %d parent(s)?", len(writes)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("\n❌ Cancelled.")
+			return nil
+		}
+	}
+
+	fmt.Println()
+	failed := 0
+	for _, w := range writes {
+		var err error
+		if w.description != "" {
+			_, err = client.UpdateWorkItem(projectID, w.rollup.ID, &plane.WorkItemUpdate{DescriptionHTML: w.description})
+		}
+		if err == nil && w.values != nil {
+			err = client.SetWorkItemPropertyValues(projectID, w.rollup.ID, property.ID, w.values)
+		}
+		if err != nil {
+			fmt.Printf("  ❌ %s: %v\n", w.rollup.Key, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  ✅ %s\n", w.rollup.Key)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d parent(s) could not be updated", failed)
+	}
+	return nil
+}
+
+// findProperty finds a custom property of the project by name or display
+// name, case-insensitively
+func findProperty(client *plane.Client, projectID, name string) (*plane.WorkItemProperty, error) {
+	types, err := client.GetWorkItemTypes(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("custom properties are not available in this project: %w", err)
+	}
+	for _, t := range types {
+		properties, err := client.GetWorkItemProperties(projectID, t.ID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range properties {
+			if strings.EqualFold(properties[i].Name, name) || strings.EqualFold(properties[i].DisplayName, name) {
+				return &properties[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unknown custom property %q", name)
+}
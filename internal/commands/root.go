@@ -1,10 +1,16 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
 )
 
 // rootCmd is the base command
@@ -22,12 +28,60 @@ Features:
 
 For more information, visit: https://plane.so`,
 	Version: "1.0.0",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if profile, _ := cmd.Flags().GetString("profile"); profile != "" {
+			config.SelectProfile(profile)
+		}
+		applyDefaultProject(cmd)
+	},
+}
+
+// applyDefaultProject fills in --project from defaults.project when the
+// command has the flag and it wasn't given. It runs before required flags
+// are checked, so commands requiring --project accept the default.
+func applyDefaultProject(cmd *cobra.Command) {
+	flag := cmd.Flags().Lookup("project")
+	if flag == nil || flag.Changed {
+		return
+	}
+	if project := config.DefaultProject(); project != "" {
+		cmd.Flags().Set("project", project)
+	}
 }
 
 // Execute runs the root command
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	args := expandAlias(os.Args[1:])
+	rootCmd.SetArgs(args)
+	registerFlagCompletions(rootCmd)
+	start := time.Now()
+
+	// The first Ctrl-C cancels the command's context, aborting requests in
+	// flight; a second one exits immediately
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	recordUsage(cmd, time.Since(start), err)
+	recordOperation(cmd, args, start, err)
+	if err == nil {
+		warmCompletionData(cmd)
+	}
+	stop()
+	if errors.Is(err, context.Canceled) {
+		fmt.Fprintln(os.Stderr, "\n❌ Interrupted")
+		os.Exit(130)
+	}
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if hint := apiErrorHint(err); hint != "" {
+			fmt.Fprintf(os.Stderr, "\n💡 %s\n", hint)
+		} else if strings.Contains(err.Error(), `"project" not set`) {
+			fmt.Fprintln(os.Stderr, "\n💡 Pass --project, or set a default with: plane-cli project use <project>")
+		}
 		os.Exit(1)
 	}
 }
@@ -36,4 +90,12 @@ func init() {
 	// Global flags
 	rootCmd.PersistentFlags().String("config", "", "config file (default is ./config.yaml)")
 	rootCmd.PersistentFlags().String("workspace", "", "Plane workspace slug")
+	rootCmd.PersistentFlags().String("profile", "", "Configuration profile to use (default: PLANE_PROFILE or the current profile)")
+	rootCmd.PersistentFlags().Bool("strict", false, "Fail when API responses contain fields unknown to the CLI")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "Do not read or write the local response cache")
+	rootCmd.PersistentFlags().Bool("verbose", false, "Log API requests and responses to stderr")
+	rootCmd.PersistentFlags().Bool("debug", false, "Also log headers and bodies to stderr, with tokens redacted")
+	rootCmd.PersistentFlags().Int("page-size", 100, "Work items requested per page when fetching whole projects (overrides request.page_size)")
+	rootCmd.PersistentFlags().Int("max-retries", 3, "Retries of requests failing with 429, 502, 503 or 504 (overrides request.max_retries)")
+	rootCmd.PersistentFlags().StringP("output", "o", outputTable, "Output format for list and show commands: table, json or yaml")
 }
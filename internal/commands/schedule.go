@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"plane-cli/internal/plane"
+)
+
+// parseScheduleDate parses a YYYY-MM-DD date flag; an empty value gives the
+// zero time
+func parseScheduleDate(flag, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	day, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s: expected YYYY-MM-DD, got %q", flag, value)
+	}
+	return day, nil
+}
+
+// scheduleDate parses a date returned by the API; unset or invalid dates give
+// the zero time
+func scheduleDate(d *string) time.Time {
+	day, err := time.ParseInLocation("2006-01-02", dateValue(d), time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return day
+}
+
+// validateScheduleRange checks that a range does not end before it starts
+func validateScheduleRange(start, end time.Time) error {
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return fmt.Errorf("end date %s is before start date %s", end.Format("2006-01-02"), start.Format("2006-01-02"))
+	}
+	return nil
+}
+
+// scheduleDays returns the number of days from start to end, both included
+func scheduleDays(start, end time.Time) int {
+	return int(math.Round(end.Sub(start).Hours()/24)) + 1
+}
+
+// cycleScheduleWarnings lists the conflicts of a cycle running from start to
+// end with the other cycles of the project: overlaps, and a length or a gap
+// to the previous cycle that differs from the project's usual cadence. The
+// cycle with skipID (the one being updated) is ignored.
+func cycleScheduleWarnings(cycles []plane.Cycle, skipID string, start, end time.Time) []string {
+	if start.IsZero() || end.IsZero() {
+		return nil
+	}
+
+	type dated struct {
+		name       string
+		start, end time.Time
+	}
+	var others []dated
+	for _, c := range cycles {
+		s, e := scheduleDate(c.StartDate), scheduleDate(c.EndDate)
+		if c.ID == skipID || s.IsZero() || e.IsZero() {
+			continue
+		}
+		others = append(others, dated{c.Name, s, e})
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i].start.Before(others[j].start) })
+
+	var warnings []string
+	for _, o := range others {
+		if !start.After(o.end) && !o.start.After(end) {
+			warnings = append(warnings, fmt.Sprintf("overlaps cycle '%s' (%s to %s)", o.name, o.start.Format("2006-01-02"), o.end.Format("2006-01-02")))
+		}
+	}
+
+	overlaps := len(warnings) > 0
+
+	// The cadence is only meaningful with a few cycles to learn it from
+	if len(others) < 2 {
+		return warnings
+	}
+
+	var lengths, gaps []int
+	for i, o := range others {
+		lengths = append(lengths, scheduleDays(o.start, o.end))
+		if i > 0 {
+			gaps = append(gaps, scheduleDays(others[i-1].end, o.start)-2)
+		}
+	}
+	usualLength, usualGap := medianInt(lengths), medianInt(gaps)
+
+	length := scheduleDays(start, end)
+	if diff := length - usualLength; diff > max(1, usualLength/4) || -diff > max(1, usualLength/4) {
+		warnings = append(warnings, fmt.Sprintf("lasts %d days; cycles in this project usually last %d days", length, usualLength))
+	}
+
+	// Compare the gap to the latest cycle ending before this one starts
+	for i := len(others) - 1; i >= 0 && !overlaps; i-- {
+		if others[i].end.Before(start) {
+			gap := scheduleDays(others[i].end, start) - 2
+			if gap-usualGap > 1 || usualGap-gap > 1 {
+				warnings = append(warnings, fmt.Sprintf("starts %d day(s) after cycle '%s' ends; cycles in this project are usually %d day(s) apart", gap, others[i].name, usualGap))
+			}
+			break
+		}
+	}
+
+	return warnings
+}
+
+// moduleScheduleWarnings lists surprising dates of a module
+func moduleScheduleWarnings(end time.Time, status string) []string {
+	y, m, d := time.Now().Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+	if !end.IsZero() && end.Before(today) && status != "completed" && status != "cancelled" {
+		return []string{fmt.Sprintf("target date %s is already in the past", end.Format("2006-01-02"))}
+	}
+	return nil
+}
+
+// confirmScheduleWarnings shows schedule conflicts and asks whether to go on.
+// It returns true right away when there are none or --yes was given.
+func confirmScheduleWarnings(warnings []string, yes bool, action string) (bool, error) {
+	if len(warnings) == 0 {
+		return true, nil
+	}
+
+	fmt.Println("\n⚠️  Schedule conflicts:")
+	for _, w := range warnings {
+		fmt.Printf("  • %s\n", w)
+	}
+	if yes {
+		return true, nil
+	}
+	return confirm(fmt.Sprintf("%s anyway?", action))
+}
+
+func medianInt(values []int) int {
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+	return sorted[len(sorted)/2]
+}
@@ -0,0 +1,289 @@
+package commands
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"plane-cli/internal/offline"
+	"plane-cli/internal/plane"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema [command...]",
+	Short: "Describe commands, flags and output as JSON",
+	Long: `Print a JSON description of the CLI for wrapper tools, TUIs and
+assistants: every command with its arguments, flags (type, default,
+whether it is required or repeatable and, where known, the accepted
+values) and, for commands that support --output json|yaml, the shape of
+what they print.
+
+Without arguments every command is described; with a command path only
+that command and its subcommands are.
+
+Examples:
+  plane-cli schema
+  plane-cli schema list
+  plane-cli schema page create
+  plane-cli schema | jq '.commands[] | select(.output) | .command'`,
+	RunE: runSchema,
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+}
+
+// commandOutputs is what commands print with --output json or yaml, by
+// command path
+var commandOutputs = map[string]any{
+	"list":           []plane.WorkItem{},
+	"view":           plane.WorkItem{},
+	"project list":   []projectWithStats{},
+	"page list":      []plane.Page{},
+	"module list":    []plane.Module{},
+	"label list":     []plane.Label{},
+	"member list":    []memberEntry{},
+	"member find":    []memberEntry{},
+	"queue list":     []offline.Operation{},
+	"search":         []plane.WorkItem{},
+	"watch":          watchEvent{},
+	"stats":          workItemStats{},
+	"history":        []itemHistoryEvent{},
+	"my-issues":      []workspaceItem{},
+	"whoami":         whoamiOutput{},
+	"workspace list": []workspaceEntry{},
+	"worklog list":   []worklogEntry{},
+	"rollup":         []itemRollup{},
+}
+
+// flagValuesPattern finds the accepted values listed in a flag's help text,
+// as in "Priority: urgent, high, medium, low" or "(public, private)"
+var flagValuesPattern = regexp.MustCompile(`(?:: |\()([a-z][a-z0-9-]*(?:, [a-z][a-z0-9-]*)*(?:,? or [a-z][a-z0-9-]*)?)(?:\)|\s|$)`)
+
+// cliSchema is the description printed by the schema command
+type cliSchema struct {
+	Name        string          `json:"name"`
+	Version     string          `json:"version"`
+	GlobalFlags []flagSchema    `json:"global_flags"`
+	Commands    []commandSchema `json:"commands"`
+}
+
+// commandSchema describes one command
+type commandSchema struct {
+	Command     string        `json:"command"`
+	Usage       string        `json:"usage"`
+	Short       string        `json:"short"`
+	Long        string        `json:"long,omitempty"`
+	Aliases     []string      `json:"aliases,omitempty"`
+	Runnable    bool          `json:"runnable"`
+	Subcommands []string      `json:"subcommands,omitempty"`
+	Flags       []flagSchema  `json:"flags,omitempty"`
+	Output      *outputSchema `json:"output,omitempty"`
+}
+
+// flagSchema describes one flag
+type flagSchema struct {
+	Name        string   `json:"name"`
+	Shorthand   string   `json:"shorthand,omitempty"`
+	Type        string   `json:"type"`
+	Default     string   `json:"default,omitempty"`
+	Description string   `json:"description"`
+	Required    bool     `json:"required,omitempty"`
+	Repeatable  bool     `json:"repeatable,omitempty"`
+	Values      []string `json:"values,omitempty"`
+	Inherited   bool     `json:"inherited,omitempty"`
+}
+
+// outputSchema describes the structured output of a command
+type outputSchema struct {
+	Formats []string       `json:"formats"`
+	Shape   map[string]any `json:"shape"`
+}
+
+func runSchema(cmd *cobra.Command, args []string) error {
+	target := rootCmd
+	if len(args) > 0 {
+		found, rest, err := rootCmd.Find(args)
+		if err != nil || len(rest) > 0 || found == rootCmd {
+			return fmt.Errorf("unknown command '%s'", strings.Join(args, " "))
+		}
+		target = found
+	}
+
+	schema := cliSchema{
+		Name:        rootCmd.Name(),
+		Version:     rootCmd.Version,
+		GlobalFlags: flagSchemas(rootCmd.PersistentFlags(), false),
+	}
+	var walk func(c *cobra.Command)
+	walk = func(c *cobra.Command) {
+		if c != rootCmd {
+			schema.Commands = append(schema.Commands, describeCommand(c))
+		}
+		for _, sub := range c.Commands() {
+			if sub.IsAvailableCommand() {
+				walk(sub)
+			}
+		}
+	}
+	walk(target)
+
+	return render(outputJSON, schema)
+}
+
+// describeCommand builds the schema of c. Flags of the root command are
+// left out; they are listed once as global flags.
+func describeCommand(c *cobra.Command) commandSchema {
+	path := strings.TrimPrefix(c.CommandPath(), rootCmd.Name()+" ")
+	s := commandSchema{
+		Command:  path,
+		Usage:    c.UseLine(),
+		Short:    c.Short,
+		Long:     c.Long,
+		Aliases:  c.Aliases,
+		Runnable: c.Runnable(),
+		Flags:    flagSchemas(c.LocalFlags(), false),
+	}
+	for _, sub := range c.Commands() {
+		if sub.IsAvailableCommand() {
+			s.Subcommands = append(s.Subcommands, sub.Name())
+		}
+	}
+
+	inherited := pflag.NewFlagSet(path, pflag.ContinueOnError)
+	c.InheritedFlags().VisitAll(func(f *pflag.Flag) {
+		if rootCmd.PersistentFlags().Lookup(f.Name) == nil {
+			inherited.AddFlag(f)
+		}
+	})
+	s.Flags = append(s.Flags, flagSchemas(inherited, true)...)
+
+	if v, ok := commandOutputs[path]; ok {
+		s.Output = &outputSchema{
+			Formats: []string{outputTable, outputJSON, outputYAML},
+			Shape:   jsonShape(reflect.TypeOf(v), nil),
+		}
+	}
+	return s
+}
+
+// flagSchemas describes the flags of a set, sorted by name
+func flagSchemas(flags *pflag.FlagSet, inherited bool) []flagSchema {
+	var schemas []flagSchema
+	flags.VisitAll(func(f *pflag.Flag) {
+		if f.Hidden || f.Name == "help" {
+			return
+		}
+		typ := f.Value.Type()
+		s := flagSchema{
+			Name:        f.Name,
+			Shorthand:   f.Shorthand,
+			Type:        typ,
+			Description: f.Usage,
+			Repeatable:  strings.HasSuffix(typ, "Slice") || strings.HasSuffix(typ, "Array") || strings.HasPrefix(typ, "stringTo"),
+			Values:      flagValues(f),
+			Inherited:   inherited,
+		}
+		if f.DefValue != "" && f.DefValue != "[]" && f.DefValue != "false" && f.DefValue != "0" {
+			s.Default = f.DefValue
+		}
+		if req, ok := f.Annotations[cobra.BashCompOneRequiredFlag]; ok && len(req) > 0 && req[0] == "true" {
+			s.Required = true
+		}
+		schemas = append(schemas, s)
+	})
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Name < schemas[j].Name })
+	return schemas
+}
+
+// flagValues returns the accepted values of a flag, as listed in its help
+// text, or nil when they aren't a fixed set
+func flagValues(f *pflag.Flag) []string {
+	m := flagValuesPattern.FindStringSubmatch(f.Usage)
+	if m == nil {
+		return nil
+	}
+	list := strings.ReplaceAll(strings.ReplaceAll(m[1], ", or ", ", "), " or ", ", ")
+	values := strings.Split(list, ", ")
+	if len(values) < 2 {
+		return nil
+	}
+	return values
+}
+
+// jsonShape describes the JSON encoding of t as a JSON Schema fragment:
+// types, object properties by their JSON names, and array items. seen
+// stops recursive types.
+func jsonShape(t reflect.Type, seen map[reflect.Type]bool) map[string]any {
+	if seen == nil {
+		seen = make(map[reflect.Type]bool)
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": jsonShape(t.Elem(), seen)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": jsonShape(t.Elem(), seen)}
+	case reflect.Struct:
+		if seen[t] {
+			return map[string]any{"type": "object"}
+		}
+		seen[t] = true
+		defer delete(seen, t)
+
+		properties := make(map[string]any)
+		addStructFields(t, properties, seen)
+		return map[string]any{"type": "object", "properties": properties}
+	}
+	// Interfaces and raw JSON can hold anything
+	return map[string]any{}
+}
+
+// addStructFields adds the JSON properties of a struct's fields, with the
+// fields of embedded structs inlined as encoding/json does
+func addStructFields(t reflect.Type, properties map[string]any, seen map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() && !f.Anonymous {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			addStructFields(ft, properties, seen)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		properties[name] = jsonShape(f.Type, seen)
+	}
+}
@@ -0,0 +1,418 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+	"plane-cli/internal/fuzzy"
+	"plane-cli/internal/plane"
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search [text]",
+	Short: "Search work items on the server",
+	Long: `Search the work items of a project by title or identifier, narrowed by
+state, priority and assignee.
+
+The text is searched by the server and the state and priority filters are
+sent with the listing, so only matching work items are downloaded. The
+assignee is matched locally. On servers without the search endpoint the
+titles are matched fuzzily instead, which needs the whole listing.
+
+With --all-projects the work items of every project are fetched, several
+projects at once, and their titles matched fuzzily; results are tagged with
+their project's identifier.
+
+--template formats each result with a Go template, as list does.
+
+--offline searches the copy downloaded by 'plane-cli sync' instead. Titles
+are matched fuzzily and the filters are applied to the local copy.
+
+Examples:
+  plane-cli search "login bug" --project <project-id>
+  plane-cli search "login bug" --project <project-id> --state Todo --assignee me
+  plane-cli search --project <project-id> --priority urgent -o json
+  plane-cli search "login bug" --all-projects --assignee me
+  plane-cli search --project <project-id> --assignee me --template '{{.Key}} {{.State}} {{.Name}}'
+  plane-cli search "login bug" --project MOB --offline
+
+` + outputTemplateHelp,
+	RunE: runSearch,
+}
+
+func init() {
+	rootCmd.AddCommand(searchCmd)
+
+	searchCmd.Flags().String("project", "", "Project ID (required unless defaults.project or --all-projects is set)")
+	searchCmd.Flags().Bool("all-projects", false, "Search the work items of every project in the workspace")
+	searchCmd.Flags().String("state", "", "Only work items in this state (ID or name)")
+	searchCmd.Flags().String("priority", "", "Only work items with this priority (urgent, high, medium, low, none)")
+	searchCmd.Flags().String("assignee", "", "Only work items assigned to this member: ID, email, name or me")
+	searchCmd.Flags().Int("limit", 20, "Maximum number of results")
+	searchCmd.Flags().Int("min-score", 60, "Minimum fuzzy match score (0-100) when the server can't search")
+	searchCmd.Flags().String("template", "", "Format each work item with a Go template")
+	searchCmd.Flags().Bool("offline", false, "Search the copy downloaded by 'plane-cli sync'")
+}
+
+// searchFilters narrow a search. State and priority are sent to the
+// server; the assignee is matched locally.
+type searchFilters struct {
+	state, priority, assignee string
+}
+
+// options returns the listing parameters for the filters the server
+// applies, asking only for the given fields; nil asks for all of them
+func (f searchFilters) options(fields []string) map[string]string {
+	options := map[string]string{}
+	if f.state != "" {
+		options["state"] = f.state
+	}
+	if f.priority != "" {
+		options["priority"] = f.priority
+	}
+	plane.SelectFields(options, fields, nil)
+	return options
+}
+
+// match reports whether item passes the filters, including those the
+// server may have applied already
+func (f searchFilters) match(item *plane.WorkItem) bool {
+	return (f.state == "" || itemStateID(item) == f.state) &&
+		(f.priority == "" || strings.EqualFold(item.Priority, f.priority)) &&
+		(f.assignee == "" || slices.Contains(itemAssigneeIDs(item), f.assignee))
+}
+
+func runSearch(cmd *cobra.Command, args []string) error {
+	text := strings.TrimSpace(strings.Join(args, " "))
+	projectID, _ := cmd.Flags().GetString("project")
+	state, _ := cmd.Flags().GetString("state")
+	priority, _ := cmd.Flags().GetString("priority")
+	assignee, _ := cmd.Flags().GetString("assignee")
+	limit, _ := cmd.Flags().GetInt("limit")
+	minScore, _ := cmd.Flags().GetInt("min-score")
+	allProjects, _ := cmd.Flags().GetBool("all-projects")
+	templateStr, _ := cmd.Flags().GetString("template")
+	offlineMode, _ := cmd.Flags().GetBool("offline")
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format != "" && templateStr != "" {
+		return fmt.Errorf("--template cannot be combined with --output %s", format)
+	}
+	// Only request the columns the table shows; templates may use any field
+	fields := listFields(false, false)
+	var tmpl *template.Template
+	if templateStr != "" {
+		if tmpl, err = parseOutputTemplate(templateStr); err != nil {
+			return err
+		}
+		fields = nil
+	}
+	if projectID == "" && !allProjects {
+		return fmt.Errorf("--project is required unless --all-projects is set")
+	}
+	if offlineMode && allProjects {
+		return fmt.Errorf("--offline cannot be combined with --all-projects")
+	}
+
+	if offlineMode {
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		links, err := newItemLinker(cfg, resolveWorkspace(cmd, cfg))
+		if err != nil {
+			return err
+		}
+		return runSearchOffline(cmd, projectID, text, state, priority, assignee, limit, minScore, format, tmpl, links)
+	}
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	links, err := newItemLinker(cfg, resolveWorkspace(cmd, cfg))
+	if err != nil {
+		return err
+	}
+	if allProjects {
+		return runSearchAllProjects(client, links, text, state, priority, assignee, limit, minScore, format, tmpl, fields)
+	}
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	filters := searchFilters{priority: strings.ToLower(priority)}
+	if filters.state, err = resolveState(client, project.ID, state); err != nil {
+		return err
+	}
+	if assignee != "" {
+		ids, err := newMemberResolver(client, project.ID).resolve([]string{assignee})
+		if err != nil {
+			return err
+		}
+		filters.assignee = ids[0]
+	}
+
+	items, err := searchWorkItems(client, project.ID, text, filters, fields, limit, minScore)
+	if err != nil {
+		return err
+	}
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+
+	if format != "" {
+		return render(format, items)
+	}
+	if tmpl != nil {
+		return printItemsWithTemplate(client, project.ID, items, tmpl)
+	}
+	if len(items) == 0 {
+		fmt.Println("No matching work items found.")
+		return nil
+	}
+	printWorkItemTable(items, project.Identifier, false, nil, links, tableLookup(client, project.ID))
+	fmt.Printf("\nFound %d work items\n", len(items))
+	return nil
+}
+
+// searchWorkItems finds the work items of a project matching text and
+// filters, best first, with the given fields. Without text only the
+// filters apply.
+func searchWorkItems(client *plane.Client, projectID, text string, filters searchFilters, fields []string, limit, minScore int) ([]plane.WorkItem, error) {
+	if text == "" {
+		return fetchPages(client.WorkItemsPager(projectID, filters.options(fields)), filters.match)
+	}
+
+	// Ask for more hits than needed when some are filtered out afterwards
+	searchLimit := limit
+	if filters != (searchFilters{}) {
+		searchLimit = max(limit*5, 100)
+	}
+	hits, err := client.SearchWorkItems(projectID, text, searchLimit)
+	switch {
+	case plane.StatusCode(err) == http.StatusNotFound || plane.StatusCode(err) == http.StatusMethodNotAllowed:
+		fmt.Fprintln(os.Stderr, "🔎 The server can't search work items; matching titles locally...")
+		return fuzzySearchWorkItems(client, projectID, text, filters, fields, minScore)
+	case err != nil:
+		return nil, fmt.Errorf("failed to search work items: %w", err)
+	}
+
+	items := []plane.WorkItem{}
+	if filters.state != "" || filters.priority != "" {
+		// The filtered listing is usually smaller than one request per hit
+		listed, err := fetchPages(client.WorkItemsPager(projectID, filters.options(fields)), filters.match)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch work items: %w", err)
+		}
+		byID := make(map[string]plane.WorkItem, len(listed))
+		for _, item := range listed {
+			byID[item.ID] = item
+		}
+		for _, hit := range hits {
+			if item, ok := byID[hit.ID]; ok {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	}
+
+	for _, hit := range hits {
+		if limit > 0 && len(items) >= limit {
+			break
+		}
+		item, err := client.GetWorkItem(projectID, hit.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch work item %s-%d: %w", hit.ProjectIdentifier, hit.SequenceID, err)
+		}
+		if filters.match(item) {
+			items = append(items, *item)
+		}
+	}
+	return items, nil
+}
+
+// fuzzySearchWorkItems matches text against the titles of the work items
+// passing the filters, for servers without the search endpoint
+func fuzzySearchWorkItems(client *plane.Client, projectID, text string, filters searchFilters, fields []string, minScore int) ([]plane.WorkItem, error) {
+	listed, err := fetchPages(client.WorkItemsPager(projectID, filters.options(fields)), filters.match)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch work items: %w", err)
+	}
+	titles := make([]string, len(listed))
+	for i, item := range listed {
+		titles[i] = item.Name
+	}
+	items := []plane.WorkItem{}
+	for _, match := range fuzzy.NewMatcher(minScore).FindMatches(text, titles) {
+		items = append(items, listed[match.Index])
+	}
+	return items, nil
+}
+
+// runSearchOffline searches the titles of the work items of the copy
+// downloaded by sync, with the filters applied to the local copy
+func runSearchOffline(cmd *cobra.Command, project, text, state, priority, assignee string, limit, minScore int,
+	format string, tmpl *template.Template, links *itemLinker) error {
+	store, err := loadOfflineStore(cmd, project)
+	if err != nil {
+		return err
+	}
+
+	filters := searchFilters{priority: strings.ToLower(priority)}
+	if state != "" {
+		if filters.state = state; !isUUID(state) {
+			if filters.state, err = stateIDByName(store.States, state); err != nil {
+				return fmt.Errorf("%w (see plane-cli state list)", err)
+			}
+		}
+	}
+	switch {
+	case assignee == "":
+	case strings.EqualFold(assignee, "me"):
+		if store.CurrentUser == "" {
+			return fmt.Errorf("the offline copy doesn't know the current user; run: plane-cli sync --project %s", store.Project.ID)
+		}
+		filters.assignee = store.CurrentUser
+	case isUUID(assignee):
+		filters.assignee = assignee
+	default:
+		member, err := matchMember(store.Members, assignee)
+		if err != nil {
+			return err
+		}
+		filters.assignee = member.ID
+	}
+
+	listed := []plane.WorkItem{}
+	for i := range store.WorkItems {
+		if filters.match(&store.WorkItems[i]) {
+			listed = append(listed, store.WorkItems[i])
+		}
+	}
+	items := listed
+	if text != "" {
+		titles := make([]string, len(listed))
+		for i, item := range listed {
+			titles[i] = item.Name
+		}
+		items = []plane.WorkItem{}
+		for _, match := range fuzzy.NewMatcher(minScore).FindMatches(text, titles) {
+			items = append(items, listed[match.Index])
+		}
+	}
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+
+	if format != "" {
+		return render(format, items)
+	}
+	lookup := buildItemLookup(store.Project.ID, &store.Project, store.States, store.Labels, store.Members)
+	if tmpl != nil {
+		for i := range items {
+			if err := executeItemTemplate(os.Stdout, tmpl, lookup.view(&items[i])); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(items) == 0 {
+		fmt.Println("No matching work items found.")
+		return nil
+	}
+	printWorkItemTable(items, store.Project.Identifier, false, nil, links, lookup)
+	fmt.Printf("\nFound %d work items\n", len(items))
+	return nil
+}
+
+// runSearchAllProjects searches the titles of the work items of every
+// project. States are matched by name, as each project has its own.
+func runSearchAllProjects(client *plane.Client, links *itemLinker, text, state, priority, assignee string, limit, minScore int,
+	format string, tmpl *template.Template, fields []string) error {
+	assigneeID := ""
+	if assignee != "" {
+		ids, err := newMemberResolver(client, "").resolve([]string{assignee})
+		if err != nil {
+			return err
+		}
+		assigneeID = ids[0]
+	}
+
+	index, err := buildWorkspaceIndex(client, fields)
+	if err != nil {
+		return err
+	}
+	keep := func(item *workspaceItem) bool {
+		stateID := itemStateID(&item.WorkItem)
+		return (state == "" || stateID == state || strings.EqualFold(index.States[stateID].Name, state)) &&
+			(priority == "" || strings.EqualFold(item.Priority, priority)) &&
+			(assigneeID == "" || slices.Contains(itemAssigneeIDs(&item.WorkItem), assigneeID))
+	}
+
+	found := []workspaceItem{}
+	if text == "" {
+		for _, item := range index.Items {
+			if keep(&item) {
+				found = append(found, item)
+			}
+		}
+	} else {
+		found = index.search(text, minScore, keep)
+	}
+	if limit > 0 && len(found) > limit {
+		found = found[:limit]
+	}
+
+	if format != "" {
+		return render(format, found)
+	}
+	if tmpl != nil {
+		// Each project has its own states, labels and members
+		lookups := make(map[string]*itemLookup)
+		for _, item := range found {
+			lookup, ok := lookups[item.ProjectID]
+			if !ok {
+				if lookup, err = newItemLookup(client, item.ProjectID); err != nil {
+					return err
+				}
+				lookups[item.ProjectID] = lookup
+			}
+			if err := executeItemTemplate(os.Stdout, tmpl, lookup.view(&item.WorkItem)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(found) == 0 {
+		fmt.Println("No matching work items found.")
+		return nil
+	}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tPROJECT\tTITLE\tSTATE\tPRIORITY\tSCORE")
+	for _, item := range found {
+		score := "-"
+		if item.Score > 0 {
+			score = fmt.Sprintf("%d%%", item.Score)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", links.cell(item.Key, item.ProjectID, item.ID), index.Projects[item.ProjectID].Name,
+			truncate(item.Name, 40), emptyAsDash(index.States[itemStateID(&item.WorkItem)].Name), emptyAsDash(item.Priority), score)
+	}
+	w.Flush()
+	links.writeTable(os.Stdout, buf.Bytes())
+	fmt.Printf("\nFound %d work items (searched %d projects)\n", len(found), len(index.Projects))
+	return nil
+}
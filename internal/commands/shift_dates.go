@@ -0,0 +1,213 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+	"plane-cli/internal/query"
+)
+
+var shiftDatesCmd = &cobra.Command{
+	Use:   "shift-dates",
+	Short: "Move the start and due dates of work items",
+	Long: `Move the start and due dates of every work item matching a filter forward
+or backward by a number of days or weeks - for example when a release slips
+a week. Items without dates are left alone.
+
+The filter uses the query language of 'plane-cli list -q'. A preview of the
+old and new dates is shown and must be confirmed unless --yes is passed.
+
+Examples:
+  # Everything in Sprint 43 slips a week
+  plane-cli shift-dates --project <project-id> --filter 'cycle:"Sprint 43"' --by 7d
+
+  # Pull unfinished work of a module in by two weeks, due dates only
+  plane-cli shift-dates --project <project-id> --filter 'module:Payments -group:completed' --by -2w --only due
+
+  # Preview only
+  plane-cli shift-dates --project <project-id> --filter 'label:release' --by 3d --dry-run`,
+	RunE: runShiftDates,
+}
+
+func init() {
+	rootCmd.AddCommand(shiftDatesCmd)
+
+	shiftDatesCmd.Flags().StringP("project", "p", "", "Project identifier (required unless defaults.project is set)")
+	shiftDatesCmd.Flags().String("filter", "", "Query selecting the work items (required)")
+	shiftDatesCmd.Flags().String("by", "", "Days or weeks to shift by, e.g. 7d, -3d, 2w (required)")
+	shiftDatesCmd.Flags().String("only", "", "Shift only one date: start or due")
+	shiftDatesCmd.Flags().Bool("dry-run", false, "Show the new dates without changing anything")
+	shiftDatesCmd.Flags().Bool("yes", false, "Skip confirmation prompt")
+	shiftDatesCmd.MarkFlagRequired("project")
+	shiftDatesCmd.MarkFlagRequired("filter")
+	shiftDatesCmd.MarkFlagRequired("by")
+}
+
+// dateShift is the planned change of one work item
+type dateShift struct {
+	Item      plane.WorkItem
+	Start     string
+	Target    string
+	NewStart  string
+	NewTarget string
+}
+
+func runShiftDates(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	filter, _ := cmd.Flags().GetString("filter")
+	by, _ := cmd.Flags().GetString("by")
+	only, _ := cmd.Flags().GetString("only")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	days, err := parseShiftDays(by)
+	if err != nil {
+		return err
+	}
+	switch only {
+	case "", "start", "due":
+	default:
+		return fmt.Errorf("unknown --only '%s' (use start or due)", only)
+	}
+
+	q, err := query.Parse(filter)
+	if err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	identifier := projectID
+	if project, err := client.GetProject(projectID); err == nil {
+		identifier = project.Identifier
+	}
+
+	ctx, err := loadQueryContext(client, projectID, q)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("📥 Fetching matching work items...")
+	items, err := fetchMatchingWorkItems(client, projectID, q, ctx, []string{"sequence_id", "name", "start_date", "target_date"})
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	var shifts []dateShift
+	for _, item := range items {
+		s := dateShift{Item: item, Start: dateValue(item.StartDate), Target: dateValue(item.TargetDate)}
+		if only != "due" {
+			s.NewStart = shiftDate(s.Start, days)
+		}
+		if only != "start" {
+			s.NewTarget = shiftDate(s.Target, days)
+		}
+		if s.NewStart != "" || s.NewTarget != "" {
+			shifts = append(shifts, s)
+		}
+	}
+
+	if len(shifts) == 0 {
+		fmt.Printf("No work items with dates match '%s'.\n", filter)
+		return nil
+	}
+
+	fmt.Printf("\n📅 Shifting dates by %s (%d of %d matching work items have dates):\n\n", formatShiftDays(days), len(shifts), len(items))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTITLE\tSTART\tDUE")
+	for _, s := range shifts {
+		fmt.Fprintf(w, "%s-%d\t%s\t%s\t%s\n", identifier, s.Item.SequenceID, truncate(s.Item.Name, 40),
+			shiftDisplay(s.Start, s.NewStart), shiftDisplay(s.Target, s.NewTarget))
+	}
+	w.Flush()
+
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no dates changed.")
+		return nil
+	}
+
+	if !yes {
+		confirmed, err := confirm(fmt.Sprintf("\nShift the dates of %d work item(s)?", len(shifts)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("\n❌ Cancelled.")
+			return nil
+		}
+	}
+
+	fmt.Println()
+	failed := 0
+	for _, s := range shifts {
+		update := &plane.WorkItemUpdate{StartDate: s.NewStart, TargetDate: s.NewTarget}
+		if _, err := client.UpdateWorkItem(projectID, s.Item.ID, update); err != nil {
+			fmt.Printf("  ❌ %s-%d: %v\n", identifier, s.Item.SequenceID, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  ✅ %s-%d %s\n", identifier, s.Item.SequenceID, truncate(s.Item.Name, 50))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d work item(s) could not be updated", failed, len(shifts))
+	}
+	fmt.Printf("\n✅ Shifted the dates of %d work item(s)\n", len(shifts))
+	return nil
+}
+
+// parseShiftDays parses a shift such as 7d, -3d, +2w or a bare number of
+// days
+func parseShiftDays(s string) (int, error) {
+	s = strings.TrimSpace(strings.ToLower(s))
+	unit := 1
+	switch {
+	case strings.HasSuffix(s, "w"):
+		unit, s = 7, strings.TrimSuffix(s, "w")
+	case strings.HasSuffix(s, "d"):
+		s = strings.TrimSuffix(s, "d")
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(s, "+"))
+	if err != nil || n == 0 {
+		return 0, fmt.Errorf("invalid --by value (use a non-zero number of days or weeks, e.g. 7d, -3d, 2w)")
+	}
+	return n * unit, nil
+}
+
+// shiftDate moves a YYYY-MM-DD date by days; empty and unparsable dates
+// give ""
+func shiftDate(date string, days int) string {
+	if date == "" {
+		return ""
+	}
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return ""
+	}
+	return t.AddDate(0, 0, days).Format("2006-01-02")
+}
+
+// shiftDisplay shows a date change for the preview
+func shiftDisplay(old, shifted string) string {
+	if shifted == "" {
+		return emptyAsDash(old)
+	}
+	return old + " → " + shifted
+}
+
+// formatShiftDays describes a shift, e.g. "+7 days"
+func formatShiftDays(days int) string {
+	if days == 1 || days == -1 {
+		return fmt.Sprintf("%+d day", days)
+	}
+	return fmt.Sprintf("%+d days", days)
+}
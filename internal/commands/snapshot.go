@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"plane-cli/internal/config"
+	"plane-cli/internal/plane"
+)
+
+// itemSnapshot is the saved copy of work items about to be removed, so they
+// can be recreated by hand: the API can't undo a deletion
+type itemSnapshot struct {
+	Action    string              `json:"action"`
+	CreatedAt time.Time           `json:"created_at"`
+	Workspace string              `json:"workspace"`
+	ProjectID string              `json:"project_id"`
+	Project   string              `json:"project,omitempty"`
+	Items     []itemSnapshotEntry `json:"items"`
+}
+
+// itemSnapshotEntry is one work item as the API returned it, with its
+// comments
+type itemSnapshotEntry struct {
+	Item     json.RawMessage `json:"item"`
+	Comments []plane.Comment `json:"comments,omitempty"`
+}
+
+// writeItemSnapshot saves the full JSON of items and their comments to a
+// timestamped file in the snapshots directory and returns its path. Items
+// whose details can't be fetched are saved as listed.
+func writeItemSnapshot(client *plane.Client, projectID, identifier, action string, items []plane.WorkItem) (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Join(dir, "snapshots")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	fmt.Printf("💾 Saving a snapshot of %d work item(s)...\n", len(items))
+	snapshot := itemSnapshot{
+		Action:    action,
+		CreatedAt: time.Now(),
+		Workspace: client.Workspace(),
+		ProjectID: projectID,
+		Project:   identifier,
+	}
+	for _, item := range items {
+		raw, err := client.GetWorkItemJSON(projectID, item.ID)
+		if err != nil {
+			if raw, err = json.Marshal(item); err != nil {
+				return "", fmt.Errorf("failed to encode %s-%d: %w", identifier, item.SequenceID, err)
+			}
+		}
+		entry := itemSnapshotEntry{Item: raw}
+		if comments, err := client.ListComments(projectID, item.ID); err == nil {
+			entry.Comments = comments
+		} else {
+			fmt.Printf("  ⚠️  Warning: comments of %s-%d not saved: %v\n", identifier, item.SequenceID, err)
+		}
+		snapshot.Items = append(snapshot.Items, entry)
+	}
+
+	var data bytes.Buffer
+	enc := json.NewEncoder(&data)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(snapshot); err != nil {
+		return "", fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	project := identifier
+	if project == "" {
+		project = projectID
+	}
+	name := fmt.Sprintf("%s-%s-%s.json", snapshot.CreatedAt.Format("20060102-150405"), slugify(project), action)
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data.Bytes(), 0600); err != nil {
+		return "", fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return path, nil
+}
@@ -0,0 +1,244 @@
+package commands
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var splitCmd = &cobra.Command{
+	Use:   "split <PROJ-42>",
+	Short: "Split a work item into several new items",
+	Long: `Split a work item that grew too large into several new items.
+
+Each new item copies the labels, module and assignees of the original. The
+estimate of the original is distributed over the new items (--estimate
+distribute, the default) or each new item gets half of it (--estimate halve);
+shares are rounded to the nearest point of the project's estimate scale.
+
+The new items become sub-items of the original (--link child, the default)
+or are related to it (--link related). With --note, a "Split into" line
+listing the new items is appended to the description of the original.
+
+Examples:
+  plane-cli split PROJ-42 --into "Backend API,Frontend form,Docs"
+
+  # Relate instead of nesting, halve the estimate and note the split
+  plane-cli split PROJ-42 --into "Part 1,Part 2" --link related --estimate halve --note
+
+  # Preview only
+  plane-cli split PROJ-42 --into "Part 1,Part 2" --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSplit,
+}
+
+func init() {
+	rootCmd.AddCommand(splitCmd)
+
+	splitCmd.Flags().String("into", "", "Comma-separated titles of the new items (required)")
+	splitCmd.Flags().String("link", "child", "How new items are linked to the original: child, related or none")
+	splitCmd.Flags().String("estimate", "distribute", "Estimate of the new items: distribute, halve or none")
+	splitCmd.Flags().Bool("note", false, "Append a \"Split into\" note to the original's description")
+	splitCmd.Flags().Bool("dry-run", false, "Preview the split without creating items")
+	splitCmd.Flags().Bool("yes", false, "Skip confirmation prompt")
+	splitCmd.MarkFlagRequired("into")
+}
+
+func runSplit(cmd *cobra.Command, args []string) error {
+	identifier := strings.ToUpper(args[0])
+	into, _ := cmd.Flags().GetString("into")
+	link, _ := cmd.Flags().GetString("link")
+	estimateMode, _ := cmd.Flags().GetString("estimate")
+	note, _ := cmd.Flags().GetBool("note")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	var titles []string
+	for _, t := range strings.Split(into, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			titles = append(titles, t)
+		}
+	}
+	if len(titles) < 2 {
+		return fmt.Errorf("--into needs at least two titles")
+	}
+	switch link {
+	case "child", "related", "none":
+	default:
+		return fmt.Errorf("unknown --link '%s' (use child, related or none)", link)
+	}
+	switch estimateMode {
+	case "distribute", "halve", "none":
+	default:
+		return fmt.Errorf("unknown --estimate '%s' (use distribute, halve or none)", estimateMode)
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, err := client.GetWorkItemByIdentifier(identifier)
+	if err != nil {
+		return err
+	}
+	projectID := item.ProjectID
+	if projectID == "" {
+		projectID = item.Project
+	}
+	prefix := identifier[:strings.LastIndex(identifier, "-")+1]
+
+	labels := itemLabelIDs(item)
+	assignees := itemAssigneeIDs(item)
+	moduleID := item.ModuleID
+	if moduleID == "" {
+		moduleID = item.Module
+	}
+
+	// Work out the estimate point of each new item
+	var estimateID, estimateLabel string
+	if estimateMode != "none" && item.EstimatePoint != nil && *item.EstimatePoint != "" {
+		points, err := estimatePoints(client, projectID)
+		if err != nil {
+			fmt.Printf("⚠️  Warning: estimate not copied: %v\n", err)
+		} else if value, ok := points[*item.EstimatePoint]; ok {
+			share := value / 2
+			if estimateMode == "distribute" {
+				share = value / float64(len(titles))
+			}
+			estimateID, estimateLabel = nearestEstimatePoint(points, share)
+		}
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Printf("✂️  Split %s: %s\n", identifier, item.Name)
+	fmt.Println(strings.Repeat("=", 70))
+	for i, t := range titles {
+		fmt.Printf("  %d. %s\n", i+1, t)
+	}
+	fmt.Printf("\nCopied:   %d label(s), %d assignee(s)", len(labels), len(assignees))
+	if moduleID != "" {
+		fmt.Print(", module")
+	}
+	fmt.Println()
+	if estimateLabel != "" {
+		fmt.Printf("Estimate: %s each\n", estimateLabel)
+	}
+	switch link {
+	case "child":
+		fmt.Printf("Linked:   as sub-items of %s\n", identifier)
+	case "related":
+		fmt.Printf("Linked:   as related to %s\n", identifier)
+	}
+	if note {
+		fmt.Printf("Note:     \"Split into\" appended to %s\n", identifier)
+	}
+
+	if dryRun {
+		fmt.Println("\n🔍 Dry run - no items created.")
+		return nil
+	}
+
+	if !yes {
+		confirmed, err := confirm(fmt.Sprintf("Create %d work items?", len(titles)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("❌ Split cancelled.")
+			return nil
+		}
+	}
+
+	fmt.Println()
+	var createdIDs, createdKeys []string
+	for _, title := range titles {
+		create := &plane.WorkItemCreate{
+			Name:          title,
+			Labels:        labels,
+			Assignees:     assignees,
+			Module:        moduleID,
+			EstimatePoint: estimateID,
+		}
+		if link == "child" {
+			create.Parent = item.ID
+		}
+
+		workItem, err := client.CreateWorkItem(projectID, create)
+		if err != nil {
+			fmt.Printf("  ❌ Failed: %s - %v\n", title, err)
+			continue
+		}
+		key := fmt.Sprintf("%s%d", prefix, workItem.SequenceID)
+		fmt.Printf("  ✅ Created: %s %s\n", key, title)
+
+		// If module was set but didn't apply during creation, update it separately
+		if moduleID != "" && workItem.ModuleID == "" {
+			if _, err := client.UpdateWorkItem(projectID, workItem.ID, &plane.WorkItemUpdate{Module: moduleID}); err != nil {
+				fmt.Printf("  ⚠️  Warning: Created but couldn't set module: %v\n", err)
+			}
+		}
+
+		createdIDs = append(createdIDs, workItem.ID)
+		createdKeys = append(createdKeys, key)
+	}
+
+	if len(createdIDs) == 0 {
+		return fmt.Errorf("no work items were created")
+	}
+
+	if link == "related" {
+		if err := client.CreateWorkItemRelation(projectID, item.ID, plane.RelationRelatesTo, createdIDs); err != nil {
+			fmt.Printf("⚠️  Warning: couldn't relate the new items: %v\n", err)
+		}
+	}
+
+	if note {
+		description := item.DescriptionHTML + fmt.Sprintf("<p>Split into %s.</p>", html.EscapeString(strings.Join(createdKeys, ", ")))
+		if _, err := client.UpdateWorkItem(projectID, item.ID, &plane.WorkItemUpdate{DescriptionHTML: description}); err != nil {
+			fmt.Printf("⚠️  Warning: couldn't add the split note: %v\n", err)
+		}
+	}
+
+	fmt.Printf("\n✅ Split %s into %d/%d work items: %s\n", identifier, len(createdIDs), len(titles), strings.Join(createdKeys, ", "))
+	return nil
+}
+
+// estimatePoints maps the estimate point IDs of a project to their numeric values
+func estimatePoints(client *plane.Client, projectID string) (map[string]float64, error) {
+	estimates, err := client.GetEstimates(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make(map[string]float64)
+	for _, e := range estimates {
+		for _, p := range e.Points {
+			if v, err := strconv.ParseFloat(p.Value, 64); err == nil {
+				points[p.ID] = v
+			}
+		}
+	}
+	return points, nil
+}
+
+// nearestEstimatePoint returns the point closest to value, preferring the
+// smaller point on ties
+func nearestEstimatePoint(points map[string]float64, value float64) (string, string) {
+	bestID, best := "", math.Inf(1)
+	for id, v := range points {
+		d := math.Abs(v - value)
+		if d < math.Abs(best-value) || (d == math.Abs(best-value) && v < best) {
+			bestID, best = id, v
+		}
+	}
+	if bestID == "" {
+		return "", ""
+	}
+	return bestID, strconv.FormatFloat(best, 'f', -1, 64)
+}
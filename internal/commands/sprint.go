@@ -0,0 +1,619 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/console"
+	"plane-cli/internal/plane"
+	"plane-cli/internal/report"
+)
+
+var sprintCmd = &cobra.Command{
+	Use:   "sprint",
+	Short: "Run sprints: status, add, plan and close",
+	Long: `Work with cycles the way scrum teams talk about sprints. The sprint is
+the cycle running today; once it has ended, the latest ended cycle until
+the next one starts. The next sprint is the first cycle starting after it.
+
+  status  progress report of the sprint
+  add     add work items to the sprint (or the next one with --next)
+  plan    create the next sprint following the project's cadence if there
+          is none yet, and pick the open work items to pull into it
+  close   carry the sprint's unfinished work items over to the next sprint
+          and print the sprint's summary
+
+Use 'plane-cli cycle' to manage cycles directly.
+
+Examples:
+  plane-cli sprint status --project <project-id>
+  plane-cli sprint add PROJ-12 PROJ-15
+  plane-cli sprint plan --project <project-id>
+  plane-cli sprint close --project <project-id> --dry-run`,
+}
+
+var sprintStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the progress of the current sprint",
+	Args:  cobra.NoArgs,
+	RunE:  runSprintStatus,
+}
+
+var sprintAddCmd = &cobra.Command{
+	Use:   "add <work-item>...",
+	Short: "Add work items to the current sprint",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runSprintAdd,
+}
+
+var sprintPlanCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Create the next sprint and pick the work items for it",
+	Args:  cobra.NoArgs,
+	RunE:  runSprintPlan,
+}
+
+var sprintCloseCmd = &cobra.Command{
+	Use:   "close",
+	Short: "Carry unfinished work over to the next sprint",
+	Args:  cobra.NoArgs,
+	RunE:  runSprintClose,
+}
+
+// defaultSprintDays is the length of the first sprint of a project
+const defaultSprintDays = 14
+
+// sprintNumberPattern matches the number at the end of a sprint name
+var sprintNumberPattern = regexp.MustCompile(`(\d+)\s*$`)
+
+// priorityRank orders priorities from most to least pressing
+var priorityRank = map[string]int{"urgent": 0, "high": 1, "medium": 2, "low": 3}
+
+func init() {
+	rootCmd.AddCommand(sprintCmd)
+	sprintCmd.AddCommand(sprintStatusCmd)
+	sprintCmd.AddCommand(sprintAddCmd)
+	sprintCmd.AddCommand(sprintPlanCmd)
+	sprintCmd.AddCommand(sprintCloseCmd)
+
+	for _, c := range []*cobra.Command{sprintStatusCmd, sprintPlanCmd, sprintCloseCmd} {
+		c.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+		c.MarkFlagRequired("project")
+	}
+	for _, c := range []*cobra.Command{sprintStatusCmd, sprintAddCmd, sprintCloseCmd} {
+		c.Flags().String("cycle", "", "Cycle name or ID (default: the current sprint)")
+	}
+
+	sprintStatusCmd.Flags().String("format", report.FormatText, "Output format: text, html or pdf")
+	sprintStatusCmd.Flags().String("out", "", "Output file for html/pdf (default: sprint-<name>.<format>)")
+	sprintStatusCmd.Flags().String("pdf-renderer", "", "Path to wkhtmltopdf or a Chromium-based browser")
+
+	sprintAddCmd.Flags().Bool("next", false, "Add to the next sprint instead of the current one")
+
+	sprintPlanCmd.Flags().String("name", "", "Name of the sprint to create (default: the last sprint's number plus one)")
+	sprintPlanCmd.Flags().Int("days", 0, "Length of the sprint to create (default: the project's usual length)")
+	sprintPlanCmd.Flags().StringSlice("add", nil, "Work items to pull into the sprint, e.g. PROJ-12,PROJ-15 (default: pick from a list)")
+	sprintPlanCmd.Flags().Bool("dry-run", false, "Show the plan without creating or changing anything")
+	sprintPlanCmd.Flags().Bool("yes", false, "Create the sprint without asking")
+
+	sprintCloseCmd.Flags().String("to", "", "Cycle name or ID to carry unfinished work to (default: the next sprint, created if needed)")
+	sprintCloseCmd.Flags().Bool("dry-run", false, "Show what would be carried over without changing anything")
+	sprintCloseCmd.Flags().Bool("yes", false, "Carry over without asking")
+}
+
+func runSprintStatus(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	ref, _ := cmd.Flags().GetString("cycle")
+	format, _ := cmd.Flags().GetString("format")
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	links, err := newItemLinker(cfg, resolveWorkspace(cmd, cfg))
+	if err != nil {
+		return err
+	}
+
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get cycles: %w", err)
+	}
+	cycle, err := sprintCycle(cycles, ref)
+	if err != nil {
+		return err
+	}
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+	items, err := client.GetCycleWorkItems(projectID, cycle.ID)
+	if err != nil {
+		return err
+	}
+
+	doc := sprintReport(cycle, items, lookup, links, projectID, format)
+	return writeReport(cmd, doc, "sprint-"+slugify(cycle.Name), links)
+}
+
+func runSprintAdd(cmd *cobra.Command, args []string) error {
+	ref, _ := cmd.Flags().GetString("cycle")
+	next, _ := cmd.Flags().GetBool("next")
+	if ref != "" && next {
+		return fmt.Errorf("--cycle and --next cannot be combined")
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Work items may come from several projects, each with its own sprint
+	type batch struct {
+		cycle *plane.Cycle
+		ids   []string
+		keys  []string
+	}
+	batches := make(map[string]*batch)
+	var order []string
+	for _, arg := range args {
+		item, projectID, err := itemByIdentifier(client, arg)
+		if err != nil {
+			return err
+		}
+		b := batches[projectID]
+		if b == nil {
+			cycles, err := client.GetProjectCycles(projectID)
+			if err != nil {
+				return fmt.Errorf("failed to get cycles: %w", err)
+			}
+			var cycle *plane.Cycle
+			switch {
+			case ref != "":
+				cycle, err = findCycle(cycles, ref)
+			case next:
+				if cycle = nextSprintCycle(cycles); cycle == nil {
+					err = fmt.Errorf("there is no next sprint yet; create it with: plane-cli sprint plan --project %s", projectID)
+				}
+			default:
+				cycle, err = sprintCycle(cycles, "")
+			}
+			if err != nil {
+				return err
+			}
+			b = &batch{cycle: cycle}
+			batches[projectID] = b
+			order = append(order, projectID)
+		}
+		b.ids = append(b.ids, item.ID)
+		b.keys = append(b.keys, strings.ToUpper(arg))
+	}
+
+	for _, projectID := range order {
+		b := batches[projectID]
+		if err := client.AddWorkItemsToCycle(projectID, b.cycle.ID, b.ids); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Added %s to %s\n", strings.Join(b.keys, ", "), b.cycle.Name)
+	}
+	return nil
+}
+
+func runSprintPlan(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	name, _ := cmd.Flags().GetString("name")
+	days, _ := cmd.Flags().GetInt("days")
+	add, _ := cmd.Flags().GetStringSlice("add")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+	if days < 0 {
+		return fmt.Errorf("--days must be positive")
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get cycles: %w", err)
+	}
+
+	next := nextSprintCycle(cycles)
+	if next != nil {
+		fmt.Printf("📅 Next sprint: %s (%s → %s)\n", next.Name, emptyAsDash(dateValue(next.StartDate)), emptyAsDash(dateValue(next.EndDate)))
+	} else {
+		created, err := createNextSprint(client, projectID, cycles, name, days, dryRun, yes)
+		if err != nil || created == nil {
+			return err
+		}
+		next = created
+	}
+
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+
+	var picked []plane.WorkItem
+	if len(add) > 0 {
+		for _, id := range add {
+			item, _, err := itemByIdentifier(client, id)
+			if err != nil {
+				return err
+			}
+			picked = append(picked, *item)
+		}
+	} else {
+		fmt.Fprintf(os.Stderr, "📥 Fetching work items from project '%s'...\n", projectID)
+		items, err := fetchAllWorkItemsForProject(client, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch work items: %w", err)
+		}
+		candidates := sprintCandidates(items, lookup)
+		if len(candidates) == 0 {
+			fmt.Println("\nNo open work items outside a cycle to pull in.")
+			return nil
+		}
+
+		if dryRun || yes || !console.IsTerminal(os.Stdin) {
+			fmt.Printf("\n📋 Open work items outside a cycle (%d):\n", len(candidates))
+			for i := range candidates {
+				fmt.Printf("   %s\n", sprintItemLine(&candidates[i], lookup))
+			}
+			fmt.Println("\nPull them in with --add, e.g. --add " + fmt.Sprintf("%s-%d", lookup.projectIdentifier, candidates[0].SequenceID))
+			return nil
+		}
+
+		options := make([]string, len(candidates))
+		for i := range candidates {
+			options[i] = sprintItemLine(&candidates[i], lookup)
+		}
+		selected, err := selectMultiOption(fmt.Sprintf("Pull into %s:", next.Name), options)
+		if err != nil {
+			return err
+		}
+		for _, i := range selected {
+			picked = append(picked, candidates[i])
+		}
+	}
+
+	if len(picked) == 0 {
+		fmt.Println("Nothing pulled in.")
+		return nil
+	}
+	if dryRun {
+		fmt.Printf("\n🔍 DRY RUN - would pull %d work item(s) into %s:\n", len(picked), next.Name)
+		for i := range picked {
+			fmt.Printf("   %s\n", sprintItemLine(&picked[i], lookup))
+		}
+		return nil
+	}
+
+	ids := make([]string, len(picked))
+	for i := range picked {
+		ids[i] = picked[i].ID
+	}
+	if err := client.AddWorkItemsToCycle(projectID, next.ID, ids); err != nil {
+		return err
+	}
+	fmt.Printf("\n✅ Pulled %d work item(s) into %s\n", len(picked), next.Name)
+	return nil
+}
+
+func runSprintClose(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	ref, _ := cmd.Flags().GetString("cycle")
+	to, _ := cmd.Flags().GetString("to")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	links, err := newItemLinker(cfg, resolveWorkspace(cmd, cfg))
+	if err != nil {
+		return err
+	}
+
+	cycles, err := client.GetProjectCycles(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get cycles: %w", err)
+	}
+	cycle, err := sprintCycle(cycles, ref)
+	if err != nil {
+		return err
+	}
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+	items, err := client.GetCycleWorkItems(projectID, cycle.ID)
+	if err != nil {
+		return err
+	}
+
+	var unfinished []plane.WorkItem
+	for i := range items {
+		if group := lookup.stateGroup(&items[i]); group != "completed" && group != "cancelled" {
+			unfinished = append(unfinished, items[i])
+		}
+	}
+
+	// The sprint to carry over to, created after confirmation when missing
+	var target *plane.Cycle
+	if to != "" {
+		if target, err = findCycle(cycles, to); err != nil {
+			return err
+		}
+	} else {
+		target = cycleAfter(cycles, cycle)
+	}
+	endsEarly := scheduleDate(cycle.EndDate).After(startOfToday())
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Printf("                    🏁 CLOSE SPRINT: %s\n", cycle.Name)
+	fmt.Println(strings.Repeat("=", 70))
+	if endsEarly {
+		fmt.Printf("Ends early: the end date moves from %s to today\n", dateValue(cycle.EndDate))
+	}
+	if len(unfinished) == 0 {
+		fmt.Println("Every work item is done - nothing to carry over.")
+	} else {
+		targetName := "a new sprint"
+		if target != nil {
+			targetName = target.Name
+		}
+		fmt.Printf("Carry over %d unfinished work item(s) to %s:\n", len(unfinished), targetName)
+		for i := range unfinished {
+			fmt.Printf("   %s\n", sprintItemLine(&unfinished[i], lookup))
+		}
+	}
+	fmt.Println(strings.Repeat("=", 70))
+
+	if dryRun {
+		fmt.Println("\n🔍 DRY RUN - nothing was changed")
+		return nil
+	}
+	if len(unfinished) > 0 || endsEarly {
+		if !yes {
+			ok, err := confirm(fmt.Sprintf("Close %s?", cycle.Name))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("❌ Sprint not closed.")
+				return nil
+			}
+		}
+	}
+
+	if len(unfinished) > 0 {
+		if target == nil {
+			if target, err = createNextSprint(client, projectID, cycles, "", 0, false, true); err != nil {
+				return err
+			}
+		}
+		ids := make([]string, len(unfinished))
+		for i := range unfinished {
+			ids[i] = unfinished[i].ID
+		}
+		if err := client.AddWorkItemsToCycle(projectID, target.ID, ids); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Carried %d work item(s) over to %s\n", len(unfinished), target.Name)
+	}
+	if endsEarly {
+		today := startOfToday().Format("2006-01-02")
+		if _, err := client.UpdateCycle(projectID, cycle.ID, &plane.CycleUpdate{EndDate: today}); err != nil {
+			return fmt.Errorf("failed to end the cycle today: %w", err)
+		}
+		fmt.Printf("✅ %s now ends on %s\n", cycle.Name, today)
+	}
+
+	// The summary shows the sprint as it ended, before the carry-over
+	doc := sprintReport(cycle, items, lookup, links, projectID, report.FormatText)
+	if len(unfinished) > 0 {
+		doc.Sections[0].Metrics = append(doc.Sections[0].Metrics,
+			report.Metric{Label: "Carried over", Value: fmt.Sprintf("%d → %s", len(unfinished), target.Name)})
+	}
+	var buf bytes.Buffer
+	if err := report.RenderText(&buf, doc); err != nil {
+		return err
+	}
+	return links.writeTable(os.Stdout, buf.Bytes())
+}
+
+// startOfToday returns midnight of the current local day
+func startOfToday() time.Time {
+	y, m, d := time.Now().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
+
+// datedCycles returns the cycles with both dates, by start date
+func datedCycles(cycles []plane.Cycle) []plane.Cycle {
+	var dated []plane.Cycle
+	for _, c := range cycles {
+		if !scheduleDate(c.StartDate).IsZero() && !scheduleDate(c.EndDate).IsZero() {
+			dated = append(dated, c)
+		}
+	}
+	sort.SliceStable(dated, func(i, j int) bool {
+		return scheduleDate(dated[i].StartDate).Before(scheduleDate(dated[j].StartDate))
+	})
+	return dated
+}
+
+// sprintCycle returns the cycle named by ref, or else the current sprint:
+// the cycle running today, or the latest one that has ended
+func sprintCycle(cycles []plane.Cycle, ref string) (*plane.Cycle, error) {
+	if ref != "" {
+		return findCycle(cycles, ref)
+	}
+
+	today := startOfToday()
+	var ended *plane.Cycle
+	dated := datedCycles(cycles)
+	for i := range dated {
+		start, end := scheduleDate(dated[i].StartDate), scheduleDate(dated[i].EndDate)
+		if !today.Before(start) && !today.After(end) {
+			return &dated[i], nil
+		}
+		if end.Before(today) {
+			ended = &dated[i]
+		}
+	}
+	if ended != nil {
+		return ended, nil
+	}
+	return nil, fmt.Errorf("no sprint has started yet; pass --cycle or plan one with 'plane-cli sprint plan'")
+}
+
+// nextSprintCycle returns the first cycle starting after today, or nil
+func nextSprintCycle(cycles []plane.Cycle) *plane.Cycle {
+	today := startOfToday()
+	for _, c := range datedCycles(cycles) {
+		if scheduleDate(c.StartDate).After(today) {
+			return &c
+		}
+	}
+	return nil
+}
+
+// cycleAfter returns the first cycle starting after cycle, or nil
+func cycleAfter(cycles []plane.Cycle, cycle *plane.Cycle) *plane.Cycle {
+	start := scheduleDate(cycle.StartDate)
+	for _, c := range datedCycles(cycles) {
+		if c.ID != cycle.ID && scheduleDate(c.StartDate).After(start) {
+			return &c
+		}
+	}
+	return nil
+}
+
+// nextSprintSchedule proposes the sprint after the project's last one: it
+// starts as far after it as cycles are usually apart, no earlier than
+// today, and lasts as long as cycles usually do (or days when set). The
+// name continues the last sprint's numbering.
+func nextSprintSchedule(cycles []plane.Cycle, days int) (string, time.Time, time.Time) {
+	dated := datedCycles(cycles)
+	today := startOfToday()
+	if len(dated) == 0 {
+		if days == 0 {
+			days = defaultSprintDays
+		}
+		return fmt.Sprintf("Sprint %d", len(cycles)+1), today, today.AddDate(0, 0, days-1)
+	}
+
+	var lengths, gaps []int
+	for i, c := range dated {
+		lengths = append(lengths, scheduleDays(scheduleDate(c.StartDate), scheduleDate(c.EndDate)))
+		if i > 0 {
+			gaps = append(gaps, max(0, scheduleDays(scheduleDate(dated[i-1].EndDate), scheduleDate(c.StartDate))-2))
+		}
+	}
+	if days == 0 {
+		days = medianInt(lengths)
+	}
+	gap := 0
+	if len(gaps) > 0 {
+		gap = medianInt(gaps)
+	}
+
+	last := dated[len(dated)-1]
+	start := scheduleDate(last.EndDate).AddDate(0, 0, gap+1)
+	if start.Before(today) {
+		start = today
+	}
+
+	name := fmt.Sprintf("Sprint %d", len(cycles)+1)
+	if m := sprintNumberPattern.FindStringSubmatchIndex(last.Name); m != nil {
+		n, _ := strconv.Atoi(last.Name[m[2]:m[3]])
+		name = last.Name[:m[2]] + strconv.Itoa(n+1)
+	}
+	return name, start, start.AddDate(0, 0, days-1)
+}
+
+// createNextSprint creates the sprint proposed by nextSprintSchedule after
+// showing it and asking, unless yes is set. It returns nil without error
+// when the user declines; a dry run returns the sprint it would create,
+// without an ID.
+func createNextSprint(client *plane.Client, projectID string, cycles []plane.Cycle, name string, days int, dryRun, yes bool) (*plane.Cycle, error) {
+	proposed, start, end := nextSprintSchedule(cycles, days)
+	if name == "" {
+		name = proposed
+	}
+	fmt.Printf("📅 Next sprint: %s (%s → %s, %d days)\n", name, start.Format("2006-01-02"), end.Format("2006-01-02"), scheduleDays(start, end))
+	if dryRun {
+		fmt.Println("🔍 DRY RUN - the sprint was not created")
+		return &plane.Cycle{Name: name}, nil
+	}
+
+	ok := true
+	var err error
+	if warnings := cycleScheduleWarnings(cycles, "", start, end); len(warnings) > 0 {
+		ok, err = confirmScheduleWarnings(warnings, yes, "Create the sprint")
+	} else if !yes {
+		ok, err = confirm(fmt.Sprintf("Create %s?", name))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		fmt.Println("❌ Sprint not created.")
+		return nil, nil
+	}
+
+	cycle, err := client.CreateCycle(projectID, &plane.CycleCreate{
+		Name:      name,
+		StartDate: start.Format("2006-01-02"),
+		EndDate:   end.Format("2006-01-02"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the sprint: %w", err)
+	}
+	fmt.Printf("✅ Created %s\n", cycle.Name)
+	return cycle, nil
+}
+
+// sprintCandidates returns the open work items that are in no cycle, most
+// pressing first
+func sprintCandidates(items []plane.WorkItem, lookup *itemLookup) []plane.WorkItem {
+	var candidates []plane.WorkItem
+	for i := range items {
+		group := lookup.stateGroup(&items[i])
+		if group == "completed" || group == "cancelled" || items[i].CycleID != "" || items[i].Cycle != "" {
+			continue
+		}
+		candidates = append(candidates, items[i])
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		pi, oki := priorityRank[candidates[i].Priority]
+		pj, okj := priorityRank[candidates[j].Priority]
+		if !oki {
+			pi = len(priorityRank)
+		}
+		if !okj {
+			pj = len(priorityRank)
+		}
+		if pi != pj {
+			return pi < pj
+		}
+		return candidates[i].SequenceID < candidates[j].SequenceID
+	})
+	return candidates
+}
+
+// sprintItemLine describes a work item in one line for sprint lists
+func sprintItemLine(item *plane.WorkItem, lookup *itemLookup) string {
+	return fmt.Sprintf("%s-%d [%s] %s (%s)", lookup.projectIdentifier, item.SequenceID,
+		emptyAsDash(item.Priority), truncate(item.Name, 50), emptyAsDash(lookup.stateNames[itemStateID(item)]))
+}
@@ -0,0 +1,390 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var stateCmd = &cobra.Command{
+	Use:   "state",
+	Short: "Manage project workflow states",
+	Long: `List, create, rename, recolor and delete the workflow states of a project.
+
+States belong to a group: backlog, unstarted, started, completed or cancelled.
+States can be referenced by ID or by name.
+
+Examples:
+  # List the states of a project, grouped in workflow order
+  plane-cli state list --project <project-id>
+
+  # Add a state
+  plane-cli state create --project <project-id> --name "In Review" --group started --color "#f59e0b"
+
+  # Rename and recolor a state
+  plane-cli state update --project <project-id> --state "In Review" --name "Code Review" --color "#eab308"
+
+  # Delete a state (it must not hold any work items)
+  plane-cli state delete --project <project-id> --state "Code Review"
+
+  # Interactive state management
+  plane-cli state interactive`,
+}
+
+var stateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the workflow states of a project",
+	RunE:  runStateList,
+}
+
+var stateCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a workflow state",
+	RunE:  runStateCreate,
+}
+
+var stateUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Rename, recolor or regroup a workflow state",
+	RunE:  runStateUpdate,
+}
+
+var stateDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a workflow state",
+	RunE:  runStateDelete,
+}
+
+var stateInteractiveCmd = &cobra.Command{
+	Use:   "interactive",
+	Short: "Interactive workflow state management",
+	Long:  `Interactive workflow for managing states - select project, then create, update, or delete states.`,
+	RunE:  runStateInteractive,
+}
+
+func init() {
+	rootCmd.AddCommand(stateCmd)
+	stateCmd.AddCommand(stateListCmd)
+	stateCmd.AddCommand(stateCreateCmd)
+	stateCmd.AddCommand(stateUpdateCmd)
+	stateCmd.AddCommand(stateDeleteCmd)
+	stateCmd.AddCommand(stateInteractiveCmd)
+
+	for _, c := range []*cobra.Command{stateListCmd, stateCreateCmd, stateUpdateCmd, stateDeleteCmd} {
+		c.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+		c.MarkFlagRequired("project")
+	}
+
+	stateCreateCmd.Flags().String("name", "", "State name (required)")
+	stateCreateCmd.Flags().String("group", "", "State group: "+strings.Join(plane.StateGroups, ", ")+" (required)")
+	stateCreateCmd.Flags().String("color", "#858e96", "State color (hex code)")
+	stateCreateCmd.Flags().String("description", "", "State description")
+	stateCreateCmd.MarkFlagRequired("name")
+	stateCreateCmd.MarkFlagRequired("group")
+
+	stateUpdateCmd.Flags().String("state", "", "State ID or name (required)")
+	stateUpdateCmd.Flags().String("name", "", "New state name")
+	stateUpdateCmd.Flags().String("color", "", "New state color")
+	stateUpdateCmd.Flags().String("group", "", "Move the state to another group")
+	stateUpdateCmd.MarkFlagRequired("state")
+
+	stateDeleteCmd.Flags().String("state", "", "State ID or name (required)")
+	stateDeleteCmd.MarkFlagRequired("state")
+}
+
+func runStateList(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	return listStates(client, projectID)
+}
+
+func runStateCreate(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	name, _ := cmd.Flags().GetString("name")
+	group, _ := cmd.Flags().GetString("group")
+	color, _ := cmd.Flags().GetString("color")
+	description, _ := cmd.Flags().GetString("description")
+
+	group = strings.ToLower(group)
+	if err := validateStateGroup(group); err != nil {
+		return err
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	state, err := client.CreateState(projectID, &plane.StateCreate{
+		Name:        name,
+		Group:       group,
+		Color:       color,
+		Description: description,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✅ Created state:\n")
+	fmt.Printf("   ID: %s\n", state.ID)
+	fmt.Printf("   Name: %s\n", state.Name)
+	fmt.Printf("   Group: %s\n", state.Group)
+	fmt.Printf("   Color: %s\n", state.Color)
+	return nil
+}
+
+func runStateUpdate(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	ref, _ := cmd.Flags().GetString("state")
+	name, _ := cmd.Flags().GetString("name")
+	color, _ := cmd.Flags().GetString("color")
+	group, _ := cmd.Flags().GetString("group")
+
+	if name == "" && color == "" && group == "" {
+		return fmt.Errorf("nothing to update: pass --name, --color or --group")
+	}
+	group = strings.ToLower(group)
+	if group != "" {
+		if err := validateStateGroup(group); err != nil {
+			return err
+		}
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	state, err := findState(client, projectID, ref)
+	if err != nil {
+		return err
+	}
+
+	updated, err := client.UpdateState(projectID, state.ID, &plane.StateUpdate{Name: name, Color: color, Group: group})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✅ Updated state:\n")
+	fmt.Printf("   ID: %s\n", updated.ID)
+	fmt.Printf("   Name: %s\n", updated.Name)
+	fmt.Printf("   Group: %s\n", updated.Group)
+	fmt.Printf("   Color: %s\n", updated.Color)
+	return nil
+}
+
+func runStateDelete(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	ref, _ := cmd.Flags().GetString("state")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	state, err := findState(client, projectID, ref)
+	if err != nil {
+		return err
+	}
+
+	return deleteState(client, projectID, state)
+}
+
+func runStateInteractive(cmd *cobra.Command, args []string) error {
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	return runStateInteractiveSubmenu(client, false)
+}
+
+// validateStateGroup checks that group is one of Plane's state groups
+func validateStateGroup(group string) error {
+	if !slices.Contains(plane.StateGroups, group) {
+		return fmt.Errorf("unknown state group '%s' (use %s)", group, strings.Join(plane.StateGroups, ", "))
+	}
+	return nil
+}
+
+// findState resolves a state by ID or case-insensitive name
+func findState(client *plane.Client, projectID, ref string) (*plane.State, error) {
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range states {
+		if states[i].ID == ref || strings.EqualFold(states[i].Name, ref) {
+			return &states[i], nil
+		}
+	}
+	return nil, fmt.Errorf("state '%s' not found", ref)
+}
+
+// sortStatesByGroup orders states by workflow group, keeping the API order
+// within a group
+func sortStatesByGroup(states []plane.State) {
+	slices.SortStableFunc(states, func(a, b plane.State) int {
+		return slices.Index(plane.StateGroups, a.Group) - slices.Index(plane.StateGroups, b.Group)
+	})
+}
+
+func listStates(client *plane.Client, projectID string) error {
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return err
+	}
+
+	if len(states) == 0 {
+		fmt.Println("No states found in this project.")
+		return nil
+	}
+	sortStatesByGroup(states)
+
+	fmt.Printf("\n🔀 States (%d):\n\n", len(states))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "GROUP\tNAME\tCOLOR\tID")
+	for _, s := range states {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Group, s.Name, emptyAsDash(s.Color), s.ID)
+	}
+	w.Flush()
+	fmt.Println()
+	return nil
+}
+
+// deleteState shows the impact of deleting a state, asks for confirmation
+// and deletes it
+func deleteState(client *plane.Client, projectID string, state *plane.State) error {
+	impact := stateDeleteImpact(client, projectID, state)
+	if impact.Items > 0 {
+		impact.print()
+		return fmt.Errorf("state '%s' still holds %d work item(s); move them to another state first", state.Name, impact.Items)
+	}
+
+	confirmed, err := confirmDestructive(impact)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		fmt.Println("❌ Deletion cancelled.")
+		return nil
+	}
+
+	if err := client.DeleteState(projectID, state.ID); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✅ State '%s' deleted.\n", state.Name)
+	return nil
+}
+
+func selectStateInteractive(client *plane.Client, projectID, message string) (*plane.State, error) {
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, fmt.Errorf("no states found")
+	}
+	sortStatesByGroup(states)
+
+	var options []string
+	for _, s := range states {
+		options = append(options, fmt.Sprintf("%s (%s)", s.Name, s.Group))
+	}
+
+	idx, err := selectOption(message, options)
+	if err != nil {
+		return nil, err
+	}
+	return &states[idx], nil
+}
+
+func createStateInteractive(client *plane.Client, projectID string) error {
+	fmt.Println("\n➕ Create New State")
+
+	name, err := input("State name:")
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return fmt.Errorf("state name is required")
+	}
+
+	idx, err := selectOption("Group:", plane.StateGroups)
+	if err != nil {
+		return err
+	}
+
+	color, err := inputWithDefault("Color (hex code):", "#858e96")
+	if err != nil {
+		return err
+	}
+
+	state, err := client.CreateState(projectID, &plane.StateCreate{
+		Name:  name,
+		Group: plane.StateGroups[idx],
+		Color: color,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✅ Created state: %s (ID: %s)\n", state.Name, state.ID)
+	return nil
+}
+
+func updateStateInteractive(client *plane.Client, projectID string) error {
+	state, err := selectStateInteractive(client, projectID, "Select state to update:")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✏️  Update State: %s\n", state.Name)
+
+	update := &plane.StateUpdate{}
+
+	name, err := inputWithDefault(fmt.Sprintf("New name (current: %s):", state.Name), "")
+	if err != nil {
+		return err
+	}
+	update.Name = name
+
+	color, err := inputWithDefault(fmt.Sprintf("New color (current: %s):", state.Color), "")
+	if err != nil {
+		return err
+	}
+	update.Color = color
+
+	if update.Name == "" && update.Color == "" {
+		fmt.Println("No changes.")
+		return nil
+	}
+
+	updated, err := client.UpdateState(projectID, state.ID, update)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✅ Updated state: %s\n", updated.Name)
+	return nil
+}
+
+func deleteStateInteractive(client *plane.Client, projectID string) error {
+	state, err := selectStateInteractive(client, projectID, "Select state to delete:")
+	if err != nil {
+		return err
+	}
+
+	return deleteState(client, projectID, state)
+}
@@ -0,0 +1,273 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/config"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show project statistics or local command usage statistics",
+	Long: `With --project, aggregate the work items of a project, or of one of its
+cycles or modules: counts and estimate points per state and per assignee,
+and items created and completed over time drawn as sparklines. Cycles with
+dates are drawn per day over their span, everything else per week over the
+last --weeks weeks. Use -o json to export the numbers.
+
+Without --project, show which commands are used and how long they take.
+When defaults.project is set, use 'plane-cli stats usage' for these.
+
+Collecting usage statistics is opt-in and strictly local: counts and
+durations are stored in ~/.config/plane-cli/stats.yaml and never sent
+anywhere. Arguments and flag values are not recorded, only the command name.
+
+Examples:
+  plane-cli stats --project <project-id>
+  plane-cli stats --project <project-id> --cycle "Sprint 12"
+  plane-cli stats --project <project-id> --module Onboarding -o json
+
+  plane-cli stats enable
+  plane-cli stats
+  plane-cli stats reset
+  plane-cli stats disable`,
+	RunE: runStats,
+}
+
+var statsUsageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show local command usage statistics",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runUsageStats()
+	},
+}
+
+var statsEnableCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Start recording command usage",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setStatsEnabled(true)
+	},
+}
+
+var statsDisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Stop recording command usage (recorded data is kept)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setStatsEnabled(false)
+	},
+}
+
+var statsResetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Delete the recorded statistics",
+	RunE:  runStatsReset,
+}
+
+// usageStats is the local statistics store
+type usageStats struct {
+	Enabled  bool                     `yaml:"enabled"`
+	Since    time.Time                `yaml:"since,omitempty"`
+	Commands map[string]*commandUsage `yaml:"commands,omitempty"`
+}
+
+// commandUsage aggregates the runs of one command
+type commandUsage struct {
+	Runs     int       `yaml:"runs"`
+	Failures int       `yaml:"failures"`
+	TotalMs  int64     `yaml:"total_ms"`
+	MaxMs    int64     `yaml:"max_ms"`
+	LastUsed time.Time `yaml:"last_used"`
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+	statsCmd.AddCommand(statsUsageCmd)
+	statsCmd.AddCommand(statsEnableCmd)
+	statsCmd.AddCommand(statsDisableCmd)
+	statsCmd.AddCommand(statsResetCmd)
+
+	statsCmd.Flags().String("project", "", "Project ID for project statistics (defaults.project applies)")
+	statsCmd.Flags().String("cycle", "", "Only the work items of this cycle (name or ID)")
+	statsCmd.Flags().String("module", "", "Only the work items of this module (name or ID)")
+	statsCmd.Flags().Int("weeks", 12, "Number of weeks in the timeline, ending with the current week")
+}
+
+func runStats(cmd *cobra.Command, args []string) error {
+	if projectID, _ := cmd.Flags().GetString("project"); projectID != "" {
+		return runProjectStats(cmd, projectID)
+	}
+	if cycle, _ := cmd.Flags().GetString("cycle"); cycle != "" {
+		return fmt.Errorf("--project is required with --cycle")
+	}
+	if module, _ := cmd.Flags().GetString("module"); module != "" {
+		return fmt.Errorf("--project is required with --module")
+	}
+	return runUsageStats()
+}
+
+func runUsageStats() error {
+	stats, err := loadUsageStats()
+	if err != nil {
+		return err
+	}
+
+	if !stats.Enabled {
+		fmt.Println("Usage statistics are disabled. Enable them with: plane-cli stats enable")
+	}
+	if len(stats.Commands) == 0 {
+		fmt.Println("No usage recorded yet.")
+		return nil
+	}
+
+	names := sortedMapKeys(stats.Commands)
+	sort.SliceStable(names, func(i, j int) bool {
+		return stats.Commands[names[i]].Runs > stats.Commands[names[j]].Runs
+	})
+
+	fmt.Printf("\n📊 Command usage since %s\n", stats.Since.Local().Format("2006-01-02"))
+	fmt.Println(strings.Repeat("=", 70))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "COMMAND\tRUNS\tFAILED\tAVG\tMAX\tLAST USED")
+	for _, name := range names {
+		u := stats.Commands[name]
+		avg := time.Duration(u.TotalMs/int64(u.Runs)) * time.Millisecond
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\n",
+			name, u.Runs, u.Failures,
+			formatDuration(avg), formatDuration(time.Duration(u.MaxMs)*time.Millisecond),
+			u.LastUsed.Local().Format("2006-01-02 15:04"))
+	}
+	w.Flush()
+	return nil
+}
+
+func runStatsReset(cmd *cobra.Command, args []string) error {
+	stats, err := loadUsageStats()
+	if err != nil {
+		return err
+	}
+	stats.Commands = nil
+	stats.Since = time.Now()
+	if err := writeUsageStats(stats); err != nil {
+		return err
+	}
+	fmt.Println("✅ Usage statistics reset")
+	return nil
+}
+
+func setStatsEnabled(enabled bool) error {
+	stats, err := loadUsageStats()
+	if err != nil {
+		return err
+	}
+	stats.Enabled = enabled
+	if enabled && stats.Since.IsZero() {
+		stats.Since = time.Now()
+	}
+	if err := writeUsageStats(stats); err != nil {
+		return err
+	}
+	if enabled {
+		fmt.Println("✅ Usage statistics enabled (stored locally only)")
+	} else {
+		fmt.Println("✅ Usage statistics disabled")
+	}
+	return nil
+}
+
+// recordUsage adds a command run to the statistics when they are enabled.
+// Errors are ignored so statistics never break a command.
+func recordUsage(cmd *cobra.Command, elapsed time.Duration, runErr error) {
+	if cmd == nil || cmd == rootCmd || cmd == statsCmd || cmd.Parent() == statsCmd {
+		return
+	}
+	stats, err := loadUsageStats()
+	if err != nil || !stats.Enabled {
+		return
+	}
+
+	name := strings.TrimPrefix(cmd.CommandPath(), rootCmd.Name()+" ")
+	if stats.Commands == nil {
+		stats.Commands = make(map[string]*commandUsage)
+	}
+	u := stats.Commands[name]
+	if u == nil {
+		u = &commandUsage{}
+		stats.Commands[name] = u
+	}
+	ms := elapsed.Milliseconds()
+	u.Runs++
+	u.TotalMs += ms
+	if ms > u.MaxMs {
+		u.MaxMs = ms
+	}
+	if runErr != nil {
+		u.Failures++
+	}
+	u.LastUsed = time.Now()
+
+	_ = writeUsageStats(stats)
+}
+
+// formatDuration prints a duration rounded for display
+func formatDuration(d time.Duration) string {
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return d.Round(100 * time.Millisecond).String()
+}
+
+// statsPath returns the location of the statistics file
+func statsPath() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "stats.yaml"), nil
+}
+
+// loadUsageStats reads the statistics; a missing file means disabled
+func loadUsageStats() (*usageStats, error) {
+	path, err := statsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &usageStats{}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return stats, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stats: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, stats); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return stats, nil
+}
+
+func writeUsageStats(stats *usageStats) error {
+	path, err := statsPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(stats)
+	if err != nil {
+		return fmt.Errorf("failed to encode stats: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write stats: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,423 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+// workItemStats aggregates the work items of a project, cycle or module
+type workItemStats struct {
+	Project string `json:"project"`
+	// Scope is "project", or the cycle or module the items belong to
+	Scope     string `json:"scope"`
+	Total     int    `json:"total_items"`
+	Open      int    `json:"open_items"`
+	Completed int    `json:"completed_items"`
+	Cancelled int    `json:"cancelled_items"`
+	// Completion is the share of completed items, cancelled ones left out
+	Completion      float64 `json:"completion"`
+	Points          float64 `json:"points"`
+	CompletedPoints float64 `json:"completed_points"`
+	// Estimates tells whether the estimate points could be resolved
+	Estimates bool            `json:"estimates"`
+	States    []stateStats    `json:"states"`
+	Assignees []assigneeStats `json:"assignees"`
+	// Period is the length of each timeline bucket: day or week
+	Period   string           `json:"period"`
+	Timeline []timelineBucket `json:"timeline"`
+}
+
+// stateStats counts the work items in one state
+type stateStats struct {
+	Name   string  `json:"name"`
+	Group  string  `json:"group"`
+	Count  int     `json:"count"`
+	Points float64 `json:"points"`
+}
+
+// assigneeStats counts the work items of one assignee; an item with several
+// assignees counts for each
+type assigneeStats struct {
+	Name            string  `json:"name"`
+	Count           int     `json:"count"`
+	Open            int     `json:"open"`
+	Completed       int     `json:"completed"`
+	Points          float64 `json:"points"`
+	CompletedPoints float64 `json:"completed_points"`
+}
+
+// timelineBucket is one day or week of the completion timeline
+type timelineBucket struct {
+	Start           string  `json:"start"`
+	Created         int     `json:"created"`
+	Completed       int     `json:"completed"`
+	CompletedPoints float64 `json:"completed_points"`
+	// Completion is the share of the items created by the end of the bucket
+	// that were completed by then, cancelled ones left out
+	Completion float64 `json:"completion"`
+}
+
+func runProjectStats(cmd *cobra.Command, projectID string) error {
+	cycleRef, _ := cmd.Flags().GetString("cycle")
+	moduleRef, _ := cmd.Flags().GetString("module")
+	weeks, _ := cmd.Flags().GetInt("weeks")
+	if cycleRef != "" && moduleRef != "" {
+		return fmt.Errorf("--cycle and --module can't be combined")
+	}
+	if weeks < 1 || weeks > 53 {
+		return fmt.Errorf("--weeks must be between 1 and 53")
+	}
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get states: %w", err)
+	}
+
+	scope := "project"
+	var items []plane.WorkItem
+	// Cycles with dates get a daily timeline over their span
+	var from, to time.Time
+	switch {
+	case cycleRef != "":
+		cycles, err := client.GetProjectCycles(projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get cycles: %w", err)
+		}
+		cycle, err := findCycle(cycles, cycleRef)
+		if err != nil {
+			return err
+		}
+		scope = "cycle " + cycle.Name
+		if items, err = client.GetCycleWorkItems(projectID, cycle.ID); err != nil {
+			return err
+		}
+		from, _ = time.ParseInLocation("2006-01-02", dateValue(cycle.StartDate), time.Local)
+		to, _ = time.ParseInLocation("2006-01-02", dateValue(cycle.EndDate), time.Local)
+	case moduleRef != "":
+		moduleID, err := resolveModule(client, projectID, moduleRef)
+		if err != nil {
+			return err
+		}
+		module, err := client.GetModule(projectID, moduleID)
+		if err != nil {
+			return fmt.Errorf("failed to get module: %w", err)
+		}
+		scope = "module " + module.Name
+		if items, err = client.GetModuleWorkItems(projectID, moduleID); err != nil {
+			return err
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "📥 Fetching work items from project '%s'...\n", projectID)
+		if items, err = fetchAllWorkItemsForProject(client, projectID); err != nil {
+			return fmt.Errorf("failed to fetch work items: %w", err)
+		}
+	}
+
+	// Without cached estimates the points are left out
+	points, err := estimatePoints(client, projectID)
+	if err != nil {
+		points = nil
+	}
+
+	stats := aggregateWorkItems(items, states, lookup, points)
+	stats.Project, stats.Scope = lookup.projectName, scope
+	now := time.Now()
+	if !from.IsZero() && !to.IsZero() && !to.Before(from) {
+		if to.After(now) {
+			to = now
+		}
+		stats.Period = "day"
+		stats.Timeline = completionTimeline(items, lookup, points, from, to, 1)
+	} else {
+		// Weeks start on Monday
+		start := now.AddDate(0, 0, -7*(weeks-1)-(int(now.Weekday())+6)%7)
+		stats.Period = "week"
+		stats.Timeline = completionTimeline(items, lookup, points, start, now, 7)
+	}
+
+	if format != "" {
+		return render(format, stats)
+	}
+	printWorkItemStats(stats)
+	return nil
+}
+
+// aggregateWorkItems counts items and their estimate points per state and
+// per assignee. points maps estimate point IDs to values and may be nil.
+func aggregateWorkItems(items []plane.WorkItem, states []plane.State, lookup *itemLookup, points map[string]float64) *workItemStats {
+	states = slices.Clone(states)
+	slices.SortStableFunc(states, func(a, b plane.State) int {
+		return slices.Index(stateGroupOrder, a.Group) - slices.Index(stateGroupOrder, b.Group)
+	})
+
+	stats := &workItemStats{Total: len(items), Estimates: points != nil}
+	// byState indexes stats.States by state ID
+	byState := make(map[string]int)
+	for i, s := range states {
+		stats.States = append(stats.States, stateStats{Name: s.Name, Group: s.Group})
+		byState[s.ID] = i
+	}
+	byAssignee := make(map[string]*assigneeStats)
+
+	for i := range items {
+		item := &items[i]
+		value := itemPoints(item, points)
+		group := lookup.stateGroup(item)
+		stats.Points += value
+		switch group {
+		case "completed":
+			stats.Completed++
+			stats.CompletedPoints += value
+		case "cancelled":
+			stats.Cancelled++
+		default:
+			stats.Open++
+		}
+
+		si, ok := byState[itemStateID(item)]
+		if !ok {
+			// A state the project no longer lists
+			si = len(stats.States)
+			byState[itemStateID(item)] = si
+			stats.States = append(stats.States, stateStats{Name: nameOrID(lookup.stateNames, itemStateID(item)), Group: group})
+		}
+		stats.States[si].Count++
+		stats.States[si].Points += value
+
+		for _, name := range lookup.assigneeNames(item) {
+			a := byAssignee[name]
+			if a == nil {
+				a = &assigneeStats{Name: name}
+				byAssignee[name] = a
+			}
+			a.Count++
+			a.Points += value
+			switch group {
+			case "completed":
+				a.Completed++
+				a.CompletedPoints += value
+			case "cancelled":
+			default:
+				a.Open++
+			}
+		}
+	}
+
+	if scope := stats.Total - stats.Cancelled; scope > 0 {
+		stats.Completion = float64(stats.Completed) / float64(scope) * 100
+	}
+	names := sortedMapKeys(byAssignee)
+	sort.SliceStable(names, func(i, j int) bool {
+		return byAssignee[names[i]].Count > byAssignee[names[j]].Count
+	})
+	stats.Assignees = []assigneeStats{}
+	for _, name := range names {
+		stats.Assignees = append(stats.Assignees, *byAssignee[name])
+	}
+	return stats
+}
+
+// itemPoints returns the estimate value of item, 0 when it has none or the
+// point isn't in points
+func itemPoints(item *plane.WorkItem, points map[string]float64) float64 {
+	if item.EstimatePoint == nil {
+		return 0
+	}
+	return points[*item.EstimatePoint]
+}
+
+// itemCompletedAt returns when item was completed, or false when it isn't.
+// Items completed before Plane recorded completion times count on the day
+// they were last updated.
+func itemCompletedAt(item *plane.WorkItem, lookup *itemLookup) (time.Time, bool) {
+	if lookup.stateGroup(item) != "completed" {
+		return time.Time{}, false
+	}
+	if item.CompletedAt != nil {
+		return *item.CompletedAt, true
+	}
+	return item.UpdatedAt, true
+}
+
+// completionTimeline counts the items created and completed in buckets of
+// step days from the day of from to the day of to
+func completionTimeline(items []plane.WorkItem, lookup *itemLookup, points map[string]float64, from, to time.Time, step int) []timelineBucket {
+	day := func(t time.Time) time.Time {
+		t = t.Local()
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	}
+	start, end := day(from), day(to)
+	var buckets []timelineBucket
+	var bounds []time.Time
+	for t := start; !t.After(end); t = t.AddDate(0, 0, step) {
+		buckets = append(buckets, timelineBucket{Start: t.Format("2006-01-02")})
+		bounds = append(bounds, t.AddDate(0, 0, step))
+	}
+	// bucket returns the index of the bucket of t, -1 before the first
+	bucket := func(t time.Time) int {
+		if t.Before(start) {
+			return -1
+		}
+		i := int(day(t).Sub(start).Hours()/24) / step
+		return min(i, len(buckets)-1)
+	}
+
+	// Items created or completed before the first bucket count towards the
+	// completion from the start
+	created := make([]int, len(buckets))
+	completed := make([]int, len(buckets))
+	createdBefore, completedBefore := 0, 0
+	for i := range items {
+		item := &items[i]
+		cancelled := lookup.stateGroup(item) == "cancelled"
+		if b := bucket(item.CreatedAt); b < 0 {
+			if !cancelled {
+				createdBefore++
+			}
+		} else if item.CreatedAt.Before(bounds[len(bounds)-1]) {
+			buckets[b].Created++
+			if !cancelled {
+				created[b]++
+			}
+		}
+		at, ok := itemCompletedAt(item, lookup)
+		if !ok {
+			continue
+		}
+		if b := bucket(at); b < 0 {
+			completedBefore++
+		} else if at.Before(bounds[len(bounds)-1]) {
+			buckets[b].Completed++
+			buckets[b].CompletedPoints += itemPoints(item, points)
+			completed[b]++
+		}
+	}
+
+	scope, done := createdBefore, completedBefore
+	for i := range buckets {
+		scope += created[i]
+		done += completed[i]
+		if scope > 0 {
+			buckets[i].Completion = float64(done) / float64(scope) * 100
+		}
+	}
+	return buckets
+}
+
+// sparkline draws values as one bar each, scaled to the highest; zero
+// values are blank
+func sparkline(values []float64) string {
+	highest := 0.0
+	for _, v := range values {
+		highest = max(highest, v)
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		if v <= 0 {
+			sb.WriteRune(' ')
+			continue
+		}
+		i := int(v*float64(len(sparkBars))/highest+0.999999) - 1
+		sb.WriteRune(sparkBars[max(min(i, len(sparkBars)-1), 0)])
+	}
+	return sb.String()
+}
+
+// statsBar draws value as a horizontal bar of up to width blocks, scaled to
+// highest
+func statsBar(value, highest float64, width int) string {
+	if highest <= 0 || value <= 0 {
+		return ""
+	}
+	return strings.Repeat("█", max(int(value/highest*float64(width)), 1))
+}
+
+func printWorkItemStats(stats *workItemStats) {
+	fmt.Printf("\n📊 %s — %s (%d work items)\n", stats.Project, stats.Scope, stats.Total)
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("Open: %d  Completed: %d  Cancelled: %d  Completion: %.0f%%\n",
+		stats.Open, stats.Completed, stats.Cancelled, stats.Completion)
+	if stats.Estimates {
+		fmt.Printf("Points: %s of %s completed\n", formatPoints(stats.CompletedPoints), formatPoints(stats.Points))
+	}
+
+	most := 0.0
+	for _, s := range stats.States {
+		most = max(most, float64(s.Count))
+	}
+	fmt.Println("\nBy state")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if stats.Estimates {
+		fmt.Fprintln(w, "STATE\tGROUP\tITEMS\tPOINTS\t")
+	} else {
+		fmt.Fprintln(w, "STATE\tGROUP\tITEMS\t")
+	}
+	for _, s := range stats.States {
+		if stats.Estimates {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", s.Name, s.Group, s.Count, formatPoints(s.Points), statsBar(float64(s.Count), most, 20))
+		} else {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", s.Name, s.Group, s.Count, statsBar(float64(s.Count), most, 20))
+		}
+	}
+	w.Flush()
+
+	if len(stats.Assignees) > 0 {
+		fmt.Println("\nBy assignee")
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		if stats.Estimates {
+			fmt.Fprintln(w, "ASSIGNEE\tITEMS\tOPEN\tDONE\tPOINTS\tDONE POINTS")
+		} else {
+			fmt.Fprintln(w, "ASSIGNEE\tITEMS\tOPEN\tDONE")
+		}
+		for _, a := range stats.Assignees {
+			if stats.Estimates {
+				fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\t%s\n", a.Name, a.Count, a.Open, a.Completed, formatPoints(a.Points), formatPoints(a.CompletedPoints))
+			} else {
+				fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", a.Name, a.Count, a.Open, a.Completed)
+			}
+		}
+		w.Flush()
+	}
+
+	if len(stats.Timeline) > 0 {
+		first, last := stats.Timeline[0], stats.Timeline[len(stats.Timeline)-1]
+		var created, completed, completion []float64
+		totalCreated, totalCompleted := 0, 0
+		for _, b := range stats.Timeline {
+			created = append(created, float64(b.Created))
+			completed = append(completed, float64(b.Completed))
+			completion = append(completion, b.Completion)
+			totalCreated += b.Created
+			totalCompleted += b.Completed
+		}
+		fmt.Printf("\nOver time (one bar per %s from %s)\n", stats.Period, first.Start)
+		fmt.Printf("  Created     %s  %d\n", sparkline(created), totalCreated)
+		fmt.Printf("  Completed   %s  %d\n", sparkline(completed), totalCompleted)
+		fmt.Printf("  Completion  %s  %.0f%% → %.0f%%\n", sparkline(completion), first.Completion, last.Completion)
+	}
+	if !stats.Estimates {
+		fmt.Println("\nEstimate points are left out: the project's estimates aren't cached.")
+	}
+	fmt.Println()
+}
@@ -0,0 +1,291 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+	"plane-cli/internal/offline"
+	"plane-cli/internal/plane"
+	"plane-cli/internal/query"
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Download a project for offline use",
+	Long: `Download the work items, states, labels, members, modules and cycles of
+a project into a local copy, so list and view can read it with --offline:
+without a connection, or instantly on large projects.
+
+The first sync downloads everything. Later ones only list the IDs and
+update times of the work items, then download those updated since, add new
+ones and drop deleted ones. Use --full to download everything again.
+
+Examples:
+  plane-cli sync --project <project-id>
+  plane-cli sync --project <project-id> --full
+  plane-cli list --project <project-id> --offline -q 'state:"In Progress" login'
+  plane-cli view PROJ-123 --offline`,
+	RunE: runSync,
+}
+
+func init() {
+	rootCmd.AddCommand(syncCmd)
+
+	syncCmd.Flags().String("project", "", "Project ID (required unless defaults.project is set)")
+	syncCmd.MarkFlagRequired("project")
+	syncCmd.Flags().Bool("full", false, "Download every work item again instead of only the changed ones")
+}
+
+// fullSyncShare is the share of changed work items from which downloading
+// the whole listing is cheaper than fetching them one by one
+const fullSyncShare = 4
+
+// offlineDir returns the directory holding the offline copies
+func offlineDir() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "offline"), nil
+}
+
+func runSync(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	full, _ := cmd.Flags().GetBool("full")
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	dir, err := offlineDir()
+	if err != nil {
+		return err
+	}
+	workspace := resolveWorkspace(cmd, cfg)
+
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	previous, err := offline.Load(dir, workspace, project.ID)
+	if err != nil && !errors.Is(err, offline.ErrNotSynced) {
+		fmt.Printf("⚠️  Warning: %v; downloading everything\n", err)
+	}
+	if full {
+		previous = nil
+	}
+
+	store := &offline.Store{Workspace: workspace, Project: *project, SyncedAt: time.Now()}
+	fmt.Printf("📥 Syncing %s (%s)...\n", project.Name, project.Identifier)
+	if err := syncMetadata(client, project.ID, store); err != nil {
+		return err
+	}
+
+	var stats syncStats
+	if previous == nil {
+		if store.WorkItems, err = fetchAllWorkItemsForProject(client, project.ID); err != nil {
+			return fmt.Errorf("failed to fetch work items: %w", err)
+		}
+		stats.added = len(store.WorkItems)
+	} else if store.WorkItems, stats, err = syncWorkItems(client, project.ID, previous.WorkItems); err != nil {
+		return err
+	}
+
+	if err := store.Save(dir); err != nil {
+		return err
+	}
+	fmt.Printf("\n✅ Synced %d work items: %s\n", len(store.WorkItems), stats)
+	fmt.Printf("   Saved to %s\n", offline.Path(dir, workspace, project.ID))
+	return nil
+}
+
+// syncMetadata downloads the project metadata into store. Modules, cycles
+// and the current user are optional: features may be disabled.
+func syncMetadata(client *plane.Client, projectID string, store *offline.Store) error {
+	var err error
+	if store.States, err = client.GetProjectStates(projectID); err != nil {
+		return fmt.Errorf("failed to get states: %w", err)
+	}
+	if store.Labels, err = client.GetLabels(projectID); err != nil {
+		return fmt.Errorf("failed to get labels: %w", err)
+	}
+	if store.Members, err = client.GetProjectMembers(projectID); err != nil {
+		return fmt.Errorf("failed to get members: %w", err)
+	}
+	store.Modules, _ = client.GetModules(projectID)
+	store.Cycles, _ = client.GetProjectCycles(projectID)
+	if user, err := client.GetCurrentUser(); err == nil {
+		store.CurrentUser = user.ID
+	}
+	return nil
+}
+
+// syncStats counts what a sync changed in the local copy
+type syncStats struct {
+	added, updated, removed, unchanged int
+}
+
+func (s syncStats) String() string {
+	return fmt.Sprintf("%d new, %d updated, %d removed, %d unchanged", s.added, s.updated, s.removed, s.unchanged)
+}
+
+// syncWorkItems brings the work items of a previous sync up to date. Only
+// IDs and update times are listed; items whose updated_at changed and new
+// ones are downloaded, one by one or, when many changed, with the whole
+// listing.
+func syncWorkItems(client *plane.Client, projectID string, previous []plane.WorkItem) ([]plane.WorkItem, syncStats, error) {
+	var stats syncStats
+	options := map[string]string{}
+	plane.SelectFields(options, []string{"updated_at"}, nil)
+	headers, err := fetchPages(client.WorkItemsPager(projectID, options), nil)
+	if err != nil {
+		return nil, stats, fmt.Errorf("failed to list work items: %w", err)
+	}
+
+	known := make(map[string]*plane.WorkItem, len(previous))
+	for i := range previous {
+		known[previous[i].ID] = &previous[i]
+	}
+	var changed []string
+	for _, h := range headers {
+		old, ok := known[h.ID]
+		switch {
+		case !ok:
+			stats.added++
+			changed = append(changed, h.ID)
+		case !old.UpdatedAt.Equal(h.UpdatedAt):
+			stats.updated++
+			changed = append(changed, h.ID)
+		default:
+			stats.unchanged++
+		}
+	}
+	stats.removed = len(previous) - stats.updated - stats.unchanged
+
+	if len(changed) > 0 && len(changed)*fullSyncShare >= len(headers) {
+		items, err := fetchAllWorkItemsForProject(client, projectID)
+		if err != nil {
+			return nil, stats, fmt.Errorf("failed to fetch work items: %w", err)
+		}
+		return items, stats, nil
+	}
+
+	fresh := make(map[string]*plane.WorkItem, len(changed))
+	for _, id := range changed {
+		item, err := client.GetWorkItem(projectID, id)
+		if err != nil {
+			return nil, stats, fmt.Errorf("failed to fetch work item %s: %w", id, err)
+		}
+		fresh[id] = item
+	}
+	items := make([]plane.WorkItem, 0, len(headers))
+	for _, h := range headers {
+		if item, ok := fresh[h.ID]; ok {
+			items = append(items, *item)
+		} else {
+			items = append(items, *known[h.ID])
+		}
+	}
+	return items, stats, nil
+}
+
+// loadOfflineStore reads the local copy of a project given by ID or
+// identifier
+func loadOfflineStore(cmd *cobra.Command, projectRef string) (*offline.Store, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	dir, err := offlineDir()
+	if err != nil {
+		return nil, err
+	}
+	workspace := resolveWorkspace(cmd, cfg)
+
+	store, err := offline.Load(dir, workspace, projectRef)
+	if errors.Is(err, offline.ErrNotSynced) {
+		store, err = offline.LoadByIdentifier(dir, workspace, projectRef)
+	}
+	if errors.Is(err, offline.ErrNotSynced) {
+		return nil, fmt.Errorf("project %s has not been synced; run: plane-cli sync --project %s", projectRef, projectRef)
+	}
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(os.Stderr, "📴 Offline copy from %s\n", store.SyncedAt.Local().Format("2006-01-02 15:04"))
+	return store, nil
+}
+
+// offlineItem finds a work item like PROJ-123 in the local copies
+func offlineItem(cmd *cobra.Command, identifier string) (*offline.Store, *plane.WorkItem, error) {
+	i := strings.LastIndex(identifier, "-")
+	if i < 0 {
+		return nil, nil, fmt.Errorf("invalid work item identifier '%s' (expected e.g. PROJ-123)", identifier)
+	}
+	store, err := loadOfflineStore(cmd, identifier[:i])
+	if err != nil {
+		return nil, nil, err
+	}
+	item, ok := store.Item(identifier[i+1:])
+	if !ok {
+		return nil, nil, fmt.Errorf("work item %s is not in the offline copy; run: plane-cli sync --project %s", identifier, store.Project.ID)
+	}
+	return store, item, nil
+}
+
+// offlineQueryContext indexes the metadata of a local copy for queries.
+// Cycle and module membership comes from the work items themselves.
+func offlineQueryContext(store *offline.Store) *query.Context {
+	ctx := query.NewContext(store.States, store.Labels, store.Members)
+	ctx.Me = store.CurrentUser
+	ctx.Cycles = make(map[string]plane.Cycle)
+	ctx.Modules = make(map[string]plane.Module)
+	ctx.ItemCycles = make(map[string][]string)
+	ctx.ItemModules = make(map[string][]string)
+	for _, c := range store.Cycles {
+		ctx.Cycles[c.ID] = c
+	}
+	for _, m := range store.Modules {
+		ctx.Modules[m.ID] = m
+	}
+	for _, item := range store.WorkItems {
+		if id := offlineCycleID(&item); id != "" {
+			ctx.ItemCycles[item.ID] = []string{id}
+		}
+		if id := offlineModuleID(&item); id != "" {
+			ctx.ItemModules[item.ID] = []string{id}
+		}
+	}
+	return ctx
+}
+
+func offlineCycleID(item *plane.WorkItem) string {
+	if item.CycleID != "" {
+		return item.CycleID
+	}
+	return item.Cycle
+}
+
+func offlineModuleID(item *plane.WorkItem) string {
+	if item.ModuleID != "" {
+		return item.ModuleID
+	}
+	return item.Module
+}
+
+// offlineStateName returns the name of a state of a local copy, or "" when
+// it is unknown
+func offlineStateName(store *offline.Store, stateID string) string {
+	for _, s := range store.States {
+		if s.ID == stateID {
+			return s.Name
+		}
+	}
+	return ""
+}
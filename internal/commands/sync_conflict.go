@@ -0,0 +1,271 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+	"plane-cli/internal/config"
+	"plane-cli/internal/plane"
+)
+
+// syncBase holds the field values of the last successful sync per row key,
+// used as the common ancestor when both sides changed
+type syncBase struct {
+	Rows map[string]*syncBaseRow `yaml:"rows"`
+}
+
+// syncBaseRow stores what each side held after the last sync. Both sides
+// are kept because a conflict resolved in favour of Plane leaves the CSV
+// different from Plane.
+type syncBaseRow struct {
+	Local  map[string]string `yaml:"local"`
+	Remote map[string]string `yaml:"remote"`
+}
+
+// syncBasePath returns the file holding the sync base of a project and source
+func syncBasePath(projectID, source string) (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Join(dir, "sync")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s-%s.yaml", projectID, slugify(source))), nil
+}
+
+// loadSyncBase reads the sync base; a missing file means no earlier sync
+func loadSyncBase(projectID, source string) (*syncBase, error) {
+	path, err := syncBasePath(projectID, source)
+	if err != nil {
+		return nil, err
+	}
+
+	base := &syncBase{Rows: make(map[string]*syncBaseRow)}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return base, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sync state: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, base); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if base.Rows == nil {
+		base.Rows = make(map[string]*syncBaseRow)
+	}
+	return base, nil
+}
+
+// saveSyncBase records the values of every row after a sync. Rows whose
+// planned action was not applied keep their previous base so the change is
+// retried on the next run.
+func saveSyncBase(projectID, source string, rows []*csvRow, items []plane.WorkItem, states []plane.State, planned, applied []syncAction) error {
+	previous, err := loadSyncBase(projectID, source)
+	if err != nil {
+		return err
+	}
+
+	stateByID := make(map[string]plane.State, len(states))
+	for _, s := range states {
+		stateByID[s.ID] = s
+	}
+	remote := make(map[string]*plane.WorkItem)
+	for i := range items {
+		if items[i].ExternalID != "" {
+			remote[items[i].ExternalID] = &items[i]
+		}
+	}
+	pending := make(map[string]bool)
+	for _, a := range planned {
+		pending[a.Key] = true
+	}
+	done := make(map[string]syncAction)
+	for _, a := range applied {
+		done[a.Key] = a
+		delete(pending, a.Key)
+	}
+
+	base := &syncBase{Rows: make(map[string]*syncBaseRow)}
+	for _, row := range rows {
+		if pending[row.Key] {
+			if prev, ok := previous.Rows[row.Key]; ok {
+				base.Rows[row.Key] = prev
+			}
+			continue
+		}
+		item := remote[row.Key]
+		action, wasApplied := done[row.Key]
+		if item == nil && !wasApplied {
+			continue
+		}
+
+		entry := &syncBaseRow{Local: make(map[string]string), Remote: make(map[string]string)}
+		for _, field := range syncFields {
+			local, ok := csvFieldValue(row, field)
+			if !ok {
+				continue
+			}
+			entry.Local[field] = local
+			if item == nil {
+				entry.Remote[field] = syncRemoteValue(field, local)
+			} else {
+				entry.Remote[field] = planeFieldValue(item, field, stateByID)
+			}
+		}
+		if wasApplied {
+			for _, c := range action.Changes {
+				entry.Remote[c.Field] = syncRemoteValue(c.Field, c.To)
+			}
+		}
+		base.Rows[row.Key] = entry
+	}
+
+	path, err := syncBasePath(projectID, source)
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(base)
+	if err != nil {
+		return fmt.Errorf("failed to encode sync state: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write sync state: %w", err)
+	}
+	return nil
+}
+
+// syncRemoteValue returns the value Plane reports after a field is set to
+// value; descriptions are stored as HTML and compared as plain text
+func syncRemoteValue(field, value string) string {
+	if field == "description" {
+		return strings.TrimSpace(stripHTML(markdownToHTML(value)))
+	}
+	return value
+}
+
+// countConflicts returns the number of conflicting fields in the actions
+func countConflicts(actions []syncAction) int {
+	n := 0
+	for _, a := range actions {
+		for _, c := range a.Changes {
+			if c.Conflict {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// resolveSyncConflicts settles every conflicting field, either with the
+// prefer policy (local or remote) or by asking. Fields resolved in favour of
+// Plane are dropped, as are updates left without changes.
+func resolveSyncConflicts(actions []syncAction, prefer string) ([]syncAction, error) {
+	total := countConflicts(actions)
+	if prefer == "" {
+		fmt.Printf("\n🔀 Resolving %d conflict(s)\n", total)
+	}
+
+	var resolved []syncAction
+	n := 0
+	for _, a := range actions {
+		var changes []fieldChange
+		for _, c := range a.Changes {
+			if !c.Conflict {
+				changes = append(changes, c)
+				continue
+			}
+			n++
+
+			choice := prefer
+			merged := ""
+			if choice == "" {
+				var err error
+				if choice, merged, err = askConflictResolution(a, c, n, total); err != nil {
+					return nil, err
+				}
+			}
+
+			switch choice {
+			case "local":
+				c.Conflict = false
+				changes = append(changes, c)
+			case "merge":
+				if !syncValuesEqual(c.Field, merged, c.From) {
+					c.To = merged
+					c.Conflict = false
+					changes = append(changes, c)
+				}
+			}
+		}
+		if a.Kind == "update" && len(changes) == 0 {
+			continue
+		}
+		a.Changes = changes
+		resolved = append(resolved, a)
+	}
+
+	if prefer != "" {
+		fmt.Printf("\n🔀 Resolved %d conflict(s) keeping the %s value\n", total, prefer)
+	}
+	return resolved, nil
+}
+
+// askConflictResolution shows both sides of a conflict and returns local,
+// remote or merge (with the merged value)
+func askConflictResolution(a syncAction, c fieldChange, n, total int) (string, string, error) {
+	fmt.Printf("\n%s\n", strings.Repeat("-", 70))
+	fmt.Printf("⚠️  Conflict %d/%d: %s [%d] %s\n", n, total, a.Key, a.Item.SequenceID, truncate(a.Item.Name, 40))
+	fmt.Printf("   Field:          %s\n", c.Field)
+	fmt.Printf("   Last sync:      %s\n", emptyAsDash(syncDisplayValue(c.Field, c.Base)))
+	fmt.Printf("   Local (CSV):    %s\n", emptyAsDash(syncDisplayValue(c.Field, c.To)))
+	fmt.Printf("   Remote (Plane): %s\n", emptyAsDash(syncDisplayValue(c.Field, c.From)))
+
+	idx, err := selectOption("How do you want to resolve it?", []string{
+		"Keep local (CSV)",
+		"Keep remote (Plane)",
+		"Merge in editor",
+	})
+	if err != nil {
+		return "", "", err
+	}
+	switch idx {
+	case 0:
+		return "local", "", nil
+	case 1:
+		return "remote", "", nil
+	}
+
+	text := conflictMarkers(c)
+	for {
+		merged, err := editText("Edit the merged value and remove the conflict markers", text)
+		if err != nil {
+			return "", "", err
+		}
+		merged = strings.TrimSpace(merged)
+		if !strings.Contains(merged, "<<<<<<<") && !strings.Contains(merged, ">>>>>>>") {
+			return "merge", merged, nil
+		}
+		fmt.Println("⚠️  The result still contains conflict markers.")
+		text = merged
+	}
+}
+
+// conflictMarkers formats a conflict the way git does with diff3 markers
+func conflictMarkers(c fieldChange) string {
+	var b strings.Builder
+	b.WriteString("<<<<<<< local (CSV)\n")
+	b.WriteString(c.To + "\n")
+	b.WriteString("||||||| last sync\n")
+	b.WriteString(c.Base + "\n")
+	b.WriteString("=======\n")
+	b.WriteString(c.From + "\n")
+	b.WriteString(">>>>>>> remote (Plane)\n")
+	return b.String()
+}
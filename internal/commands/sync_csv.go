@@ -0,0 +1,596 @@
+package commands
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var syncCSVCmd = &cobra.Command{
+	Use:   "sync-csv",
+	Short: "Sync work items with a CSV spreadsheet",
+	Long: `Compare a CSV file (the source of truth) with the work items in a project
+and create, update or close items so both stay in sync.
+
+Each CSV row is matched to a work item through its external ID. The column
+holding the external ID is selected with --key. Recognised columns are:
+  name (or title), description, state, priority, start_date, target_date
+
+Empty cells are ignored, so a sparse spreadsheet never clears fields in Plane.
+Work items that were created from this CSV (same --source) but no longer have
+a row are moved to the close state.
+
+The values of every successful sync are remembered. On the next run a field
+edited only in Plane is kept, and a field edited both in the CSV and in Plane
+is a conflict: it is resolved interactively (keep local, keep remote or merge
+in your editor), or with --prefer local|remote.
+
+A diff report is always printed before any change is made.
+
+Examples:
+  # Preview what would change
+  plane-cli sync-csv --project <project-id> --file roadmap.csv --key external_id --dry-run
+
+  # Apply the changes without prompting
+  plane-cli sync-csv --project <project-id> --file roadmap.csv --key external_id --yes
+
+  # Close removed rows as "Cancelled" instead of the first completed state
+  plane-cli sync-csv --project <project-id> --file roadmap.csv --close-state Cancelled
+
+  # Unattended sync where the spreadsheet wins conflicts
+  plane-cli sync-csv --project <project-id> --file roadmap.csv --yes --prefer local
+
+  # Translate Jira statuses and priorities with a mapping profile
+  plane-cli sync-csv --project <project-id> --file jira-export.csv --mapping jira`,
+	RunE: runSyncCSV,
+}
+
+func init() {
+	rootCmd.AddCommand(syncCSVCmd)
+
+	syncCSVCmd.Flags().String("project", "", "Project identifier (required unless defaults.project is set)")
+	syncCSVCmd.Flags().String("file", "", "CSV file to sync from (required)")
+	syncCSVCmd.Flags().String("key", "external_id", "CSV column holding the external ID of each row")
+	syncCSVCmd.Flags().String("source", "csv", "External source name stored on created work items")
+	syncCSVCmd.Flags().String("close-state", "", "State used to close removed rows (default: first completed state)")
+	syncCSVCmd.Flags().Bool("no-close", false, "Do not close work items missing from the CSV")
+	syncCSVCmd.Flags().Bool("dry-run", false, "Only print the diff report")
+	syncCSVCmd.Flags().Bool("yes", false, "Apply changes without confirmation")
+	syncCSVCmd.Flags().String("prefer", "", "Resolve conflicts without prompting: local (CSV) or remote (Plane)")
+	syncCSVCmd.Flags().String("mapping", "", "Mapping profile translating source states and priorities")
+	syncCSVCmd.MarkFlagRequired("project")
+	syncCSVCmd.MarkFlagRequired("file")
+}
+
+// csvRow is a single row of the source spreadsheet
+type csvRow struct {
+	Line   int
+	Key    string
+	Fields map[string]string
+}
+
+// fieldChange describes a single field that differs between CSV and Plane.
+// A conflict is a field changed on both sides since the last sync; Base
+// holds the value both sides had then.
+type fieldChange struct {
+	Field    string
+	From     string
+	To       string
+	Base     string
+	Conflict bool
+}
+
+// syncAction is a planned change produced by the diff
+type syncAction struct {
+	Kind    string // create, update, close
+	Key     string
+	Row     *csvRow
+	Item    *plane.WorkItem
+	Changes []fieldChange
+}
+
+var syncActionPastTense = map[string]string{
+	"create": "Created",
+	"update": "Updated",
+	"close":  "Closed",
+}
+
+// csvColumnAliases maps accepted header spellings to canonical field names
+var csvColumnAliases = map[string]string{
+	"name":        "name",
+	"title":       "name",
+	"description": "description",
+	"state":       "state",
+	"status":      "state",
+	"priority":    "priority",
+	"start_date":  "start_date",
+	"start":       "start_date",
+	"target_date": "target_date",
+	"due_date":    "target_date",
+	"due":         "target_date",
+}
+
+// syncFields lists the fields compared by the sync, in report order
+var syncFields = []string{"name", "description", "state", "priority", "start_date", "target_date"}
+
+func runSyncCSV(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	file, _ := cmd.Flags().GetString("file")
+	key, _ := cmd.Flags().GetString("key")
+	source, _ := cmd.Flags().GetString("source")
+	closeStateName, _ := cmd.Flags().GetString("close-state")
+	noClose, _ := cmd.Flags().GetBool("no-close")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+	prefer, _ := cmd.Flags().GetString("prefer")
+	mapping, _ := cmd.Flags().GetString("mapping")
+
+	if prefer != "" && prefer != "local" && prefer != "remote" {
+		return fmt.Errorf("unknown --prefer '%s' (use local or remote)", prefer)
+	}
+
+	rows, err := readSyncCSV(file, key)
+	if err != nil {
+		return err
+	}
+	if mapping != "" {
+		profile, err := loadMappingProfile(resolveMappingFile(mapping))
+		if err != nil {
+			return err
+		}
+		if err := profile.apply(rows); err != nil {
+			return err
+		}
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	states, err := client.GetProjectStates(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project states: %w", err)
+	}
+
+	fmt.Printf("📥 Fetching work items from project '%s'...\n", projectID)
+	items, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	base, err := loadSyncBase(projectID, source)
+	if err != nil {
+		return err
+	}
+
+	actions, unchanged := diffCSVAgainstPlane(rows, items, states, source, !noClose, base)
+	printSyncReport(actions, unchanged)
+
+	if len(actions) == 0 {
+		fmt.Println("\n✅ Plane is already in sync with the CSV.")
+		return saveSyncBase(projectID, source, rows, items, states, nil, nil)
+	}
+
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no changes made.")
+		return nil
+	}
+
+	if conflicts := countConflicts(actions); conflicts > 0 {
+		if prefer == "" && yes {
+			return fmt.Errorf("%d conflicting field(s): run without --yes to resolve them or pass --prefer local|remote", conflicts)
+		}
+		if actions, err = resolveSyncConflicts(actions, prefer); err != nil {
+			return err
+		}
+		if len(actions) == 0 {
+			fmt.Println("\n✅ Nothing left to apply after resolving conflicts.")
+			return saveSyncBase(projectID, source, rows, items, states, nil, nil)
+		}
+	}
+
+	if !yes {
+		var closing []plane.WorkItem
+		for _, a := range actions {
+			if a.Kind == "close" {
+				closing = append(closing, *a.Item)
+			}
+		}
+
+		var confirmed bool
+		if len(closing) > 0 {
+			action := fmt.Sprintf("Apply %d changes, closing %d work item(s) missing from the CSV.", len(actions), len(closing))
+			confirmed, err = confirmDestructive(gatherItemImpact(client, projectID, action, closing, items))
+		} else {
+			confirmed, err = confirm(fmt.Sprintf("\nApply %d changes?", len(actions)))
+		}
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			fmt.Println("\n❌ Sync cancelled.")
+			return nil
+		}
+	}
+
+	closeStateID := ""
+	for _, a := range actions {
+		if a.Kind == "close" {
+			closeStateID, err = findCloseState(states, closeStateName)
+			if err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	fmt.Printf("\n🔄 Applying %d changes...\n\n", len(actions))
+	successCount := 0
+	var applied []syncAction
+	for _, a := range actions {
+		if err := applySyncAction(client, projectID, a, states, source, closeStateID); err != nil {
+			fmt.Printf("  ❌ Failed to %s %s: %v\n", a.Kind, a.Key, err)
+			continue
+		}
+		fmt.Printf("  ✅ %s %s\n", syncActionPastTense[a.Kind], a.Key)
+		successCount++
+		applied = append(applied, a)
+	}
+
+	fmt.Printf("\n%s\n", strings.Repeat("-", 70))
+	fmt.Printf("✅ Completed: %d/%d changes applied\n", successCount, len(actions))
+	return saveSyncBase(projectID, source, rows, items, states, actions, applied)
+}
+
+// readSyncCSV parses the CSV file into rows keyed by the given column
+func readSyncCSV(path, keyColumn string) ([]*csvRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CSV file: %w", err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	keyColumn = normalizeCSVHeader(keyColumn)
+	keyIdx := -1
+	columns := make([]string, len(header))
+	for i, h := range header {
+		name := normalizeCSVHeader(h)
+		if name == keyColumn {
+			keyIdx = i
+			continue
+		}
+		columns[i] = csvColumnAliases[name]
+	}
+	if keyIdx < 0 {
+		return nil, fmt.Errorf("key column '%s' not found in CSV header", keyColumn)
+	}
+
+	var rows []*csvRow
+	seen := make(map[string]int)
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV line %d: %w", line, err)
+		}
+
+		key := strings.TrimSpace(record[keyIdx])
+		if key == "" {
+			continue
+		}
+		if prev, ok := seen[key]; ok {
+			return nil, fmt.Errorf("duplicate key '%s' on lines %d and %d", key, prev, line)
+		}
+		seen[key] = line
+
+		row := &csvRow{Line: line, Key: key, Fields: make(map[string]string)}
+		for i, value := range record {
+			if i >= len(columns) || columns[i] == "" {
+				continue
+			}
+			if value = strings.TrimSpace(value); value != "" {
+				row.Fields[columns[i]] = value
+			}
+		}
+		if row.Fields["name"] == "" {
+			return nil, fmt.Errorf("CSV line %d: name/title is required", line)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func normalizeCSVHeader(h string) string {
+	h = strings.ToLower(strings.TrimSpace(h))
+	h = strings.NewReplacer(" ", "_", "-", "_").Replace(h)
+	return h
+}
+
+// diffCSVAgainstPlane computes the changes needed to make Plane match the CSV.
+// With the values of the last sync in base, fields only edited in Plane are
+// kept and fields edited on both sides are marked as conflicts.
+func diffCSVAgainstPlane(rows []*csvRow, items []plane.WorkItem, states []plane.State, source string, closeMissing bool, base *syncBase) ([]syncAction, int) {
+	stateByID := make(map[string]plane.State, len(states))
+	for _, s := range states {
+		stateByID[s.ID] = s
+	}
+
+	remote := make(map[string]*plane.WorkItem)
+	for i := range items {
+		if items[i].ExternalID != "" {
+			remote[items[i].ExternalID] = &items[i]
+		}
+	}
+
+	var creates, updates, closes []syncAction
+	unchanged := 0
+	inCSV := make(map[string]bool, len(rows))
+
+	for _, row := range rows {
+		inCSV[row.Key] = true
+		item, ok := remote[row.Key]
+		if !ok {
+			creates = append(creates, syncAction{Kind: "create", Key: row.Key, Row: row})
+			continue
+		}
+
+		changes := compareRowWithItem(row, item, stateByID, base.Rows[row.Key])
+		if len(changes) == 0 {
+			unchanged++
+			continue
+		}
+		updates = append(updates, syncAction{Kind: "update", Key: row.Key, Row: row, Item: item, Changes: changes})
+	}
+
+	if closeMissing {
+		for key, item := range remote {
+			if inCSV[key] || item.ExternalSource != source {
+				continue
+			}
+			group := stateByID[itemStateID(item)].Group
+			if group == "completed" || group == "cancelled" {
+				continue
+			}
+			closes = append(closes, syncAction{Kind: "close", Key: key, Item: item})
+		}
+	}
+
+	actions := append(creates, updates...)
+	return append(actions, closes...), unchanged
+}
+
+func compareRowWithItem(row *csvRow, item *plane.WorkItem, stateByID map[string]plane.State, last *syncBaseRow) []fieldChange {
+	var changes []fieldChange
+
+	for _, field := range syncFields {
+		local, ok := csvFieldValue(row, field)
+		if !ok {
+			continue
+		}
+		remote := planeFieldValue(item, field, stateByID)
+		if syncValuesEqual(field, local, remote) {
+			continue
+		}
+
+		change := fieldChange{Field: field, From: remote, To: local}
+		if last != nil {
+			baseLocal, okLocal := last.Local[field]
+			baseRemote, okRemote := last.Remote[field]
+			if okLocal && okRemote {
+				localChanged := !syncValuesEqual(field, local, baseLocal)
+				remoteChanged := !syncValuesEqual(field, remote, baseRemote)
+				if !localChanged {
+					// Edited in Plane only, or a conflict resolved earlier in favour of Plane
+					continue
+				}
+				if remoteChanged {
+					change.Base = baseRemote
+					change.Conflict = true
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// csvFieldValue returns the value a row holds for a field in the form used
+// by planeFieldValue; ok is false for empty cells
+func csvFieldValue(row *csvRow, field string) (string, bool) {
+	value, ok := row.Fields[field]
+	if !ok {
+		return "", false
+	}
+	switch field {
+	case "priority":
+		value = plane.ParsePriorityString(value)
+	case "description":
+		value = normalizeDescription(value)
+	}
+	return value, true
+}
+
+// planeFieldValue returns the comparable value of a work item field
+func planeFieldValue(item *plane.WorkItem, field string, stateByID map[string]plane.State) string {
+	switch field {
+	case "name":
+		return item.Name
+	case "description":
+		return descriptionText(item.DescriptionHTML)
+	case "state":
+		return stateByID[itemStateID(item)].Name
+	case "priority":
+		return item.Priority
+	case "start_date":
+		return dateValue(item.StartDate)
+	case "target_date":
+		return dateValue(item.TargetDate)
+	}
+	return ""
+}
+
+// syncDisplayValue shortens long values for the report
+func syncDisplayValue(field, value string) string {
+	if field == "description" {
+		return truncate(value, 30)
+	}
+	return value
+}
+
+// syncValuesEqual compares two values of a field; state names ignore case
+func syncValuesEqual(field, a, b string) bool {
+	if field == "state" {
+		return strings.EqualFold(a, b)
+	}
+	return a == b
+}
+
+// dateValue returns the YYYY-MM-DD part of an optional API date
+func dateValue(d *string) string {
+	if d == nil {
+		return ""
+	}
+	if len(*d) > 10 {
+		return (*d)[:10]
+	}
+	return *d
+}
+
+func printSyncReport(actions []syncAction, unchanged int) {
+	counts := map[string]int{}
+	for _, a := range actions {
+		counts[a.Kind]++
+	}
+	conflicts := countConflicts(actions)
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Println("                    📋 CSV SYNC REPORT")
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("Create: %d | Update: %d | Close: %d | Unchanged: %d\n\n",
+		counts["create"], counts["update"], counts["close"], unchanged)
+	if conflicts > 0 {
+		fmt.Printf("⚠️  %d field(s) changed both in the CSV and in Plane since the last sync\n\n", conflicts)
+	}
+
+	for _, a := range actions {
+		switch a.Kind {
+		case "create":
+			fmt.Printf("  + %-15s %s\n", a.Key, truncate(a.Row.Fields["name"], 50))
+		case "update":
+			fmt.Printf("  ~ %-15s [%d] %s\n", a.Key, a.Item.SequenceID, truncate(a.Item.Name, 45))
+			for _, c := range a.Changes {
+				marker := ""
+				if c.Conflict {
+					marker = "  ⚠️  conflict: also changed in Plane"
+				}
+				fmt.Printf("      %s: %s → %s%s\n", c.Field, emptyAsDash(syncDisplayValue(c.Field, c.From)), syncDisplayValue(c.Field, c.To), marker)
+			}
+		case "close":
+			fmt.Printf("  - %-15s [%d] %s\n", a.Key, a.Item.SequenceID, truncate(a.Item.Name, 45))
+		}
+	}
+	fmt.Println(strings.Repeat("=", 70))
+}
+
+// findCloseState resolves the state used to close work items removed from the CSV
+func findCloseState(states []plane.State, name string) (string, error) {
+	for _, s := range states {
+		if name != "" && strings.EqualFold(s.Name, name) {
+			return s.ID, nil
+		}
+		if name == "" && s.Group == "completed" {
+			return s.ID, nil
+		}
+	}
+	if name != "" {
+		return "", fmt.Errorf("close state '%s' not found", name)
+	}
+	return "", fmt.Errorf("project has no completed state, use --close-state")
+}
+
+func applySyncAction(client *plane.Client, projectID string, a syncAction, states []plane.State, source, closeStateID string) error {
+	switch a.Kind {
+	case "create":
+		create := &plane.WorkItemCreate{
+			Name:            a.Row.Fields["name"],
+			DescriptionHTML: markdownToHTML(a.Row.Fields["description"]),
+			StartDate:       a.Row.Fields["start_date"],
+			TargetDate:      a.Row.Fields["target_date"],
+			ExternalID:      a.Key,
+			ExternalSource:  source,
+		}
+		if p, ok := a.Row.Fields["priority"]; ok {
+			create.Priority = plane.ParsePriorityString(p)
+		}
+		if s, ok := a.Row.Fields["state"]; ok {
+			stateID, err := stateIDByName(states, s)
+			if err != nil {
+				return err
+			}
+			create.State = stateID
+		}
+		_, err := client.CreateWorkItem(projectID, create)
+		return err
+
+	case "update":
+		update := &plane.WorkItemUpdate{}
+		for _, c := range a.Changes {
+			switch c.Field {
+			case "name":
+				update.Name = c.To
+			case "description":
+				update.DescriptionHTML = markdownToHTML(c.To)
+			case "state":
+				stateID, err := stateIDByName(states, c.To)
+				if err != nil {
+					return err
+				}
+				update.State = stateID
+			case "priority":
+				update.Priority = c.To
+			case "start_date":
+				update.StartDate = c.To
+			case "target_date":
+				update.TargetDate = c.To
+			}
+		}
+		_, err := client.UpdateWorkItem(projectID, a.Item.ID, update)
+		return err
+
+	case "close":
+		_, err := client.UpdateWorkItem(projectID, a.Item.ID, &plane.WorkItemUpdate{State: closeStateID})
+		return err
+	}
+
+	return fmt.Errorf("unknown action %s", a.Kind)
+}
+
+// stateIDByName finds a state UUID by name (case-insensitive) in an already fetched list
+func stateIDByName(states []plane.State, name string) (string, error) {
+	for _, s := range states {
+		if strings.EqualFold(s.Name, name) {
+			return s.ID, nil
+		}
+	}
+	return "", fmt.Errorf("state '%s' not found", name)
+}
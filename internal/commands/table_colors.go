@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"plane-cli/internal/console"
+)
+
+// colorDot is the swatch shown before state and label names
+const colorDot = "●"
+
+// columnGap separates the columns of an aligned table header
+var columnGap = regexp.MustCompile(`\S  +`)
+
+// cellPainter colors the swatches in table cells once the table is aligned,
+// as tabwriter would count color codes as visible text. A nil painter, or
+// one made when the terminal shows no color, leaves tables alone.
+type cellPainter struct {
+	enabled bool
+	cells   map[int]map[int][]string
+}
+
+func newCellPainter() *cellPainter {
+	return &cellPainter{enabled: console.SupportsColor(), cells: make(map[int]map[int][]string)}
+}
+
+// swatch returns text prefixed with a swatch when colors are shown
+func (p *cellPainter) swatch(text string) string {
+	if p == nil || !p.enabled || text == "" {
+		return text
+	}
+	return colorDot + " " + text
+}
+
+// paint colors the swatches of a cell, in order, with hex colors. Rows are
+// counted from 0 after the header.
+func (p *cellPainter) paint(row, column int, colors ...string) {
+	if p == nil || !p.enabled {
+		return
+	}
+	if p.cells[row] == nil {
+		p.cells[row] = make(map[int][]string)
+	}
+	p.cells[row][column] = colors
+}
+
+// apply returns an aligned table, starting with its header line, with the
+// painted cells colored. Columns are found from the header, whose names
+// must not contain two spaces in a row.
+func (p *cellPainter) apply(table []byte) []byte {
+	if p == nil || len(p.cells) == 0 {
+		return table
+	}
+	lines := bytes.SplitAfter(table, []byte("\n"))
+	if len(lines) == 0 {
+		return table
+	}
+
+	// Rune offsets of the columns; the header is plain ASCII
+	starts := []int{0}
+	for _, m := range columnGap.FindAllIndex(bytes.TrimRight(lines[0], " \n"), -1) {
+		starts = append(starts, m[1])
+	}
+
+	var out bytes.Buffer
+	out.Write(lines[0])
+	for i, line := range lines[1:] {
+		cells, ok := p.cells[i]
+		if !ok {
+			out.Write(line)
+			continue
+		}
+		// Right to left, so the escape codes don't move the columns still
+		// to paint
+		runes := []rune(string(line))
+		for column := len(starts) - 1; column >= 0; column-- {
+			colors, ok := cells[column]
+			start := starts[column]
+			if !ok || start >= len(runes) {
+				continue
+			}
+			end := len(runes)
+			if column+1 < len(starts) && starts[column+1] < end {
+				end = starts[column+1]
+			}
+			painted := []rune(paintDots(string(runes[start:end]), colors))
+			runes = append(append(append([]rune{}, runes[:start]...), painted...), runes[end:]...)
+		}
+		out.WriteString(string(runes))
+	}
+	return out.Bytes()
+}
+
+// paintDots colors each swatch in a cell with the next of colors
+func paintDots(cell string, colors []string) string {
+	var b strings.Builder
+	for _, part := range strings.SplitAfter(cell, colorDot) {
+		if !strings.HasSuffix(part, colorDot) || len(colors) == 0 {
+			b.WriteString(part)
+			continue
+		}
+		color := console.Color(colors[0])
+		colors = colors[1:]
+		if color == "" {
+			b.WriteString(part)
+			continue
+		}
+		b.WriteString(strings.TrimSuffix(part, colorDot) + color + colorDot + console.ColorReset)
+	}
+	return b.String()
+}
+
+// coloredName returns a name after a swatch in a hex color, for free text
+// output, or the name alone when the terminal shows no color
+func coloredName(name, hex string) string {
+	color := console.Color(hex)
+	if name == "" || color == "" || !console.SupportsColor() {
+		return name
+	}
+	return color + colorDot + console.ColorReset + " " + name
+}
+
+// coloredNames joins names, each after a swatch in its color
+func coloredNames(names, colors []string) string {
+	parts := make([]string, len(names))
+	for i, name := range names {
+		hex := ""
+		if i < len(colors) {
+			hex = colors[i]
+		}
+		parts[i] = coloredName(name, hex)
+	}
+	return strings.Join(parts, ", ")
+}
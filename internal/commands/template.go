@@ -1,13 +1,14 @@
 package commands
 
 import (
-	"bufio"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"plane-cli/internal/config"
+	"plane-cli/internal/console"
 	"plane-cli/internal/templates"
 )
 
@@ -37,7 +38,11 @@ Examples:
   plane-cli template create my-template
 
   # Delete template
-  plane-cli template delete my-template`,
+  plane-cli template delete my-template
+
+  # Share a template set
+  plane-cli template export team.tar.gz --all
+  plane-cli template import team.tar.gz --on-conflict rename`,
 }
 
 var templateListCmd = &cobra.Command{
@@ -58,8 +63,17 @@ var templateCreateCmd = &cobra.Command{
 	Short: "Create a new template",
 	Long: `Create a new template interactively or with flags.
 
+Templates are Go templates: {{.name}} inserts a variable, {{range .steps}}
+... {{.}} ... {{end}} repeats for every item of a list variable and
+{{.env.os}} reads a field of a JSON object variable. Templates written in
+the mustache style of earlier versions ({{name}}, {{#steps}}...{{/steps}})
+are converted when loaded.
+
 Example:
-  plane-cli template create my-feature`,
+  plane-cli template create my-feature \
+    --content '## Checklist
+{{range .checklist}}- [ ] {{.}}
+{{end}}' --vars checklist`,
 	Args: cobra.ExactArgs(1),
 	RunE: runTemplateCreate,
 }
@@ -71,17 +85,66 @@ var templateDeleteCmd = &cobra.Command{
 	RunE:  runTemplateDelete,
 }
 
+var templateExportCmd = &cobra.Command{
+	Use:   "export <bundle.tar.gz> [name...]",
+	Short: "Export templates to a bundle",
+	Long: `Write templates to a gzipped tar bundle that can be shared and loaded
+with 'template import'. Name the templates to export, or use --all.
+
+Examples:
+  plane-cli template export team.tar.gz --all
+  plane-cli template export bugs.tar.gz bug regression`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runTemplateExport,
+}
+
+var templateImportCmd = &cobra.Command{
+	Use:   "import <bundle.tar.gz>",
+	Short: "Import templates from a bundle",
+	Long: `Add the templates of a bundle made with 'template export' to the
+templates directory. The whole bundle is checked first; nothing is imported
+from a damaged one.
+
+A template with the name of an existing, different template is a conflict,
+handled with --on-conflict:
+  skip       keep the existing template
+  overwrite  replace it with the bundle's
+  rename     import the bundle's as <name>-2 (or the next free number)
+Without --on-conflict you are asked for each conflict in a terminal, and
+conflicts are skipped otherwise. Identical templates are left alone.
+
+Examples:
+  plane-cli template import team.tar.gz --dry-run
+  plane-cli template import team.tar.gz --on-conflict overwrite`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTemplateImport,
+}
+
+// Conflict handling of template import
+const (
+	conflictSkip      = "skip"
+	conflictOverwrite = "overwrite"
+	conflictRename    = "rename"
+)
+
 func init() {
 	rootCmd.AddCommand(templateCmd)
 	templateCmd.AddCommand(templateListCmd)
 	templateCmd.AddCommand(templateShowCmd)
 	templateCmd.AddCommand(templateCreateCmd)
 	templateCmd.AddCommand(templateDeleteCmd)
+	templateCmd.AddCommand(templateExportCmd)
+	templateCmd.AddCommand(templateImportCmd)
 
 	// Create flags
 	templateCreateCmd.Flags().String("description", "", "Template description")
 	templateCreateCmd.Flags().String("content", "", "Template content")
 	templateCreateCmd.Flags().StringSlice("vars", nil, "Template variables")
+
+	// Export and import flags
+	templateExportCmd.Flags().Bool("all", false, "Export every template")
+	templateImportCmd.Flags().String("on-conflict", "", "What to do with templates that already exist: skip, overwrite or rename")
+	templateImportCmd.Flags().Bool("dry-run", false, "Show what would be imported without writing anything")
 }
 
 func runTemplateList(cmd *cobra.Command, args []string) error {
@@ -126,6 +189,9 @@ func runTemplateShow(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Template: %s\n", tmpl.Name)
 	fmt.Printf("Description: %s\n", tmpl.Description)
 	fmt.Printf("Variables: %v\n", tmpl.Variables)
+	if lists := tmpl.ListVariables(); len(lists) > 0 {
+		fmt.Printf("Lists: %v\n", lists)
+	}
 	fmt.Printf("\nContent:\n%s\n", tmpl.Content)
 
 	return nil
@@ -150,8 +216,10 @@ func runTemplateCreate(cmd *cobra.Command, args []string) error {
 
 	// Interactive mode if content not provided
 	if content == "" {
-		fmt.Println("Enter template content (press Ctrl+D when done):")
-		content = readMultiLineInput()
+		fmt.Printf("Enter template content (press %s when done):\n", console.EOFHint())
+		if content, err = console.ReadLines(os.Stdin); err != nil {
+			return fmt.Errorf("failed to read template content: %w", err)
+		}
 	}
 
 	if content == "" {
@@ -198,11 +266,163 @@ func runTemplateDelete(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func readMultiLineInput() string {
-	var lines []string
-	scanner := bufio.NewScanner(os.Stdin)
-	for scanner.Scan() {
-		lines = append(lines, scanner.Text())
+func runTemplateExport(cmd *cobra.Command, args []string) error {
+	bundle, names := args[0], args[1:]
+	all, _ := cmd.Flags().GetBool("all")
+	if all == (len(names) > 0) {
+		return fmt.Errorf("name the templates to export or use --all")
+	}
+
+	mgr, err := templates.NewManager(getTemplatesDir())
+	if err != nil {
+		return fmt.Errorf("failed to initialize template manager: %w", err)
+	}
+	if all {
+		names = mgr.List()
+		sort.Strings(names)
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no templates to export in %s", getTemplatesDir())
+	}
+
+	var tmpls []*templates.Template
+	for _, name := range names {
+		tmpl, err := mgr.Get(name)
+		if err != nil {
+			return err
+		}
+		tmpls = append(tmpls, tmpl)
+	}
+
+	f, err := os.Create(bundle)
+	if err != nil {
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+	if err := templates.WriteBundle(f, tmpls); err != nil {
+		f.Close()
+		os.Remove(bundle)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	fmt.Printf("✓ Exported %d template(s) to %s: %s\n", len(tmpls), bundle, strings.Join(names, ", "))
+	return nil
+}
+
+func runTemplateImport(cmd *cobra.Command, args []string) error {
+	onConflict, _ := cmd.Flags().GetString("on-conflict")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	switch onConflict {
+	case "", conflictSkip, conflictOverwrite, conflictRename:
+	default:
+		return fmt.Errorf("invalid --on-conflict '%s' (use skip, overwrite or rename)", onConflict)
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to open bundle: %w", err)
+	}
+	defer f.Close()
+	tmpls, err := templates.ReadBundle(f)
+	if err != nil {
+		return err
+	}
+	if len(tmpls) == 0 {
+		return fmt.Errorf("no templates in %s", args[0])
+	}
+
+	mgr, err := templates.NewManager(getTemplatesDir())
+	if err != nil {
+		return fmt.Errorf("failed to initialize template manager: %w", err)
+	}
+
+	fmt.Printf("📦 %d template(s) in %s\n\n", len(tmpls), args[0])
+	var added, replaced, renamed, skipped int
+	for _, tmpl := range tmpls {
+		existing, err := mgr.Get(tmpl.Name)
+		if err != nil {
+			fmt.Printf("  ✓ %s: new\n", tmpl.Name)
+			added++
+			if !dryRun {
+				if err := mgr.Save(tmpl); err != nil {
+					return fmt.Errorf("failed to save template %s: %w", tmpl.Name, err)
+				}
+			}
+			continue
+		}
+		if sameTemplate(existing, tmpl) {
+			fmt.Printf("  = %s: unchanged\n", tmpl.Name)
+			continue
+		}
+
+		action := onConflict
+		if action == "" {
+			action = conflictSkip
+			if !dryRun && console.IsTerminal(os.Stdin) {
+				if action, err = askTemplateConflict(tmpl.Name); err != nil {
+					return err
+				}
+			}
+		}
+
+		switch action {
+		case conflictOverwrite:
+			fmt.Printf("  ↻ %s: overwritten\n", tmpl.Name)
+			replaced++
+		case conflictRename:
+			original := tmpl.Name
+			tmpl.Name = freeTemplateName(mgr, original)
+			fmt.Printf("  + %s: imported as %s\n", original, tmpl.Name)
+			renamed++
+		default:
+			fmt.Printf("  - %s: exists, skipped\n", tmpl.Name)
+			skipped++
+			continue
+		}
+		if !dryRun {
+			if err := mgr.Save(tmpl); err != nil {
+				return fmt.Errorf("failed to save template %s: %w", tmpl.Name, err)
+			}
+		}
+	}
+
+	if dryRun {
+		fmt.Println("\n📝 Dry run mode - no templates written.")
+		return nil
+	}
+	fmt.Printf("\n✓ Imported %d new, %d overwritten, %d renamed; %d skipped\n", added, replaced, renamed, skipped)
+	return nil
+}
+
+// askTemplateConflict asks what to do with a template that already exists
+func askTemplateConflict(name string) (string, error) {
+	options := []string{
+		"Skip - keep the existing template",
+		"Overwrite - replace it with the bundle's",
+		"Rename - import the bundle's under a new name",
+	}
+	i, err := selectOption(fmt.Sprintf("Template '%s' already exists and differs:", name), options)
+	if err != nil {
+		return "", err
+	}
+	return []string{conflictSkip, conflictOverwrite, conflictRename}[i], nil
+}
+
+// sameTemplate reports whether two templates have the same description,
+// content and variables
+func sameTemplate(a, b *templates.Template) bool {
+	return a.Description == b.Description && a.Content == b.Content &&
+		strings.Join(a.Variables, "\x00") == strings.Join(b.Variables, "\x00")
+}
+
+// freeTemplateName returns name-2, name-3, ... whichever is not taken
+func freeTemplateName(mgr *templates.Manager, name string) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d", name, n)
+		if _, err := mgr.Get(candidate); err != nil {
+			return candidate
+		}
 	}
-	return strings.Join(lines, "\n")
 }
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"plane-cli/internal/plane"
+)
+
+// itemTimings holds the SLA-style durations computed for a work item
+type itemTimings struct {
+	Age         time.Duration
+	SinceUpdate time.Duration
+	InState     time.Duration
+	// DueInDays is the number of days until the target date, negative when
+	// overdue. It is nil when the item has no target date.
+	DueInDays *int
+}
+
+// computeTimings derives the timings of a work item. The time in the current
+// state comes from the most recent state change in activities, or from the
+// creation date if the state never changed.
+func computeTimings(item *plane.WorkItem, activities []plane.Activity, now time.Time) itemTimings {
+	t := itemTimings{}
+	if !item.CreatedAt.IsZero() {
+		t.Age = now.Sub(item.CreatedAt)
+		t.InState = t.Age
+	}
+	if !item.UpdatedAt.IsZero() {
+		t.SinceUpdate = now.Sub(item.UpdatedAt)
+	}
+
+	for i := len(activities) - 1; i >= 0; i-- {
+		if activities[i].Field == "state" {
+			t.InState = now.Sub(activities[i].CreatedAt)
+			break
+		}
+	}
+
+	if due := dateValue(item.TargetDate); due != "" {
+		if d, err := time.ParseInLocation("2006-01-02", due, time.Local); err == nil {
+			today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+			days := int(math.Round(d.Sub(today).Hours() / 24))
+			t.DueInDays = &days
+		}
+	}
+
+	return t
+}
+
+// fetchItemTimings computes timings for each work item, fetching its
+// activity history. Items whose history can't be fetched fall back to their
+// creation date for the time in state.
+func fetchItemTimings(client *plane.Client, projectID string, items []plane.WorkItem) map[string]itemTimings {
+	now := time.Now()
+	timings := make(map[string]itemTimings, len(items))
+	for i := range items {
+		activities, err := client.GetWorkItemActivities(projectID, items[i].ID)
+		if err != nil {
+			activities = nil
+		}
+		timings[items[i].ID] = computeTimings(&items[i], activities, now)
+	}
+	return timings
+}
+
+// formatAge renders a duration in its two largest units, e.g. "3d 4h"
+func formatAge(d time.Duration) string {
+	if d <= 0 {
+		return "-"
+	}
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case days > 0 && hours > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case days > 0:
+		return fmt.Sprintf("%dd", days)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", max(minutes, 1))
+	}
+}
+
+// formatDue renders days until the target date, e.g. "in 3d" or "2d overdue"
+func formatDue(days *int) string {
+	switch {
+	case days == nil:
+		return "-"
+	case *days == 0:
+		return "today"
+	case *days > 0:
+		return fmt.Sprintf("in %dd", *days)
+	default:
+		return fmt.Sprintf("%dd overdue", -*days)
+	}
+}
@@ -0,0 +1,183 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var treeCmd = &cobra.Command{
+	Use:   "tree <PROJ-123>",
+	Short: "Show and change the sub-item hierarchy of a work item",
+	Long: `Show where a work item sits in the parent/child hierarchy: the chain of
+parents above it and all of its sub-items below it.
+
+Use --set-parent to move the item under another work item of the same
+project, or --detach to make it a top-level item again. Moves that would
+make an item its own ancestor are refused.
+
+Examples:
+  plane-cli tree PROJ-123
+  plane-cli tree PROJ-123 --depth 2
+  plane-cli tree PROJ-123 --set-parent PROJ-100
+  plane-cli tree PROJ-123 --detach`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTree,
+}
+
+func init() {
+	rootCmd.AddCommand(treeCmd)
+
+	treeCmd.Flags().Int("depth", 0, "Maximum depth of sub-items to show (0 = all)")
+	treeCmd.Flags().String("set-parent", "", "Move the work item under this work item, e.g. PROJ-100")
+	treeCmd.Flags().Bool("detach", false, "Remove the work item from its parent")
+}
+
+func runTree(cmd *cobra.Command, args []string) error {
+	identifier := strings.ToUpper(args[0])
+	depth, _ := cmd.Flags().GetInt("depth")
+	setParent, _ := cmd.Flags().GetString("set-parent")
+	detach, _ := cmd.Flags().GetBool("detach")
+
+	if setParent != "" && detach {
+		return fmt.Errorf("use either --set-parent or --detach, not both")
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	item, projectID, err := itemByIdentifier(client, identifier)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case setParent != "":
+		parent, parentProjectID, err := itemByIdentifier(client, setParent)
+		if err != nil {
+			return err
+		}
+		if parentProjectID != projectID {
+			return fmt.Errorf("%s is in another project; sub-items must share their parent's project", strings.ToUpper(setParent))
+		}
+		ancestors, err := workItemAncestors(client, projectID, parent)
+		if err != nil {
+			return err
+		}
+		if parent.ID == item.ID || containsWorkItem(ancestors, item.ID) {
+			return fmt.Errorf("%s is %s itself or one of its sub-items", strings.ToUpper(setParent), identifier)
+		}
+
+		if item, err = client.SetWorkItemParent(projectID, item.ID, parent.ID); err != nil {
+			return err
+		}
+		fmt.Printf("✅ %s moved under %s\n", identifier, strings.ToUpper(setParent))
+
+	case detach:
+		if item.ParentID == "" {
+			fmt.Printf("%s has no parent.\n", identifier)
+		} else {
+			if item, err = client.SetWorkItemParent(projectID, item.ID, ""); err != nil {
+				return err
+			}
+			fmt.Printf("✅ %s detached from its parent\n", identifier)
+		}
+	}
+
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+	ancestors, err := workItemAncestors(client, projectID, item)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	prefix := ""
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		fmt.Println(prefix + treeLine(lookup, &ancestors[i]))
+		prefix = strings.Repeat("    ", len(ancestors)-i-1) + "└── "
+	}
+	fmt.Println(prefix + treeLine(lookup, item) + "  ◀")
+
+	indent := strings.Repeat("    ", len(ancestors))
+	visited := map[string]bool{item.ID: true}
+	count, err := printSubTree(client, lookup, projectID, item.ID, indent, depth, 1, visited)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d parent(s), %d sub-item(s)\n", len(ancestors), count)
+	return nil
+}
+
+// workItemAncestors returns the parents of item, nearest first
+func workItemAncestors(client *plane.Client, projectID string, item *plane.WorkItem) ([]plane.WorkItem, error) {
+	var ancestors []plane.WorkItem
+	seen := map[string]bool{item.ID: true}
+	for parentID := item.ParentID; parentID != "" && !seen[parentID]; {
+		parent, err := client.GetWorkItem(projectID, parentID)
+		if err != nil {
+			return nil, err
+		}
+		seen[parentID] = true
+		ancestors = append(ancestors, *parent)
+		parentID = parent.ParentID
+	}
+	return ancestors, nil
+}
+
+func containsWorkItem(items []plane.WorkItem, id string) bool {
+	for _, item := range items {
+		if item.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// printSubTree prints the sub-items of parentID below indent and returns
+// how many were printed
+func printSubTree(client *plane.Client, lookup *itemLookup, projectID, parentID, indent string, maxDepth, depth int, visited map[string]bool) (int, error) {
+	if maxDepth > 0 && depth > maxDepth {
+		return 0, nil
+	}
+
+	children, err := client.GetWorkItemChildren(projectID, parentID)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for i := range children {
+		child := &children[i]
+		if visited[child.ID] {
+			continue
+		}
+		visited[child.ID] = true
+
+		branch, next := "├── ", "│   "
+		if i == len(children)-1 {
+			branch, next = "└── ", "    "
+		}
+		fmt.Println(indent + branch + treeLine(lookup, child))
+		count++
+
+		n, err := printSubTree(client, lookup, projectID, child.ID, indent+next, maxDepth, depth+1, visited)
+		if err != nil {
+			return count, err
+		}
+		count += n
+	}
+	return count, nil
+}
+
+func treeLine(lookup *itemLookup, item *plane.WorkItem) string {
+	v := lookup.view(item)
+	return fmt.Sprintf("%s %s [%s]", v.Key, v.Name, emptyAsDash(v.State))
+}
@@ -10,12 +10,13 @@ import (
 	"github.com/spf13/cobra"
 	"plane-cli/internal/config"
 	"plane-cli/internal/fuzzy"
+	"plane-cli/internal/markdown"
 	"plane-cli/internal/plane"
 	"plane-cli/internal/templates"
 )
 
 var updateCmd = &cobra.Command{
-	Use:   "update",
+	Use:   "update [PROJ-123]",
 	Short: "Update existing work items",
 	Long: `Update work items by ID or fuzzy title matching.
 
@@ -30,7 +31,13 @@ Examples:
   plane-cli update --title-fuzzy "api" --interactive
 
   # Bulk update with auto-apply
-  plane-cli update --title-fuzzy "bug" --template bug --auto`,
+  plane-cli update --title-fuzzy "bug" --template bug --auto
+
+  # Patch exactly the given fields, including ones without a flag
+  plane-cli update PROJ-123 --json '{"target_date":"2024-09-01","priority":"high"}'
+  plane-cli update PROJ-123 --json @patch.json
+  plane-cli update PROJ-123 --json '{"parent":null}'`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runUpdate,
 }
 
@@ -50,23 +57,39 @@ func init() {
 	updateCmd.Flags().StringToString("vars", nil, "Template variables")
 	updateCmd.Flags().String("state", "", "New state")
 	updateCmd.Flags().String("priority", "", "New priority (urgent, high, medium, low)")
-	updateCmd.Flags().StringSlice("assignees", nil, "Assignee user IDs")
-	updateCmd.Flags().StringSlice("labels", nil, "Label IDs")
+	updateCmd.Flags().StringSlice("assignees", nil, "Assignees: member IDs, emails, names or me")
+	updateCmd.Flags().StringSlice("labels", nil, "Labels: IDs or names")
+	updateCmd.Flags().Bool("create-labels", false, "Create labels given by name that don't exist yet")
 	updateCmd.Flags().String("start-date", "", "Start date (YYYY-MM-DD)")
 	updateCmd.Flags().String("target-date", "", "Target date (YYYY-MM-DD)")
 	updateCmd.Flags().Float64("estimate", 0, "Estimate points")
-	updateCmd.Flags().String("module", "", "Module ID")
-	updateCmd.Flags().String("cycle", "", "Cycle ID")
+	updateCmd.Flags().String("module", "", "Module ID or name")
+	updateCmd.Flags().String("cycle", "", "Cycle ID or name")
 	updateCmd.Flags().String("parent", "", "Parent work item ID")
+	updateCmd.Flags().Bool("externalize-images", false, "Upload images embedded as data URIs as assets and link them instead")
+	updateCmd.Flags().String("json", "", "PATCH exactly these fields: a JSON object, @file or @- for stdin")
 
 	// Behavior flags
 	updateCmd.Flags().Bool("interactive", false, "Interactive mode for selecting matches")
 	updateCmd.Flags().Bool("auto", false, "Auto-apply to all matches")
 	updateCmd.Flags().Bool("dry-run", false, "Preview changes without applying")
 	updateCmd.Flags().Int("min-score", 60, "Minimum fuzzy match score (0-100)")
+	addQueueFlag(updateCmd, func(cmd *cobra.Command, args []string) []string {
+		if id, _ := cmd.Flags().GetString("id"); id != "" {
+			return []string{id}
+		}
+		return args
+	})
 }
 
 func runUpdate(cmd *cobra.Command, args []string) error {
+	if patch, _ := cmd.Flags().GetString("json"); patch != "" {
+		return runUpdateJSON(cmd, args, patch)
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("a work item argument is only supported with --json; use --id")
+	}
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
@@ -105,7 +128,7 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 	auto, _ := cmd.Flags().GetBool("auto")
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
 	minScore, _ := cmd.Flags().GetInt("min-score")
-	workspace, _ := cmd.Flags().GetString("workspace")
+	externalize, _ := cmd.Flags().GetBool("externalize-images")
 
 	// Validate input
 	if id == "" && titleFuzzy == "" {
@@ -115,14 +138,7 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("--project is required when using --title-fuzzy")
 	}
 
-	// Get workspace - priority: flag > env > extract from URL
-	if workspace == "" {
-		if cfg.PlaneWorkspace != "" {
-			workspace = cfg.PlaneWorkspace
-		} else {
-			workspace = extractWorkspaceFromURL(cfg.PlaneBaseURL)
-		}
-	}
+	workspace := resolveWorkspace(cmd, cfg)
 
 	// Initialize template manager
 	var tmplManager *templates.Manager
@@ -143,23 +159,44 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create Plane client
-	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken)
+	client, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 	client.SetWorkspace(workspace)
 
+	// Assignees may be given by email or name
+	if assignees, err = newMemberResolver(client, project).resolve(assignees); err != nil {
+		return err
+	}
+	// Labels may be given by name
+	createLabels, _ := cmd.Flags().GetBool("create-labels")
+	if labels, err = newLabelResolver(client, project, createLabels, dryRun).resolve(labels); err != nil {
+		return err
+	}
+
+	// Module and cycle may be given by name
+	if module, err = resolveModule(client, project, module); err != nil {
+		return err
+	}
+	if cycle, err = resolveCycle(client, project, cycle); err != nil {
+		return err
+	}
+
+	if description, err = fitContent(client, cfg, project, "description", description, externalize); err != nil {
+		return err
+	}
+
 	// Build update payload
 	update := &plane.WorkItemUpdate{}
 	if newTitle != "" {
 		update.Name = newTitle
 	}
 	if description != "" {
-		// Send description as description_html
-		update.DescriptionHTML = description
+		update.DescriptionHTML = markdownToHTML(description)
 	}
-	if state != "" {
-		update.State = state
+	if update.State, err = resolveState(client, project, state); err != nil {
+		return err
 	}
 	if priorityStr != "" {
 		update.Priority = priorityStr
@@ -207,7 +244,7 @@ func updateByID(client *plane.Client, project, id string, update *plane.WorkItem
 	}
 
 	if dryRun {
-		fmt.Printf("DRY RUN - Would update work item %s-\n", project, id)
+		fmt.Printf("DRY RUN - Would update work item %s\n", id)
 		fmt.Printf("  Title: %s\n", workItem.Name)
 		printUpdateDetails(update)
 		return nil
@@ -294,33 +331,7 @@ func updateByFuzzyTitle(client *plane.Client, project, pattern string, update *p
 }
 
 func fetchAllWorkItems(client *plane.Client, project string) ([]plane.WorkItem, error) {
-	var allItems []plane.WorkItem
-	offset := 0
-	limit := 100
-
-	for {
-		options := map[string]string{
-			"offset": fmt.Sprintf("%d", offset),
-			"limit":  fmt.Sprintf("%d", limit),
-		}
-
-		response, err := client.GetWorkItems(project, options)
-		if err != nil {
-			return nil, err
-		}
-
-		allItems = append(allItems, response.Results...)
-
-		// Check if there are more results using cursor-based pagination
-		if !response.NextPageResults || response.NextCursor == nil {
-			break
-		}
-		// For now, just break to avoid infinite loop with cursor pagination
-		// TODO: Implement cursor-based pagination properly
-		break
-	}
-
-	return allItems, nil
+	return fetchPages(client.WorkItemsPager(project, nil), nil)
 }
 
 func updateInteractive(client *plane.Client, project string, items []*plane.WorkItem, update *plane.WorkItemUpdate) error {
@@ -395,7 +406,7 @@ func parseSelection(input string, max int) []int {
 }
 
 func printDryRun(items []*plane.WorkItem, update *plane.WorkItemUpdate, matcher *fuzzy.Matcher) {
-	fmt.Println("DRY RUN - No changes will be made\n")
+	fmt.Print("DRY RUN - No changes will be made\n\n")
 	for _, item := range items {
 		fmt.Printf("  [%s] %s\n", item.ID, item.Name)
 		printUpdateDetails(update)
@@ -425,12 +436,20 @@ func printUpdateDetails(update *plane.WorkItemUpdate) {
 	}
 }
 
-// markdownToHTML converts basic markdown to HTML
-func markdownToHTML(markdown string) string {
-	// For Plane, we can wrap markdown in a div and it will render properly
-	// Plane's editor handles markdown conversion internally
-	html := strings.TrimSpace(markdown)
+// markdownToHTML converts Markdown descriptions and page content into the
+// HTML Plane's editor stores; HTML is passed through
+func markdownToHTML(text string) string {
+	return markdown.ToHTML(text)
+}
+
+// descriptionText returns a stored description as trimmed Markdown, the form
+// local descriptions are compared in
+func descriptionText(html string) string {
+	return strings.TrimSpace(markdown.FromHTML(html))
+}
 
-	// Wrap the content in a div container
-	return "<div>" + html + "</div>"
+// normalizeDescription puts local Markdown in the form descriptionText gives
+// once Plane stores it, so unchanged descriptions compare equal
+func normalizeDescription(text string) string {
+	return descriptionText(markdownToHTML(strings.TrimSpace(text)))
 }
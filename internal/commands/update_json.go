@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// updateFieldFlags are the update flags that set a field; they can't be
+// combined with --json
+var updateFieldFlags = []string{"title", "description", "description-file", "template", "vars", "state", "priority",
+	"assignees", "labels", "start-date", "target-date", "estimate", "module", "cycle", "parent"}
+
+// runUpdateJSON PATCHes exactly the fields of a JSON object onto one work
+// item, identified by PROJ-123 or by --id and --project
+func runUpdateJSON(cmd *cobra.Command, args []string, patch string) error {
+	for _, name := range updateFieldFlags {
+		if cmd.Flags().Changed(name) {
+			return fmt.Errorf("--json can't be combined with --%s; put the field in the JSON instead", name)
+		}
+	}
+	if cmd.Flags().Changed("title-fuzzy") {
+		return fmt.Errorf("--json updates a single work item; use PROJ-123 or --id")
+	}
+
+	fields, err := readJSONPatch(patch)
+	if err != nil {
+		return err
+	}
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	var projectID, workItemID, label string
+	if len(args) == 1 {
+		item, pid, err := itemByIdentifier(client, args[0])
+		if err != nil {
+			return err
+		}
+		projectID, workItemID, label = pid, item.ID, strings.ToUpper(args[0])
+	} else {
+		id, _ := cmd.Flags().GetString("id")
+		projectID, _ = cmd.Flags().GetString("project")
+		if id == "" || projectID == "" {
+			return fmt.Errorf("give the work item as PROJ-123, or with --id and --project")
+		}
+		workItemID, label = id, id
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	if dryRun {
+		fmt.Printf("DRY RUN - Would patch work item %s\n", label)
+		for _, k := range keys {
+			value, _ := json.Marshal(fields[k])
+			fmt.Printf("  → %s: %s\n", k, truncate(string(value), 80))
+		}
+		return nil
+	}
+
+	updated, err := client.PatchWorkItem(projectID, workItemID, fields)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Updated work item: %s\n", label)
+	fmt.Printf("  Title: %s\n", updated.Name)
+	fmt.Printf("  Fields: %s\n", strings.Join(keys, ", "))
+	return nil
+}
+
+// readJSONPatch parses a --json value: a JSON object given inline, read
+// from a file with @file, or from standard input with @-
+func readJSONPatch(value string) (map[string]any, error) {
+	data := []byte(value)
+	if strings.HasPrefix(value, "@") {
+		var err error
+		if value == "@-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(value[1:])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JSON patch: %w", err)
+		}
+	}
+
+	var fields map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("invalid JSON patch (expected an object of fields): %w", err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("invalid JSON patch: more than one value")
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("JSON patch has no fields")
+	}
+	return fields, nil
+}
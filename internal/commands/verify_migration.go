@@ -0,0 +1,281 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var verifyMigrationCmd = &cobra.Command{
+	Use:   "verify-migration",
+	Short: "Compare work items between two projects after a migration",
+	Long: `Compare the work items of a source and a target project and report
+anything that did not survive a migration: missing or extra items and
+field values that differ.
+
+Items are paired with --key:
+  external_id  target external_id equals the source item ID or its external_id (default)
+  name         identical titles
+  sequence     identical sequence numbers
+
+States, labels and assignees are compared by name, so projects in different
+workspaces can be verified. The command exits with an error when anything
+differs, which makes it usable in scripts.
+
+Examples:
+  plane-cli verify-migration --source <project-id> --target <project-id>
+
+  # Projects in different workspaces, comparing only some fields
+  plane-cli verify-migration --source <id> --source-workspace old \
+    --target <id> --target-workspace new --fields name,state,labels`,
+	RunE: runVerifyMigration,
+}
+
+// migrationFields lists the fields compared by default, in display order
+var migrationFields = []string{"name", "state", "priority", "labels", "assignees", "start_date", "target_date", "description"}
+
+// migrationMismatch is a field whose value differs between paired items
+type migrationMismatch struct {
+	Key    string
+	Field  string
+	Source string
+	Target string
+}
+
+func init() {
+	rootCmd.AddCommand(verifyMigrationCmd)
+
+	verifyMigrationCmd.Flags().String("source", "", "Source project ID (required)")
+	verifyMigrationCmd.Flags().String("target", "", "Target project ID (required)")
+	verifyMigrationCmd.Flags().String("source-workspace", "", "Workspace of the source project (default: current)")
+	verifyMigrationCmd.Flags().String("target-workspace", "", "Workspace of the target project (default: current)")
+	verifyMigrationCmd.Flags().String("key", "external_id", "How items are paired: external_id, name or sequence")
+	verifyMigrationCmd.Flags().StringSlice("fields", migrationFields, "Fields to compare")
+	verifyMigrationCmd.Flags().Int("max-report", 50, "Maximum number of mismatches to list (0 = all)")
+	verifyMigrationCmd.MarkFlagRequired("source")
+	verifyMigrationCmd.MarkFlagRequired("target")
+}
+
+func runVerifyMigration(cmd *cobra.Command, args []string) error {
+	sourceID, _ := cmd.Flags().GetString("source")
+	targetID, _ := cmd.Flags().GetString("target")
+	sourceWorkspace, _ := cmd.Flags().GetString("source-workspace")
+	targetWorkspace, _ := cmd.Flags().GetString("target-workspace")
+	key, _ := cmd.Flags().GetString("key")
+	fields, _ := cmd.Flags().GetStringSlice("fields")
+	maxReport, _ := cmd.Flags().GetInt("max-report")
+
+	switch key {
+	case "external_id", "name", "sequence":
+	default:
+		return fmt.Errorf("unknown --key '%s' (use external_id, name or sequence)", key)
+	}
+	for _, f := range fields {
+		if !slices.Contains(migrationFields, f) {
+			return fmt.Errorf("unknown field '%s' (supported: %s)", f, strings.Join(migrationFields, ", "))
+		}
+	}
+
+	cfg, sourceClient, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	targetClient, err := plane.NewClient(cfg.PlaneBaseURL, cfg.PlaneAPIToken, clientOptions(cmd, cfg)...)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	targetClient.SetWorkspace(resolveWorkspace(cmd, cfg))
+	if sourceWorkspace != "" {
+		sourceClient.SetWorkspace(sourceWorkspace)
+	}
+	if targetWorkspace != "" {
+		targetClient.SetWorkspace(targetWorkspace)
+	}
+
+	fmt.Println("📥 Fetching source work items...")
+	sourceItems, sourceLookup, err := fetchMigrationSide(sourceClient, sourceID)
+	if err != nil {
+		return err
+	}
+	fmt.Println("📥 Fetching target work items...")
+	targetItems, targetLookup, err := fetchMigrationSide(targetClient, targetID)
+	if err != nil {
+		return err
+	}
+
+	// Index the target by key; duplicates are reported as they make pairing ambiguous
+	targetByKey := make(map[string]*plane.WorkItem)
+	duplicates := 0
+	for i := range targetItems {
+		k := migrationTargetKey(&targetItems[i], key)
+		if k == "" {
+			continue
+		}
+		if _, exists := targetByKey[k]; exists {
+			duplicates++
+			continue
+		}
+		targetByKey[k] = &targetItems[i]
+	}
+
+	var missing []string
+	var mismatches []migrationMismatch
+	paired := make(map[string]bool)
+	for i := range sourceItems {
+		src := &sourceItems[i]
+		var tgt *plane.WorkItem
+		for _, k := range migrationSourceKeys(src, key) {
+			if t, ok := targetByKey[k]; ok {
+				tgt = t
+				break
+			}
+		}
+
+		label := fmt.Sprintf("%s-%d", sourceLookup.projectIdentifier, src.SequenceID)
+		if tgt == nil {
+			missing = append(missing, fmt.Sprintf("%s %s", label, truncate(src.Name, 50)))
+			continue
+		}
+		paired[tgt.ID] = true
+
+		a, b := sourceLookup.view(src), targetLookup.view(tgt)
+		for _, f := range fields {
+			sv, tv := migrationFieldValue(a, f), migrationFieldValue(b, f)
+			if sv != tv {
+				mismatches = append(mismatches, migrationMismatch{Key: label, Field: f, Source: sv, Target: tv})
+			}
+		}
+	}
+
+	var extra []string
+	for i := range targetItems {
+		if !paired[targetItems[i].ID] {
+			extra = append(extra, fmt.Sprintf("%s-%d %s", targetLookup.projectIdentifier, targetItems[i].SequenceID, truncate(targetItems[i].Name, 50)))
+		}
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", 70))
+	fmt.Println("       🔎 Migration Verification")
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("Source:     %s (%d work items)\n", sourceLookup.projectName, len(sourceItems))
+	fmt.Printf("Target:     %s (%d work items)\n", targetLookup.projectName, len(targetItems))
+	fmt.Printf("Paired:     %d\n", len(paired))
+	fmt.Printf("Missing:    %d\n", len(missing))
+	fmt.Printf("Extra:      %d\n", len(extra))
+	fmt.Printf("Mismatches: %d field value(s)\n", len(mismatches))
+	if duplicates > 0 {
+		fmt.Printf("⚠️  %d target item(s) share a %s with another item and were not paired\n", duplicates, key)
+	}
+
+	printMigrationList("❌ Missing in target", missing, maxReport)
+	printMigrationList("➕ Only in target", extra, maxReport)
+
+	if len(mismatches) > 0 {
+		fmt.Printf("\n✏️  Field mismatches:\n")
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ITEM\tFIELD\tSOURCE\tTARGET")
+		for i, m := range mismatches {
+			if maxReport > 0 && i >= maxReport {
+				fmt.Fprintf(w, "...\t%d more\t\t\n", len(mismatches)-maxReport)
+				break
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", m.Key, m.Field, emptyAsDash(truncate(m.Source, 40)), emptyAsDash(truncate(m.Target, 40)))
+		}
+		w.Flush()
+	}
+
+	if len(missing) == 0 && len(extra) == 0 && len(mismatches) == 0 {
+		fmt.Println("\n✅ Migration verified - source and target match.")
+		return nil
+	}
+	return fmt.Errorf("migration verification found %d missing, %d extra and %d mismatched value(s)", len(missing), len(extra), len(mismatches))
+}
+
+func fetchMigrationSide(client *plane.Client, projectID string) ([]plane.WorkItem, *itemLookup, error) {
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, err := fetchAllWorkItemsForProject(client, projectID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch work items of %s: %w", projectID, err)
+	}
+	return items, lookup, nil
+}
+
+// migrationSourceKeys returns the keys a source item may appear under in the target
+func migrationSourceKeys(item *plane.WorkItem, key string) []string {
+	switch key {
+	case "name":
+		return []string{strings.TrimSpace(item.Name)}
+	case "sequence":
+		return []string{fmt.Sprintf("%d", item.SequenceID)}
+	}
+	keys := []string{item.ID}
+	if item.ExternalID != "" {
+		keys = append(keys, item.ExternalID)
+	}
+	return keys
+}
+
+func migrationTargetKey(item *plane.WorkItem, key string) string {
+	switch key {
+	case "name":
+		return strings.TrimSpace(item.Name)
+	case "sequence":
+		return fmt.Sprintf("%d", item.SequenceID)
+	}
+	return item.ExternalID
+}
+
+// migrationFieldValue returns a comparable representation of a field
+func migrationFieldValue(v workItemView, field string) string {
+	switch field {
+	case "name":
+		return strings.TrimSpace(v.Name)
+	case "state":
+		return v.State
+	case "priority":
+		if v.Priority == "" {
+			return "none"
+		}
+		return v.Priority
+	case "labels":
+		return sortedJoin(v.Labels)
+	case "assignees":
+		return sortedJoin(v.Assignees)
+	case "start_date":
+		return v.StartDate
+	case "target_date":
+		return v.TargetDate
+	case "description":
+		return strings.Join(strings.Fields(v.Description), " ")
+	}
+	return ""
+}
+
+func sortedJoin(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ", ")
+}
+
+func printMigrationList(title string, lines []string, limit int) {
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Printf("\n%s (%d):\n", title, len(lines))
+	for i, line := range lines {
+		if limit > 0 && i >= limit {
+			fmt.Printf("  ... %d more\n", len(lines)-limit)
+			break
+		}
+		fmt.Printf("  %s\n", line)
+	}
+}
@@ -0,0 +1,274 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+	"plane-cli/internal/console"
+	"plane-cli/internal/markdown"
+	"plane-cli/internal/plane"
+)
+
+var viewCmd = &cobra.Command{
+	Use:     "view <PROJ-123>",
+	Aliases: []string{"show"},
+	Short:   "Show a work item",
+	Long: `Show the fields and description of a single work item: state, priority,
+assignees, labels, module, cycle, dates, estimate and the rendered
+description. 'show' is an alias of 'view'.
+
+Use --offline to read the copy downloaded by 'plane-cli sync' instead of
+the API; timings are not available offline.
+
+Use --show-timings to add computed SLA timers: the age of the item, the time
+since its last update, the time spent in its current state (from the
+activity history) and the days until or past its target date.
+
+In a terminal the description is rendered: headings are bold, checklists
+show checkboxes, code blocks are boxed and links are underlined. Use
+--plain to print the Markdown as is; it is also printed as is when output
+is piped.
+
+Examples:
+  plane-cli view PROJ-123
+  plane-cli show PROJ-123
+  plane-cli view PROJ-123 --show-timings
+  plane-cli view PROJ-123 --plain
+  plane-cli view PROJ-123 --offline
+  plane-cli view PROJ-123 --template '{{.Key}} {{.State}} {{.Name}}'
+
+` + outputTemplateHelp,
+	Args: cobra.ExactArgs(1),
+	RunE: runView,
+}
+
+func init() {
+	rootCmd.AddCommand(viewCmd)
+
+	viewCmd.Flags().Bool("show-timings", false, "Show age, time since update, time in state and due date timers")
+	viewCmd.Flags().Bool("plain", false, "Print the description as plain Markdown instead of rendering it")
+	viewCmd.Flags().String("template", "", "Format the work item with a Go template")
+	viewCmd.Flags().Bool("offline", false, "Read the work item from the copy downloaded by 'plane-cli sync'")
+}
+
+func runView(cmd *cobra.Command, args []string) error {
+	identifier := strings.ToUpper(args[0])
+	showTimings, _ := cmd.Flags().GetBool("show-timings")
+	templateStr, _ := cmd.Flags().GetString("template")
+	plain, _ := cmd.Flags().GetBool("plain")
+	offlineMode, _ := cmd.Flags().GetBool("offline")
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+	if format != "" && templateStr != "" {
+		return fmt.Errorf("--template cannot be combined with --output %s", format)
+	}
+
+	var tmpl *template.Template
+	if templateStr != "" {
+		if tmpl, err = parseOutputTemplate(templateStr); err != nil {
+			return err
+		}
+	}
+
+	if offlineMode {
+		if showTimings {
+			return fmt.Errorf("--show-timings is not available with --offline")
+		}
+		return runViewOffline(cmd, identifier, format, tmpl, plain)
+	}
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	links, err := newItemLinker(cfg, resolveWorkspace(cmd, cfg))
+	if err != nil {
+		return err
+	}
+
+	item, err := client.GetWorkItemByIdentifier(identifier)
+	if err != nil {
+		return err
+	}
+	if format != "" {
+		return render(format, item)
+	}
+	projectID := item.ProjectID
+	if projectID == "" {
+		projectID = item.Project
+	}
+
+	lookup, err := newItemLookup(client, projectID)
+	if err != nil {
+		return err
+	}
+	view := lookup.view(item)
+
+	if tmpl != nil {
+		return executeItemTemplate(os.Stdout, tmpl, view)
+	}
+
+	printItemFields(links.format(view.Key, projectID, item.ID), view, lookup, item, itemExtras{
+		Module:   itemModuleName(client, projectID, item),
+		Cycle:    itemCycleName(client, projectID, item),
+		Estimate: itemEstimate(client, projectID, item),
+	})
+
+	if showTimings {
+		activities, err := client.GetWorkItemActivities(projectID, item.ID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: could not fetch activity, time in state is approximate: %v\n", err)
+		}
+		t := computeTimings(item, activities, time.Now())
+
+		fmt.Println("\n⏱️  Timings")
+		fmt.Println(strings.Repeat("-", 70))
+		fmt.Printf("Age:               %s\n", formatAge(t.Age))
+		fmt.Printf("Since last update: %s\n", formatAge(t.SinceUpdate))
+		fmt.Printf("In current state:  %s\n", formatAge(t.InState))
+		fmt.Printf("Target date:       %s\n", formatDue(t.DueInDays))
+	}
+
+	printItemDescription(view, plain)
+	return nil
+}
+
+// runViewOffline shows a work item from the copy downloaded by sync
+func runViewOffline(cmd *cobra.Command, identifier, format string, tmpl *template.Template, plain bool) error {
+	store, item, err := offlineItem(cmd, identifier)
+	if err != nil {
+		return err
+	}
+	if format != "" {
+		return render(format, item)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	links, err := newItemLinker(cfg, store.Workspace)
+	if err != nil {
+		return err
+	}
+
+	lookup := buildItemLookup(store.Project.ID, &store.Project, store.States, store.Labels, store.Members)
+	view := lookup.view(item)
+	if tmpl != nil {
+		return executeItemTemplate(os.Stdout, tmpl, view)
+	}
+
+	// Estimates are not synced, so the estimate point ID is shown
+	extras := itemExtras{Module: offlineModuleID(item), Cycle: offlineCycleID(item)}
+	for _, m := range store.Modules {
+		if m.ID == extras.Module {
+			extras.Module = m.Name
+		}
+	}
+	if c, err := findCycle(store.Cycles, extras.Cycle); extras.Cycle != "" && err == nil {
+		extras.Cycle = c.Name
+	}
+	if item.EstimatePoint != nil {
+		extras.Estimate = *item.EstimatePoint
+	}
+
+	printItemFields(links.format(view.Key, store.Project.ID, item.ID), view, lookup, item, extras)
+	printItemDescription(view, plain)
+	return nil
+}
+
+// itemExtras are the fields view shows that the item lookup doesn't name
+type itemExtras struct {
+	Module, Cycle, Estimate string
+}
+
+// printItemFields prints the heading and fields of a work item
+func printItemFields(key string, view workItemView, lookup *itemLookup, item *plane.WorkItem, extras itemExtras) {
+	fmt.Printf("\n📋 %s: %s\n", key, view.Name)
+	fmt.Println(strings.Repeat("=", 70))
+	fmt.Printf("State:      %s\n", emptyAsDash(coloredName(view.State, lookup.stateColor(item))))
+	fmt.Printf("Priority:   %s\n", emptyAsDash(view.Priority))
+	fmt.Printf("Assignees:  %s\n", emptyAsDash(strings.Join(view.Assignees, ", ")))
+	fmt.Printf("Labels:     %s\n", emptyAsDash(coloredNames(view.Labels, lookup.labelColorList(item))))
+	fmt.Printf("Module:     %s\n", emptyAsDash(extras.Module))
+	fmt.Printf("Cycle:      %s\n", emptyAsDash(extras.Cycle))
+	fmt.Printf("Estimate:   %s\n", emptyAsDash(extras.Estimate))
+	fmt.Printf("Start date: %s\n", emptyAsDash(view.StartDate))
+	fmt.Printf("Due date:   %s\n", emptyAsDash(view.TargetDate))
+	fmt.Printf("Created:    %s\n", view.CreatedAt.Local().Format("2006-01-02 15:04"))
+	fmt.Printf("Updated:    %s\n", view.UpdatedAt.Local().Format("2006-01-02 15:04"))
+}
+
+// printItemDescription prints the description of a work item, rendered in
+// terminals that show color unless plain is set
+func printItemDescription(view workItemView, plain bool) {
+	if view.Description == "" {
+		return
+	}
+	fmt.Println("\n" + strings.Repeat("-", 70))
+	if plain || !console.SupportsANSI() {
+		fmt.Println(view.Description)
+	} else {
+		fmt.Println(markdown.RenderANSI(view.Description))
+	}
+}
+
+// itemModuleName returns the name of the module of item, or its ID when the
+// modules cannot be fetched
+func itemModuleName(client *plane.Client, projectID string, item *plane.WorkItem) string {
+	moduleID := item.ModuleID
+	if moduleID == "" {
+		moduleID = item.Module
+	}
+	if moduleID == "" {
+		return ""
+	}
+	if modules, err := client.GetModules(projectID); err == nil {
+		for _, m := range modules {
+			if m.ID == moduleID {
+				return m.Name
+			}
+		}
+	}
+	return moduleID
+}
+
+// itemCycleName returns the name of the cycle of item, or its ID when the
+// cycles cannot be fetched
+func itemCycleName(client *plane.Client, projectID string, item *plane.WorkItem) string {
+	cycleID := item.CycleID
+	if cycleID == "" {
+		cycleID = item.Cycle
+	}
+	if cycleID == "" {
+		return ""
+	}
+	if cycles, err := client.GetProjectCycles(projectID); err == nil {
+		if c, err := findCycle(cycles, cycleID); err == nil {
+			return c.Name
+		}
+	}
+	return cycleID
+}
+
+// itemEstimate returns the estimate value of item, or the estimate point ID
+// when the project's estimates are not available
+func itemEstimate(client *plane.Client, projectID string, item *plane.WorkItem) string {
+	if item.EstimatePoint == nil || *item.EstimatePoint == "" {
+		return ""
+	}
+	if points, err := estimatePoints(client, projectID); err == nil {
+		if v, ok := points[*item.EstimatePoint]; ok {
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+	return *item.EstimatePoint
+}
@@ -0,0 +1,263 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+	"plane-cli/internal/console"
+	"plane-cli/internal/plane"
+	"plane-cli/internal/poll"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Follow changes to the work items of a project",
+	Long: `Poll the work items of a project and print a line for each change: new
+work items, state changes, assignment changes and deleted work items.
+
+Each poll first asks for the most recently updated work item with a
+conditional request, which servers answer with 304 Not Modified while
+nothing changed. Otherwise it lists the IDs and update times of the work
+items; those whose updated_at moved are downloaded and compared with the
+previous poll. The delay between polls comes from --interval or
+poll.interval, backs off towards poll.max_interval while nothing changes
+and never drops below poll.min_interval.
+
+With --notify each poll with changes also shows a desktop notification.
+With -o json or -o yaml every change is printed as an object instead.
+Press Ctrl+C to stop.
+
+Examples:
+  plane-cli watch --project <project-id>
+  plane-cli watch --project <project-id> --interval 30s --notify
+  plane-cli watch --project <project-id> -o json | jq -r 'select(.kind == "state") | .key'`,
+	RunE: runWatch,
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().String("project", "", "Project ID (required unless defaults.project is set)")
+	watchCmd.MarkFlagRequired("project")
+	watchCmd.Flags().Duration("interval", 0, "Delay between polls, e.g. 30s or 5m (default poll.interval)")
+	watchCmd.Flags().Bool("notify", false, "Show a desktop notification for each poll with changes")
+}
+
+// Kinds of watch events
+const (
+	watchCreated   = "created"
+	watchState     = "state"
+	watchAssignees = "assignees"
+	watchRemoved   = "removed"
+)
+
+// watchEvent is a change to a work item found by a poll
+type watchEvent struct {
+	Time  time.Time `json:"time"`
+	Kind  string    `json:"kind"`
+	ID    string    `json:"id"`
+	Key   string    `json:"key"`
+	Title string    `json:"title"`
+	// From and To are the state names, or the assignee names joined by
+	// commas
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+func (e watchEvent) String() string {
+	switch e.Kind {
+	case watchCreated:
+		return fmt.Sprintf("🆕 %s %s (created in %s)", e.Key, e.Title, e.To)
+	case watchState:
+		return fmt.Sprintf("🔄 %s %s: %s → %s", e.Key, e.Title, e.From, e.To)
+	case watchAssignees:
+		return fmt.Sprintf("👤 %s %s: %s → %s", e.Key, e.Title, e.From, e.To)
+	default:
+		return fmt.Sprintf("🗑️  %s %s (deleted)", e.Key, e.Title)
+	}
+}
+
+// pollConfig returns the polling intervals of cfg; interval, when not
+// zero, replaces poll.interval
+func pollConfig(cfg *config.Config, interval time.Duration) poll.Config {
+	pc := poll.Config{
+		Interval:    time.Duration(cfg.PollInterval) * time.Second,
+		MinInterval: time.Duration(cfg.PollMinInterval) * time.Second,
+		MaxInterval: time.Duration(cfg.PollMaxInterval) * time.Second,
+		Backoff:     poll.DefaultConfig().Backoff,
+		Jitter:      cfg.PollJitter,
+	}
+	if interval > 0 {
+		pc.Interval = interval
+	}
+	return pc
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	notify, _ := cmd.Flags().GetBool("notify")
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	lookup, err := newItemLookup(client, project.ID)
+	if err != nil {
+		return err
+	}
+	// The latest update and the total count change with any edit, creation
+	// or deletion, so a conditional request for them answers 304 while the
+	// project is unchanged
+	probe := map[string]string{"order_by": "-updated_at", "per_page": "1"}
+	plane.SelectFields(probe, []string{"updated_at"}, nil)
+	_, validators, err := client.GetWorkItemsIfChanged(project.ID, probe, plane.Validators{})
+	if err != nil {
+		validators = plane.Validators{}
+	}
+	snapshot, err := fetchAllWorkItemsForProject(client, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch work items: %w", err)
+	}
+
+	scheduler := poll.New(pollConfig(cfg, interval))
+	if interval > 0 && scheduler.Interval() > interval {
+		fmt.Fprintf(os.Stderr, "⚠️  Polling every %s: poll.min_interval doesn't allow less\n", scheduler.Interval())
+	}
+	fmt.Fprintf(os.Stderr, "👀 Watching %s (%s): %d work items, polling every %s. Press Ctrl+C to stop.\n",
+		project.Name, project.Identifier, len(snapshot), scheduler.Interval())
+
+	check := func(ctx context.Context) (poll.Outcome, error) {
+		latest, next, err := client.GetWorkItemsIfChanged(project.ID, probe, validators)
+		if err != nil {
+			return poll.Failed, err
+		}
+		if latest == nil {
+			return poll.Unchanged, nil
+		}
+		items, stats, err := syncWorkItems(client, project.ID, snapshot)
+		if err != nil {
+			return poll.Failed, err
+		}
+		validators = next
+		if stats.added+stats.updated+stats.removed == 0 {
+			return poll.Unchanged, nil
+		}
+		// New states and members may have come with the changes
+		if l, err := newItemLookup(client, project.ID); err == nil {
+			lookup = l
+		}
+
+		events := diffWatchedItems(snapshot, items, project.Identifier, lookup, time.Now())
+		snapshot = items
+		for _, e := range events {
+			if format != "" {
+				if err := render(format, e); err != nil {
+					return poll.Failed, err
+				}
+				continue
+			}
+			fmt.Printf("%s  %s\n", e.Time.Local().Format("15:04:05"), e)
+		}
+		if notify && len(events) > 0 {
+			if err := notifyWatchEvents(project, events); err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  Desktop notifications turned off: %v\n", err)
+				notify = false
+			}
+		}
+		return poll.Changed, nil
+	}
+	onError := func(err error) {
+		fmt.Fprintf(os.Stderr, "⚠️  %s poll failed, backing off: %v\n", time.Now().Format("15:04:05"), err)
+	}
+
+	err = scheduler.Run(cmd.Context(), check, onError)
+	if errors.Is(err, context.Canceled) {
+		fmt.Fprintln(os.Stderr, "\n👋 Stopped watching")
+		return nil
+	}
+	return err
+}
+
+// diffWatchedItems compares two polls of the work items of a project and
+// returns the new, reassigned, moved and deleted ones
+func diffWatchedItems(before, after []plane.WorkItem, identifier string, lookup *itemLookup, now time.Time) []watchEvent {
+	event := func(kind string, item *plane.WorkItem) watchEvent {
+		return watchEvent{
+			Time:  now,
+			Kind:  kind,
+			ID:    item.ID,
+			Key:   fmt.Sprintf("%s-%d", identifier, item.SequenceID),
+			Title: item.Name,
+		}
+	}
+
+	previous := make(map[string]*plane.WorkItem, len(before))
+	for i := range before {
+		previous[before[i].ID] = &before[i]
+	}
+	var events []watchEvent
+	for i := range after {
+		item := &after[i]
+		old, ok := previous[item.ID]
+		delete(previous, item.ID)
+		if !ok {
+			e := event(watchCreated, item)
+			e.To = nameOrID(lookup.stateNames, itemStateID(item))
+			events = append(events, e)
+			continue
+		}
+		if old.UpdatedAt.Equal(item.UpdatedAt) {
+			continue
+		}
+		if itemStateID(old) != itemStateID(item) {
+			e := event(watchState, item)
+			e.From = nameOrID(lookup.stateNames, itemStateID(old))
+			e.To = nameOrID(lookup.stateNames, itemStateID(item))
+			events = append(events, e)
+		}
+		if !sameNames(itemAssigneeIDs(old), itemAssigneeIDs(item)) {
+			e := event(watchAssignees, item)
+			e.From = strings.Join(lookup.assigneeNames(old), ", ")
+			e.To = strings.Join(lookup.assigneeNames(item), ", ")
+			events = append(events, e)
+		}
+	}
+	// Keep deletions in the order of the previous poll
+	for i := range before {
+		if _, ok := previous[before[i].ID]; ok {
+			events = append(events, event(watchRemoved, &before[i]))
+		}
+	}
+	return events
+}
+
+// notifyWatchEvents shows the changes found by one poll as a single desktop
+// notification
+func notifyWatchEvents(project *plane.Project, events []watchEvent) error {
+	const shown = 3
+	title := fmt.Sprintf("Plane: %d change(s) in %s", len(events), project.Name)
+	var lines []string
+	for i, e := range events {
+		if i == shown {
+			lines = append(lines, fmt.Sprintf("…and %d more", len(events)-shown))
+			break
+		}
+		lines = append(lines, e.String())
+	}
+	return console.Notify(title, strings.Join(lines, "\n"))
+}
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/plane"
+)
+
+var whoamiCmd = &cobra.Command{
+	Use:   "whoami",
+	Short: "Show the user behind the API token and their workspaces",
+	Long: `Show the ID, email and display name of the user the API token belongs to,
+and the workspaces they can access, marking the one in use.
+
+Plane versions without the user workspaces endpoint only show the
+workspace in use. 'plane-cli auth status' adds roles per project.
+
+Examples:
+  plane-cli whoami
+  plane-cli whoami -o json | jq -r .id`,
+	Args: cobra.NoArgs,
+	RunE: runWhoami,
+}
+
+func init() {
+	rootCmd.AddCommand(whoamiCmd)
+}
+
+// whoamiOutput is what whoami prints with -o json or yaml
+type whoamiOutput struct {
+	ID          string `json:"id"`
+	Email       string `json:"email"`
+	DisplayName string `json:"display_name"`
+	FirstName   string `json:"first_name,omitempty"`
+	LastName    string `json:"last_name,omitempty"`
+	// Workspace is the slug of the workspace in use
+	Workspace  string            `json:"workspace"`
+	Workspaces []plane.Workspace `json:"workspaces,omitempty"`
+}
+
+func runWhoami(cmd *cobra.Command, args []string) error {
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	user, err := client.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+	out := whoamiOutput{
+		ID:          user.ID,
+		Email:       user.Email,
+		DisplayName: user.GetDisplayName(),
+		FirstName:   user.FirstName,
+		LastName:    user.LastName,
+		Workspace:   resolveWorkspace(cmd, cfg),
+	}
+	workspaces, err := client.GetUserWorkspaces()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: workspaces not listed: %v\n", err)
+	}
+	out.Workspaces = workspaces
+
+	if format != "" {
+		return render(format, out)
+	}
+
+	fmt.Printf("User:         %s <%s>\n", out.DisplayName, emptyAsDash(out.Email))
+	if name := strings.TrimSpace(out.FirstName + " " + out.LastName); name != "" && name != out.DisplayName {
+		fmt.Printf("Name:         %s\n", name)
+	}
+	fmt.Printf("User ID:      %s\n", out.ID)
+	fmt.Printf("Workspace:    %s\n", emptyAsDash(out.Workspace))
+	if len(workspaces) == 0 {
+		return nil
+	}
+
+	fmt.Printf("\nWorkspaces (%d):\n\n", len(workspaces))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tSLUG\tNAME\tROLE")
+	for _, ws := range workspaces {
+		current := ""
+		if ws.Slug == out.Workspace {
+			current = "*"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", current, ws.Slug, ws.Name, emptyAsDash(plane.RoleName(ws.Role)))
+	}
+	w.Flush()
+	return nil
+}
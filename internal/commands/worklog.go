@@ -0,0 +1,394 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/console"
+	"plane-cli/internal/plane"
+)
+
+var worklogCmd = &cobra.Command{
+	Use:   "worklog",
+	Short: "Log and review time spent on work items",
+	Long: `Log time against work items and list what was logged, per work item or
+across a project, optionally summarized per day or per week.
+
+Worklogs are only available on Plane instances with time tracking; other
+instances answer that the endpoint doesn't exist.
+
+Durations are written as 1h30m, 45m or 2h. Listing a whole project reads
+the worklogs of each work item, several at once.
+
+Examples:
+  plane-cli worklog add PROJ-12 --duration 1h30m --note "debugging"
+  plane-cli worklog list PROJ-12
+  plane-cli worklog list --project <project-id> --user me --since 2024-06-03 --summary daily
+  plane-cli worklog list --project <project-id> --summary weekly -o json`,
+}
+
+var worklogAddCmd = &cobra.Command{
+	Use:   "add <PROJ-123>",
+	Short: "Log time against a work item",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runWorklogAdd,
+}
+
+var worklogListCmd = &cobra.Command{
+	Use:   "list [PROJ-123]",
+	Short: "List the time logged on a work item or a project",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runWorklogList,
+}
+
+// worklogWorkers bounds the work items whose worklogs are fetched at once
+const worklogWorkers = 4
+
+func init() {
+	rootCmd.AddCommand(worklogCmd)
+	worklogCmd.AddCommand(worklogAddCmd)
+	worklogCmd.AddCommand(worklogListCmd)
+
+	worklogAddCmd.Flags().String("duration", "", "Time spent, e.g. 1h30m or 45m (required)")
+	worklogAddCmd.Flags().String("note", "", "What the time was spent on")
+	worklogAddCmd.MarkFlagRequired("duration")
+
+	worklogListCmd.Flags().String("project", "", "Project ID, to list the worklogs of every work item")
+	worklogListCmd.Flags().String("user", "", "Only time logged by this member: ID, email, name or me")
+	worklogListCmd.Flags().String("since", "", "Only time logged on or after this date (YYYY-MM-DD)")
+	worklogListCmd.Flags().String("until", "", "Only time logged on or before this date (YYYY-MM-DD)")
+	worklogListCmd.Flags().String("summary", "", "Sum the time per user and period: daily or weekly")
+}
+
+// worklogEntry is a worklog with the work item and member it belongs to
+type worklogEntry struct {
+	plane.Worklog
+	// Key is the readable identifier of the work item, e.g. PROJ-12
+	Key   string `json:"key"`
+	Title string `json:"title"`
+	User  string `json:"user"`
+}
+
+// worklogSummary is the time one member logged in one day or week
+type worklogSummary struct {
+	// Period is the day, or the Monday starting the week, as YYYY-MM-DD
+	Period  string `json:"period"`
+	User    string `json:"user"`
+	Minutes int    `json:"minutes"`
+	Entries int    `json:"entries"`
+}
+
+func runWorklogAdd(cmd *cobra.Command, args []string) error {
+	durationStr, _ := cmd.Flags().GetString("duration")
+	note, _ := cmd.Flags().GetString("note")
+
+	duration, err := time.ParseDuration(strings.ReplaceAll(durationStr, " ", ""))
+	if err != nil {
+		return fmt.Errorf("invalid --duration %q: use a value like 1h30m or 45m", durationStr)
+	}
+	minutes := int(duration.Round(time.Minute).Minutes())
+	if minutes < 1 {
+		return fmt.Errorf("--duration must be at least one minute")
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	item, projectID, err := itemByIdentifier(client, args[0])
+	if err != nil {
+		return err
+	}
+
+	worklog, err := client.CreateWorklog(projectID, item.ID, &plane.WorklogCreate{Description: note, Duration: minutes})
+	if err != nil {
+		return worklogError(err)
+	}
+
+	fmt.Printf("✅ Logged %s on %s: %s\n", formatMinutes(worklog.Duration), strings.ToUpper(args[0]), item.Name)
+	return nil
+}
+
+func runWorklogList(cmd *cobra.Command, args []string) error {
+	projectID, _ := cmd.Flags().GetString("project")
+	user, _ := cmd.Flags().GetString("user")
+	sinceStr, _ := cmd.Flags().GetString("since")
+	untilStr, _ := cmd.Flags().GetString("until")
+	summary, _ := cmd.Flags().GetString("summary")
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	summary = strings.ToLower(summary)
+	if summary != "" && summary != "daily" && summary != "weekly" {
+		return fmt.Errorf("invalid --summary '%s' (use daily or weekly)", summary)
+	}
+	if len(args) == 0 && projectID == "" {
+		return fmt.Errorf("give a work item, or --project to list the worklogs of a project")
+	}
+	since, err := parseScheduleDate("since", sinceStr)
+	if err != nil {
+		return err
+	}
+	until, err := parseScheduleDate("until", untilStr)
+	if err != nil {
+		return err
+	}
+	if err := validateScheduleRange(since, until); err != nil {
+		return err
+	}
+	if !until.IsZero() {
+		// The whole last day is included
+		until = until.AddDate(0, 0, 1)
+	}
+
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	var items []plane.WorkItem
+	if len(args) == 1 {
+		item, itemProjectID, err := itemByIdentifier(client, args[0])
+		if err != nil {
+			return err
+		}
+		items, projectID = []plane.WorkItem{*item}, itemProjectID
+	} else {
+		fmt.Fprintf(os.Stderr, "📥 Fetching work items from project '%s'...\n", projectID)
+		if items, err = fetchAllWorkItemsForProject(client, projectID); err != nil {
+			return fmt.Errorf("failed to fetch work items: %w", err)
+		}
+	}
+	project, err := client.GetProject(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	userID := ""
+	if user != "" {
+		ids, err := newMemberResolver(client, projectID).resolve([]string{user})
+		if err != nil {
+			return err
+		}
+		userID = ids[0]
+	}
+
+	worklogs, err := fetchWorklogs(client, projectID, items)
+	if err != nil {
+		return err
+	}
+
+	memberNames := make(map[string]string)
+	if members, err := client.GetWorkspaceMembers(); err == nil {
+		for _, m := range members {
+			memberNames[m.ID] = m.GetDisplayName()
+		}
+	}
+	entries := []worklogEntry{}
+	for i, logs := range worklogs {
+		for _, w := range logs {
+			logger := worklogUser(&w)
+			if (userID != "" && logger != userID) ||
+				(!since.IsZero() && w.CreatedAt.Before(since)) ||
+				(!until.IsZero() && !w.CreatedAt.Before(until)) {
+				continue
+			}
+			entries = append(entries, worklogEntry{
+				Worklog: w,
+				Key:     fmt.Sprintf("%s-%d", project.Identifier, items[i].SequenceID),
+				Title:   items[i].Name,
+				User:    nameOrID(memberNames, logger),
+			})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+
+	if summary != "" {
+		summaries := summarizeWorklogs(entries, summary == "weekly")
+		if format != "" {
+			return render(format, summaries)
+		}
+		printWorklogSummaries(summaries, summary == "weekly")
+		return nil
+	}
+	if format != "" {
+		return render(format, entries)
+	}
+	printWorklogEntries(entries)
+	return nil
+}
+
+// worklogError explains the 404 of instances without time tracking
+func worklogError(err error) error {
+	if plane.IsNotFound(err) {
+		return fmt.Errorf("this Plane instance doesn't expose worklogs (time tracking isn't enabled): %w", err)
+	}
+	return err
+}
+
+// worklogUser returns the member who logged the time
+func worklogUser(w *plane.Worklog) string {
+	if w.LoggedBy != "" {
+		return w.LoggedBy
+	}
+	return w.CreatedBy
+}
+
+// fetchWorklogs fetches the worklogs of each work item, several at once.
+// The worklogs of items[i] are at index i. Fetching stops at the first
+// error.
+func fetchWorklogs(client *plane.Client, projectID string, items []plane.WorkItem) ([][]plane.Worklog, error) {
+	worklogs := make([][]plane.Worklog, len(items))
+	progress := console.IsTerminal(os.Stderr) && len(items) > 1
+
+	var mu sync.Mutex
+	done := 0
+	var firstErr error
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(worklogWorkers, len(items)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				logs, err := client.ListWorklogs(projectID, items[i].ID)
+
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = worklogError(err)
+				}
+				worklogs[i] = logs
+				done++
+				if progress {
+					fmt.Fprintf(os.Stderr, "\r⏱️  Reading worklogs %d/%d...", done, len(items))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for i := range items {
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed {
+			break
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if progress {
+		fmt.Fprintf(os.Stderr, "\r%s\r", strings.Repeat(" ", 50))
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return worklogs, nil
+}
+
+// summarizeWorklogs sums the time of each member per day, or per week
+// starting on Monday, latest period first
+func summarizeWorklogs(entries []worklogEntry, weekly bool) []worklogSummary {
+	byKey := make(map[[2]string]*worklogSummary)
+	for _, e := range entries {
+		day := e.CreatedAt.Local()
+		if weekly {
+			day = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		}
+		key := [2]string{day.Format("2006-01-02"), e.User}
+		s := byKey[key]
+		if s == nil {
+			s = &worklogSummary{Period: key[0], User: key[1]}
+			byKey[key] = s
+		}
+		s.Minutes += e.Duration
+		s.Entries++
+	}
+
+	summaries := []worklogSummary{}
+	for _, s := range byKey {
+		summaries = append(summaries, *s)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Period != summaries[j].Period {
+			return summaries[i].Period > summaries[j].Period
+		}
+		return summaries[i].User < summaries[j].User
+	})
+	return summaries
+}
+
+func printWorklogEntries(entries []worklogEntry) {
+	if len(entries) == 0 {
+		fmt.Println("No time logged.")
+		return
+	}
+
+	fmt.Printf("\n⏱️  Worklogs (%d):\n\n", len(entries))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATE\tITEM\tUSER\tTIME\tNOTE")
+	perUser := make(map[string]int)
+	total := 0
+	for _, e := range entries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.CreatedAt.Local().Format("2006-01-02 15:04"), e.Key, e.User,
+			formatMinutes(e.Duration), emptyAsDash(truncate(e.Description, 50)))
+		perUser[e.User] += e.Duration
+		total += e.Duration
+	}
+	w.Flush()
+
+	fmt.Println()
+	for _, name := range sortedMapKeys(perUser) {
+		fmt.Printf("  %s: %s\n", name, formatMinutes(perUser[name]))
+	}
+	fmt.Printf("Total: %s\n\n", formatMinutes(total))
+}
+
+func printWorklogSummaries(summaries []worklogSummary, weekly bool) {
+	if len(summaries) == 0 {
+		fmt.Println("No time logged.")
+		return
+	}
+
+	period := "DAY"
+	if weekly {
+		period = "WEEK OF"
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\tUSER\tTIME\tENTRIES\n", period)
+	total := 0
+	for i, s := range summaries {
+		label := s.Period
+		if i > 0 && summaries[i-1].Period == s.Period {
+			label = ""
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", label, s.User, formatMinutes(s.Minutes), s.Entries)
+		total += s.Minutes
+	}
+	w.Flush()
+	fmt.Printf("\nTotal: %s\n", formatMinutes(total))
+}
+
+// formatMinutes prints a duration in minutes as e.g. 1h 30m
+func formatMinutes(minutes int) string {
+	switch {
+	case minutes < 60:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes%60 == 0:
+		return fmt.Sprintf("%dh", minutes/60)
+	default:
+		return fmt.Sprintf("%dh %dm", minutes/60, minutes%60)
+	}
+}
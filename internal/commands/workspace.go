@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"plane-cli/internal/config"
+	"plane-cli/internal/plane"
+)
+
+var workspaceCmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "List and switch workspaces",
+	Long: `List the workspaces the API token can access and choose the one commands
+use by default.
+
+'workspace use' saves the slug as PLANE_WORKSPACE in the active profile,
+or in the .env file when no profile is in use. --workspace still wins for
+a single command, and PLANE_WORKSPACE set in the shell wins over .env.
+
+Examples:
+  plane-cli workspace list
+  plane-cli workspace use my-team
+  plane-cli project list --workspace other-team`,
+}
+
+var workspaceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the workspaces you can access",
+	Args:  cobra.NoArgs,
+	RunE:  runWorkspaceList,
+}
+
+var workspaceUseCmd = &cobra.Command{
+	Use:   "use <slug>",
+	Short: "Make a workspace the default one",
+	Long: `Make a workspace the default one, by slug or name. The workspace is checked
+against the ones the API token can access; Plane versions without the user
+workspaces endpoint take the slug as given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorkspaceUse,
+}
+
+func init() {
+	rootCmd.AddCommand(workspaceCmd)
+	workspaceCmd.AddCommand(workspaceListCmd)
+	workspaceCmd.AddCommand(workspaceUseCmd)
+}
+
+// workspaceEntry is one workspace of 'workspace list' with -o json or yaml
+type workspaceEntry struct {
+	plane.Workspace
+	Current bool `json:"current"`
+}
+
+func runWorkspaceList(cmd *cobra.Command, args []string) error {
+	format, err := structuredOutput(cmd)
+	if err != nil {
+		return err
+	}
+	cfg, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	workspaces, err := client.GetUserWorkspaces()
+	if err != nil {
+		return fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	current := resolveWorkspace(cmd, cfg)
+	entries := make([]workspaceEntry, 0, len(workspaces))
+	for _, ws := range workspaces {
+		entries = append(entries, workspaceEntry{Workspace: ws, Current: ws.Slug == current})
+	}
+	if format != "" {
+		return render(format, entries)
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("The API token has no workspaces.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tSLUG\tNAME\tROLE")
+	found := false
+	for _, e := range entries {
+		marker := ""
+		if e.Current {
+			marker, found = "*", true
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", marker, e.Slug, e.Name, emptyAsDash(plane.RoleName(e.Role)))
+	}
+	w.Flush()
+
+	if !found {
+		fmt.Printf("\n⚠️  The workspace in use, '%s', isn't one of these.\n", current)
+	}
+	return nil
+}
+
+func runWorkspaceUse(cmd *cobra.Command, args []string) error {
+	slug := args[0]
+	_, client, err := newClientFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspaces, err := client.GetUserWorkspaces()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: workspace not checked: %v\n", err)
+	} else {
+		ws := findWorkspace(workspaces, slug)
+		if ws == nil {
+			slugs := make([]string, 0, len(workspaces))
+			for _, w := range workspaces {
+				slugs = append(slugs, w.Slug)
+			}
+			return fmt.Errorf("workspace '%s' not found (available: %s)", slug, emptyAsNone(strings.Join(slugs, ", ")))
+		}
+		slug = ws.Slug
+	}
+
+	where, err := config.SetWorkspace(slug)
+	if err != nil {
+		return fmt.Errorf("failed to save workspace: %w", err)
+	}
+	fmt.Printf("✅ Now using workspace '%s', saved to %s\n", slug, where)
+	return nil
+}
+
+// findWorkspace returns the workspace with the given slug, or else the one
+// with the given name ignoring case
+func findWorkspace(workspaces []plane.Workspace, ref string) *plane.Workspace {
+	for i := range workspaces {
+		if workspaces[i].Slug == ref {
+			return &workspaces[i]
+		}
+	}
+	for i := range workspaces {
+		if strings.EqualFold(workspaces[i].Name, ref) {
+			return &workspaces[i]
+		}
+	}
+	return nil
+}
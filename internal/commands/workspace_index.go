@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"plane-cli/internal/console"
+	"plane-cli/internal/fuzzy"
+	"plane-cli/internal/plane"
+)
+
+// workspaceIndexWorkers bounds the projects whose work items are fetched at
+// once
+const workspaceIndexWorkers = 4
+
+// workspaceItem is a work item found across the projects of a workspace
+type workspaceItem struct {
+	plane.WorkItem
+	// ProjectIdentifier is the identifier of its project, e.g. PROJ
+	ProjectIdentifier string `json:"project_identifier"`
+	// Key is the readable identifier, e.g. PROJ-12
+	Key string `json:"key"`
+	// Score is how well the title matched the search, from 0 to 100
+	Score int `json:"score,omitempty"`
+}
+
+// workspaceIndex holds the work items of every project of a workspace
+type workspaceIndex struct {
+	Items    []workspaceItem
+	Projects map[string]plane.Project
+	// States holds the states of every project by ID
+	States map[string]plane.State
+}
+
+// buildWorkspaceIndex fetches the states and the given fields of the work
+// items of every project, several projects at once. A project that can't be
+// fetched is left out with a warning.
+func buildWorkspaceIndex(client *plane.Client, fields []string) (*workspaceIndex, error) {
+	projects, err := pickerProjects(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch projects: %w", err)
+	}
+	return buildProjectsIndex(client, projects, fields), nil
+}
+
+// buildProjectsIndex fetches the states and the given fields of the work
+// items of the given projects, as buildWorkspaceIndex does
+func buildProjectsIndex(client *plane.Client, projects []plane.Project, fields []string) *workspaceIndex {
+	index := &workspaceIndex{Projects: make(map[string]plane.Project), States: make(map[string]plane.State)}
+	progress := console.IsTerminal(os.Stderr)
+	items := make([][]plane.WorkItem, len(projects))
+
+	var mu sync.Mutex
+	done := 0
+	var warnings []string
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(workspaceIndexWorkers, len(projects)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				options := map[string]string{}
+				plane.SelectFields(options, fields, nil)
+				projectItems, err := client.WorkItemsPager(projects[i].ID, options).All()
+				var states []plane.State
+				if err == nil {
+					states, err = client.GetProjectStates(projects[i].ID)
+				}
+
+				mu.Lock()
+				if err != nil {
+					warnings = append(warnings, fmt.Sprintf("⚠️  Warning: skipping %s: %v", projects[i].Identifier, err))
+				} else {
+					items[i] = projectItems
+					for _, s := range states {
+						index.States[s.ID] = s
+					}
+				}
+				done++
+				if progress {
+					fmt.Fprintf(os.Stderr, "\r📥 Indexing projects %d/%d...", done, len(projects))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for i := range projects {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if progress {
+		fmt.Fprintf(os.Stderr, "\r%s\r", strings.Repeat(" ", 50))
+	}
+	for _, w := range warnings {
+		fmt.Fprintln(os.Stderr, w)
+	}
+
+	for i, p := range projects {
+		index.Projects[p.ID] = p
+		for _, item := range items[i] {
+			if item.ProjectID == "" {
+				item.ProjectID = p.ID
+			}
+			index.Items = append(index.Items, workspaceItem{
+				WorkItem:          item,
+				ProjectIdentifier: p.Identifier,
+				Key:               fmt.Sprintf("%s-%d", p.Identifier, item.SequenceID),
+			})
+		}
+	}
+	return index
+}
+
+// search returns the work items whose title matches text, best first, with
+// their score. keep, when not nil, filters the work items first.
+func (x *workspaceIndex) search(text string, minScore int, keep func(*workspaceItem) bool) []workspaceItem {
+	var candidates []workspaceItem
+	for _, item := range x.Items {
+		if keep == nil || keep(&item) {
+			candidates = append(candidates, item)
+		}
+	}
+	titles := make([]string, len(candidates))
+	for i, item := range candidates {
+		titles[i] = item.Name
+	}
+
+	matches := matchTitles(text, titles, minScore)
+	found := make([]workspaceItem, 0, len(matches))
+	for _, m := range matches {
+		item := candidates[m.Index]
+		item.Score = m.Score
+		found = append(found, item)
+	}
+	return found
+}
+
+// matchTitles matches text fuzzily against titles, falling back to titles
+// containing it when nothing matches
+func matchTitles(text string, titles []string, minScore int) []fuzzy.MatchResult {
+	matches := fuzzy.NewMatcher(minScore).FindMatches(text, titles)
+	if len(matches) > 0 {
+		return matches
+	}
+	lower := strings.ToLower(text)
+	for i, title := range titles {
+		if strings.Contains(strings.ToLower(title), lower) {
+			// A substring match scores 50%
+			matches = append(matches, fuzzy.MatchResult{Index: i, Score: 50})
+		}
+	}
+	return matches
+}
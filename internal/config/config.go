@@ -5,44 +5,75 @@ import (
 	"os"
 	"path/filepath"
 
-	"github.com/joho/godotenv"
 	"github.com/spf13/viper"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	PlaneBaseURL    string
-	PlaneAPIToken   string
-	PlaneWorkspace  string
-	DefaultProject  string
-	RequestTimeout  int
+	PlaneBaseURL   string
+	PlaneAPIToken  string
+	PlaneWorkspace string
+	DefaultProject string
+	RequestTimeout int
+	// MaxRetries is how often requests failing with 429, 502, 503 or 504
+	// are retried
+	MaxRetries int
+	// RateLimit is the number of API requests per minute the client paces
+	// itself to; 0 disables the limit
+	RateLimit int
+	// PageSize is the number of work items requested per page
+	PageSize int
+	// MaxContentKB is the largest description or page content, in KB,
+	// sent in one request; 0 disables the check
+	MaxContentKB    int
 	TemplatesDir    string
 	FuzzyMinScore   int
 	FuzzyMaxResults int
+	PollInterval    int
+	PollMinInterval int
+	PollMaxInterval int
+	PollJitter      float64
+	// ConfirmThreshold is the number of affected work items from which
+	// destructive commands ask for the count to be typed back
+	ConfirmThreshold int
+	// LinkStyle selects how work item references are printed: plain,
+	// osc8 (terminal hyperlinks) or markdown
+	LinkStyle string
+	// WebURL is the address of the Plane web app used for links; it
+	// defaults to the API base URL
+	WebURL string
+	// CompletionRefreshAfter is the age in minutes after which completion
+	// data is refreshed in the background; 0 disables the refresh
+	CompletionRefreshAfter int
+	// HistoryEnabled records commands that change data in the local
+	// operation history
+	HistoryEnabled bool
+	// MetadataTTL is how many seconds listings of projects, members,
+	// states, labels, modules and cycles are reused, by resource; 0
+	// fetches the resource every time
+	MetadataTTL map[string]int
+	// MetadataOnDisk keeps the metadata listings between runs
+	MetadataOnDisk bool
 }
 
+// metadataResources are the resources of the metadata cache
+var metadataResources = []string{"projects", "members", "states", "labels", "modules", "cycles"}
+
 // Load loads configuration from environment and config file
 // If configuration is missing, it will prompt the user interactively
 func Load() (*Config, error) {
+	// Credentials come from .env, the environment and the active profile
+	if err := loadCredentials(); err != nil {
+		return nil, err
+	}
+
 	// First check if we have a valid configuration
 	if !IsConfigured() {
 		// Configuration missing - the caller should handle this by calling CheckAndPromptConfig
 		return nil, fmt.Errorf("configuration not found: run 'plane-cli configure' or use interactive mode")
 	}
 
-	// Load .env file if exists
-	envFile := ".env"
-	if _, err := os.Stat(envFile); err == nil {
-		if err := godotenv.Load(envFile); err != nil {
-			return nil, fmt.Errorf("failed to load .env file: %w", err)
-		}
-	}
-
-	// Initialize viper
-	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
-	viper.AddConfigPath(".")
-	viper.AddConfigPath("$HOME/.plane-cli")
 
 	// Set defaults
 	viper.SetDefault("defaults.project", "")
@@ -53,24 +84,59 @@ func Load() (*Config, error) {
 	viper.SetDefault("fuzzy.min_score", 60)
 	viper.SetDefault("fuzzy.max_results", 10)
 	viper.SetDefault("request.timeout", 30)
+	viper.SetDefault("request.max_retries", 3)
+	viper.SetDefault("request.rate_limit", 60)
+	viper.SetDefault("request.page_size", 100)
+	viper.SetDefault("request.max_content_kb", 1024)
+	viper.SetDefault("poll.interval", 60)
+	viper.SetDefault("poll.min_interval", 15)
+	viper.SetDefault("poll.max_interval", 600)
+	viper.SetDefault("poll.jitter", 0.2)
+	viper.SetDefault("safety.confirm_threshold", 10)
+	viper.SetDefault("output.link_style", "plain")
+	viper.SetDefault("output.web_url", "")
+	viper.SetDefault("completion.refresh_after", 60)
+	viper.SetDefault("history.enabled", true)
+	viper.SetDefault("cache.metadata_on_disk", false)
+	viper.SetDefault("cache.ttl.projects", 600)
+	viper.SetDefault("cache.ttl.members", 600)
+	viper.SetDefault("cache.ttl.states", 600)
+	viper.SetDefault("cache.ttl.labels", 300)
+	viper.SetDefault("cache.ttl.modules", 300)
+	viper.SetDefault("cache.ttl.cycles", 300)
 
-	// Read config file (optional)
-	if err := viper.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
-			return nil, fmt.Errorf("failed to read config file: %w", err)
-		}
+	if err := readConfigFiles(viper.GetViper()); err != nil {
+		return nil, err
 	}
 
 	// Build config
 	cfg := &Config{
-		PlaneBaseURL:    getEnvOrDefault("PLANE_BASE_URL", ""),
-		PlaneAPIToken:   getEnvOrDefault("PLANE_API_TOKEN", ""),
-		PlaneWorkspace:  getEnvOrDefault("PLANE_WORKSPACE", ""),
-		DefaultProject:  viper.GetString("defaults.project"),
-		RequestTimeout:  viper.GetInt("request.timeout"),
-		TemplatesDir:    viper.GetString("templates.directory"),
-		FuzzyMinScore:   viper.GetInt("fuzzy.min_score"),
-		FuzzyMaxResults: viper.GetInt("fuzzy.max_results"),
+		PlaneBaseURL:           getEnvOrDefault("PLANE_BASE_URL", ""),
+		PlaneAPIToken:          getEnvOrDefault("PLANE_API_TOKEN", ""),
+		PlaneWorkspace:         getEnvOrDefault("PLANE_WORKSPACE", ""),
+		DefaultProject:         viper.GetString("defaults.project"),
+		RequestTimeout:         viper.GetInt("request.timeout"),
+		MaxRetries:             viper.GetInt("request.max_retries"),
+		RateLimit:              viper.GetInt("request.rate_limit"),
+		PageSize:               viper.GetInt("request.page_size"),
+		MaxContentKB:           viper.GetInt("request.max_content_kb"),
+		TemplatesDir:           viper.GetString("templates.directory"),
+		FuzzyMinScore:          viper.GetInt("fuzzy.min_score"),
+		FuzzyMaxResults:        viper.GetInt("fuzzy.max_results"),
+		PollInterval:           viper.GetInt("poll.interval"),
+		PollMinInterval:        viper.GetInt("poll.min_interval"),
+		PollMaxInterval:        viper.GetInt("poll.max_interval"),
+		PollJitter:             viper.GetFloat64("poll.jitter"),
+		ConfirmThreshold:       viper.GetInt("safety.confirm_threshold"),
+		LinkStyle:              viper.GetString("output.link_style"),
+		WebURL:                 viper.GetString("output.web_url"),
+		CompletionRefreshAfter: viper.GetInt("completion.refresh_after"),
+		HistoryEnabled:         viper.GetBool("history.enabled"),
+		MetadataTTL:            make(map[string]int),
+		MetadataOnDisk:         viper.GetBool("cache.metadata_on_disk"),
+	}
+	for _, resource := range metadataResources {
+		cfg.MetadataTTL[resource] = viper.GetInt("cache.ttl." + resource)
 	}
 
 	// Validate required fields
@@ -81,9 +147,10 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("PLANE_API_TOKEN is required")
 	}
 
-	// Resolve templates directory
+	// Resolve templates directory against the directory the configuration
+	// came from, so the CLI works from any subdirectory of the repository
 	if !filepath.IsAbs(cfg.TemplatesDir) {
-		absPath, err := filepath.Abs(cfg.TemplatesDir)
+		absPath, err := filepath.Abs(filepath.Join(anchorDir(viper.ConfigFileUsed()), cfg.TemplatesDir))
 		if err != nil {
 			return nil, fmt.Errorf("failed to resolve templates directory: %w", err)
 		}
@@ -93,6 +160,25 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+// readConfigFiles reads the per-user config.yaml into v, then merges the
+// nearest project config.yaml over it; both are optional
+func readConfigFiles(v *viper.Viper) error {
+	userConfig := userFile("config.yaml")
+	if userConfig != "" {
+		v.SetConfigFile(userConfig)
+		if err := v.ReadInConfig(); err != nil {
+			return fmt.Errorf("failed to read config file: %w", err)
+		}
+	}
+	if path := findUpward("config.yaml"); path != "" && path != userConfig {
+		v.SetConfigFile(path)
+		if err := v.MergeInConfig(); err != nil {
+			return fmt.Errorf("failed to read config file: %w", err)
+		}
+	}
+	return nil
+}
+
 func getEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
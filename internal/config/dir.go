@@ -0,0 +1,60 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Dir returns the directory holding per-user configuration and state
+// (credentials, profiles, aliases, caches), creating it if needed. It is
+// $XDG_CONFIG_HOME/plane-cli, or ~/.config/plane-cli when XDG_CONFIG_HOME is
+// not set. A ~/.plane-cli directory from earlier versions is used as long
+// as the new directory doesn't exist.
+func Dir() (string, error) {
+	dir, err := userDir()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	return dir, nil
+}
+
+// userDir returns the per-user directory without creating it
+func userDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find home directory: %w", err)
+	}
+
+	base := filepath.Join(home, ".config")
+	// The XDG spec asks to ignore relative paths
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); filepath.IsAbs(xdg) {
+		base = xdg
+	}
+	dir := filepath.Join(base, "plane-cli")
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		legacy := filepath.Join(home, ".plane-cli")
+		if info, err := os.Stat(legacy); err == nil && info.IsDir() {
+			return legacy, nil
+		}
+	}
+	return dir, nil
+}
+
+// userFile returns the path of a file in the per-user directory, or "" when
+// the file doesn't exist
+func userFile(name string) string {
+	dir, err := userDir()
+	if err != nil {
+		return ""
+	}
+	path := filepath.Join(dir, name)
+	if info, err := os.Stat(path); err != nil || info.IsDir() {
+		return ""
+	}
+	return path
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/joho/godotenv"
+)
+
+// findUpward looks for name in the current directory and its parents, the
+// way git looks for .git. The search stops after the repository root (a
+// directory containing .git) or the home directory. It returns the path of
+// the file, or "" when none was found.
+func findUpward(name string) string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	home, _ := os.UserHomeDir()
+
+	for {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return ""
+		}
+		parent := filepath.Dir(dir)
+		if dir == home || parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// EnvFile returns the .env file configuration is saved to: the nearest one
+// found upward from the current directory, or the per-user .env (see Dir)
+// when there is none
+func EnvFile() string {
+	if path := findUpward(".env"); path != "" {
+		return path
+	}
+	if dir, err := Dir(); err == nil {
+		return filepath.Join(dir, ".env")
+	}
+	return ".env"
+}
+
+// loadEnvFile loads the project .env and then the per-user .env into the
+// environment. Variables that are already set win, so the environment
+// overrides the project file, which overrides the per-user file.
+func loadEnvFile() error {
+	for _, path := range []string{findUpward(".env"), userFile(".env")} {
+		if path == "" {
+			continue
+		}
+		if err := godotenv.Load(path); err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// anchorDir returns the directory relative paths in the configuration are
+// resolved against: the directory of the config file or .env in use, or the
+// current directory
+func anchorDir(configFile string) string {
+	if configFile != "" {
+		return filepath.Dir(configFile)
+	}
+	if path := findUpward(".env"); path != "" {
+		return filepath.Dir(path)
+	}
+	return "."
+}
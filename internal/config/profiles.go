@@ -0,0 +1,199 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Profile holds the credentials of one Plane instance
+type Profile struct {
+	BaseURL   string `yaml:"base_url"`
+	APIToken  string `yaml:"api_token"`
+	Workspace string `yaml:"workspace"`
+}
+
+// Profiles is the per-user profiles file. Current is the profile used when
+// none is selected with --profile or PLANE_PROFILE.
+type Profiles struct {
+	Current  string             `yaml:"current,omitempty"`
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// selectedProfile is the profile chosen with --profile for this run
+var selectedProfile string
+
+// credentialKeys are the variables a profile provides
+var credentialKeys = []string{"PLANE_BASE_URL", "PLANE_API_TOKEN", "PLANE_WORKSPACE"}
+
+// exportedCredentials records which credential variables were set in the
+// environment before any .env file or profile was loaded
+var exportedCredentials map[string]bool
+
+// SelectProfile makes the named profile the active one for this run
+func SelectProfile(name string) {
+	selectedProfile = name
+}
+
+// ProfilesFile returns the path of the profiles file
+func ProfilesFile() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "profiles.yaml"), nil
+}
+
+// LoadProfiles reads the profiles file; a missing file has no profiles
+func LoadProfiles() (*Profiles, error) {
+	p := &Profiles{Profiles: make(map[string]Profile)}
+	path, err := ProfilesFile()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if p.Profiles == nil {
+		p.Profiles = make(map[string]Profile)
+	}
+	return p, nil
+}
+
+// Save writes the profiles file, readable only by the user as it holds
+// API tokens
+func (p *Profiles) Save() error {
+	path, err := ProfilesFile()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("failed to encode profiles: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// Names returns the profile names, sorted
+func (p *Profiles) Names() []string {
+	names := make([]string, 0, len(p.Profiles))
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ActiveProfile returns the name of the profile in use: the one selected
+// with --profile, else PLANE_PROFILE, else the current one of the profiles
+// file. "" means no profile: credentials come from .env and the
+// environment.
+func ActiveProfile() string {
+	if selectedProfile != "" {
+		return selectedProfile
+	}
+	if name := os.Getenv("PLANE_PROFILE"); name != "" {
+		return name
+	}
+	if p, err := LoadProfiles(); err == nil {
+		return p.Current
+	}
+	return ""
+}
+
+// loadCredentials loads the .env file and then the active profile. A
+// profile chosen with --profile or PLANE_PROFILE wins over both .env and
+// the environment; the current profile of the profiles file only wins over
+// .env, so variables exported in the shell still apply.
+func loadCredentials() error {
+	if exportedCredentials == nil {
+		exportedCredentials = make(map[string]bool, len(credentialKeys))
+		for _, key := range credentialKeys {
+			exportedCredentials[key] = os.Getenv(key) != ""
+		}
+	}
+	if err := loadEnvFile(); err != nil {
+		return err
+	}
+
+	name := ActiveProfile()
+	if name == "" {
+		return nil
+	}
+	profiles, err := LoadProfiles()
+	if err != nil {
+		return err
+	}
+	profile, ok := profiles.Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile '%s' not found (run 'plane-cli configure --profile %s' to create it)", name, name)
+	}
+
+	explicit := selectedProfile != "" || os.Getenv("PLANE_PROFILE") != ""
+	for key, value := range map[string]string{
+		"PLANE_BASE_URL":  profile.BaseURL,
+		"PLANE_API_TOKEN": profile.APIToken,
+		"PLANE_WORKSPACE": profile.Workspace,
+	} {
+		if value != "" && (explicit || !exportedCredentials[key]) {
+			os.Setenv(key, value)
+		}
+	}
+	return nil
+}
+
+// saveProfile stores configuration values under a profile, creating it if
+// needed
+func saveProfile(name string, data map[string]string) error {
+	profiles, err := LoadProfiles()
+	if err != nil {
+		return err
+	}
+	profile := profiles.Profiles[name]
+	if v, ok := data["PLANE_BASE_URL"]; ok {
+		profile.BaseURL = v
+	}
+	if v, ok := data["PLANE_API_TOKEN"]; ok {
+		profile.APIToken = v
+	}
+	if v, ok := data["PLANE_WORKSPACE"]; ok {
+		profile.Workspace = v
+	}
+	profiles.Profiles[name] = profile
+	return profiles.Save()
+}
+
+// saveConfig stores configuration values in the active profile, or in the
+// .env file when no profile is in use, and returns where they went
+func saveConfig(data map[string]string) (string, error) {
+	if name := ActiveProfile(); name != "" {
+		if err := saveProfile(name, data); err != nil {
+			return "", err
+		}
+		path, _ := ProfilesFile()
+		return fmt.Sprintf("profile '%s' in %s", name, path), nil
+	}
+	if err := SaveToEnv(data); err != nil {
+		return "", err
+	}
+	return EnvFile(), nil
+}
+
+// SetWorkspace stores the workspace slug in the active profile, or in the
+// .env file when no profile is in use, and returns where it went
+func SetWorkspace(slug string) (string, error) {
+	return saveConfig(map[string]string{"PLANE_WORKSPACE": slug})
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+)
+
+// DefaultProject returns defaults.project from the configuration files, or
+// "" when none is set. Unlike Load it needs no credentials, so it can fill
+// in --project before a command runs; errors in the files are reported by
+// Load later.
+func DefaultProject() string {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := readConfigFiles(v); err != nil {
+		return ""
+	}
+	return v.GetString("defaults.project")
+}
+
+// SetDefaultProject stores defaults.project in the nearest project
+// config.yaml, or in the per-user config.yaml when there is none, keeping
+// the rest of the file and its comments. It returns the file written.
+func SetDefaultProject(projectID string) (string, error) {
+	path := findUpward("config.yaml")
+	if path == "" {
+		dir, err := Dir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(dir, "config.yaml")
+	}
+
+	var doc yaml.Node
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	if err := setYAMLString(doc.Content[0], []string{"defaults", "project"}, projectID); err != nil {
+		return "", fmt.Errorf("failed to update %s: %w", path, err)
+	}
+
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return "", fmt.Errorf("failed to update %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, out.Bytes(), 0644); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// setYAMLString sets the string at a key path of a YAML mapping, creating
+// the mappings on the way
+func setYAMLString(node *yaml.Node, path []string, value string) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s is not a mapping", path[0])
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != path[0] {
+			continue
+		}
+		child := node.Content[i+1]
+		if len(path) > 1 {
+			return setYAMLString(child, path[1:], value)
+		}
+		child.Kind, child.Tag, child.Value, child.Content = yaml.ScalarNode, "!!str", value, nil
+		return nil
+	}
+
+	key := &yaml.Node{Kind: yaml.ScalarNode, Value: path[0]}
+	child := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+	if len(path) > 1 {
+		child = &yaml.Node{Kind: yaml.MappingNode}
+		if err := setYAMLString(child, path[1:], value); err != nil {
+			return err
+		}
+	}
+	node.Content = append(node.Content, key, child)
+	return nil
+}
@@ -4,17 +4,14 @@ import (
 	"bufio"
 	"fmt"
 	"os"
-	"path/filepath"
 	"regexp"
 	"strings"
-
-	"github.com/joho/godotenv"
 )
 
 // IsConfigured checks if the essential configuration is present
 func IsConfigured() bool {
-	// Try to load .env file
-	godotenv.Load(".env")
+	// Try to load .env file and the active profile
+	loadCredentials()
 
 	baseURL := os.Getenv("PLANE_BASE_URL")
 	apiToken := os.Getenv("PLANE_API_TOKEN")
@@ -67,11 +64,12 @@ func CheckAndPromptConfig() (*Config, bool, error) {
 		"PLANE_WORKSPACE": workspace,
 	}
 
-	if err := SaveToEnv(envData); err != nil {
+	savedTo, err := saveConfig(envData)
+	if err != nil {
 		return nil, false, fmt.Errorf("failed to save configuration: %w", err)
 	}
 
-	fmt.Println("\n✅ Configuration saved to .env")
+	fmt.Printf("\n✅ Configuration saved to %s\n", savedTo)
 	fmt.Println(strings.Repeat("=", 70))
 
 	// Load and return the newly saved config
@@ -93,6 +91,21 @@ func InteractiveSetup() error {
 	currentToken := os.Getenv("PLANE_API_TOKEN")
 	currentWorkspace := os.Getenv("PLANE_WORKSPACE")
 
+	// A profile is configured on its own, starting empty when it is new
+	if name := ActiveProfile(); name != "" {
+		profiles, err := LoadProfiles()
+		if err != nil {
+			return err
+		}
+		profile, exists := profiles.Profiles[name]
+		currentBaseURL, currentToken, currentWorkspace = profile.BaseURL, profile.APIToken, profile.Workspace
+		if exists {
+			fmt.Printf("Profile: %s\n\n", name)
+		} else {
+			fmt.Printf("Profile: %s (new)\n\n", name)
+		}
+	}
+
 	if currentBaseURL != "" || currentToken != "" || currentWorkspace != "" {
 		fmt.Println("Current Configuration:")
 		fmt.Println(strings.Repeat("-", 70))
@@ -191,11 +204,12 @@ func InteractiveSetup() error {
 		return fmt.Errorf("no configuration to save")
 	}
 
-	if err := SaveToEnv(envData); err != nil {
+	savedTo, err := saveConfig(envData)
+	if err != nil {
 		return fmt.Errorf("failed to save configuration: %w", err)
 	}
 
-	fmt.Println("\n✅ Configuration updated successfully!")
+	fmt.Printf("\n✅ Configuration saved to %s\n", savedTo)
 	return nil
 }
 
@@ -271,7 +285,7 @@ func promptForWorkspace(reader *bufio.Reader) (string, error) {
 
 // SaveToEnv saves configuration to .env file
 func SaveToEnv(data map[string]string) error {
-	envPath := filepath.Join(".", ".env")
+	envPath := EnvFile()
 
 	// Read existing file content if it exists
 	existingContent := ""
@@ -360,8 +374,11 @@ func maskToken(token string) string {
 
 // ShowCurrentConfig displays the current configuration
 func ShowCurrentConfig() {
-	// Load .env file first
-	godotenv.Load(".env")
+	// Load .env file and the active profile first
+	if err := loadCredentials(); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
 
 	baseURL := os.Getenv("PLANE_BASE_URL")
 	apiToken := os.Getenv("PLANE_API_TOKEN")
@@ -370,6 +387,9 @@ func ShowCurrentConfig() {
 	fmt.Println("\n" + strings.Repeat("=", 70))
 	fmt.Println("       📋 Current Configuration")
 	fmt.Println(strings.Repeat("=", 70))
+	if name := ActiveProfile(); name != "" {
+		fmt.Printf("\nProfile:    %s\n", name)
+	}
 
 	if baseURL == "" && apiToken == "" && workspace == "" {
 		fmt.Println("\n❌ No configuration found.")
@@ -400,7 +420,9 @@ func ShowCurrentConfig() {
 
 // ValidateConfig validates that all required configuration is present
 func ValidateConfig() error {
-	godotenv.Load(".env")
+	if err := loadCredentials(); err != nil {
+		return err
+	}
 
 	missing := []string{}
 
@@ -421,10 +443,11 @@ func ValidateConfig() error {
 	return nil
 }
 
-// extractWorkspaceFromURL extracts workspace slug from Plane URL
-func extractWorkspaceSlug(url string) string {
-	// Pattern: https://project.example.com/api/v1/workspaces/{workspace}/...
-	re := regexp.MustCompile(`/workspaces/([^/]+)/`)
+// WorkspaceFromURL returns the workspace slug of a Plane URL that names
+// one, such as https://plane.example.com/api/v1/workspaces/{workspace}/...,
+// or "" when it doesn't
+func WorkspaceFromURL(url string) string {
+	re := regexp.MustCompile(`/workspaces/([^/?#]+)`)
 	matches := re.FindStringSubmatch(url)
 	if len(matches) > 1 {
 		return matches[1]
@@ -0,0 +1,179 @@
+// Package console adapts terminal input and output to the platform, so
+// interactive flows behave the same in a Unix shell, PowerShell and cmd.exe.
+package console
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+var (
+	ansiOnce sync.Once
+	ansi     bool
+)
+
+// SupportsANSI reports whether stdout is a terminal that renders ANSI escape
+// sequences such as hyperlinks. On Windows, virtual terminal processing is
+// enabled on the console first; older consoles without it report false.
+func SupportsANSI() bool {
+	ansiOnce.Do(func() {
+		ansi = supportsANSI(os.Stdout)
+	})
+	return ansi
+}
+
+// supportsANSI reports whether f is a terminal rendering ANSI sequences;
+// pipes and files never are
+func supportsANSI(f *os.File) bool {
+	return IsTerminal(f) && enableVirtualTerminal()
+}
+
+// SupportsColor reports whether stdout shows colored text: a terminal that
+// renders ANSI escape sequences, unless NO_COLOR is set (https://no-color.org)
+func SupportsColor() bool {
+	return os.Getenv("NO_COLOR") == "" && SupportsANSI()
+}
+
+// ColorReset ends the text started by Color
+const ColorReset = "\x1b[0m"
+
+// Color returns the escape sequence that sets the text color to a hex color
+// such as "#3a86ff", or "" when hex isn't a color. Terminals that don't
+// announce 24-bit color in COLORTERM get the closest of the 256 colors.
+func Color(hex string) string {
+	hex = strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if len(hex) != 6 || err != nil {
+		return ""
+	}
+	r, g, b := int(rgb>>16), int(rgb>>8&0xff), int(rgb&0xff)
+
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return fmt.Sprintf("\x1b[38;2;%d;%d;%dm", r, g, b)
+	}
+	// The 6x6x6 color cube of the 256 color palette
+	cube := func(v int) int { return (v*5 + 127) / 255 }
+	return fmt.Sprintf("\x1b[38;5;%dm", 16+36*cube(r)+6*cube(g)+cube(b))
+}
+
+// EOFHint names the keys that end multi-line input read from stdin
+func EOFHint() string {
+	if runtime.GOOS == "windows" {
+		return "Ctrl+Z then Enter"
+	}
+	return "Ctrl+D"
+}
+
+// ReadLines reads multi-line input until EOF or a line holding only Ctrl+Z,
+// which terminals such as mintty pass through instead of ending the input.
+// Windows line endings are converted to \n.
+func ReadLines(r io.Reader) (string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "\x1a" {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n"), scanner.Err()
+}
+
+// CleanPath tidies a file path typed at a prompt: surrounding quotes (added
+// by PowerShell, Explorer's "Copy as path" and drag and drop) are removed, a
+// leading ~ expands to the home directory and separators are converted to
+// the platform's.
+func CleanPath(path string) string {
+	path = strings.TrimSpace(path)
+	if len(path) >= 2 {
+		if q := path[0]; (q == '"' || q == '\'') && path[len(path)-1] == q {
+			path = path[1 : len(path)-1]
+		}
+	}
+
+	if path == "~" || strings.HasPrefix(path, "~/") || strings.HasPrefix(path, `~\`) {
+		if home, err := os.UserHomeDir(); err == nil {
+			// Skip the separator too: a backslash is a file name character on Unix
+			path = filepath.Join(home, path[min(len(path), 2):])
+		}
+	}
+
+	if path == "" {
+		return path
+	}
+	return filepath.Clean(filepath.FromSlash(path))
+}
+
+// IsTerminal reports whether f is connected to a terminal, e.g. to decide
+// whether progress updates that rewrite a line can be shown
+func IsTerminal(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
+
+// OpenURL opens u in the default browser, or in $BROWSER when set. It
+// returns once the browser has been started.
+func OpenURL(u string) error {
+	var cmd *exec.Cmd
+	switch {
+	case os.Getenv("BROWSER") != "":
+		cmd = exec.Command(os.Getenv("BROWSER"), u)
+	case runtime.GOOS == "darwin":
+		cmd = exec.Command("open", u)
+	case runtime.GOOS == "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go cmd.Wait()
+	return nil
+}
+
+// Notify shows a desktop notification: notify-send on Linux and BSD,
+// Notification Center on macOS and a tray balloon on Windows. It returns an
+// error when the notifier is missing or fails.
+func Notify(title, message string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		quote := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+		cmd = exec.Command("osascript", "-e",
+			fmt.Sprintf(`display notification "%s" with title "%s"`, quote.Replace(message), quote.Replace(title)))
+	case "windows":
+		// The text is passed in the environment to avoid quoting it for
+		// PowerShell
+		cmd = exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command",
+			"Add-Type -AssemblyName System.Windows.Forms; "+
+				"$n = New-Object System.Windows.Forms.NotifyIcon; "+
+				"$n.Icon = [System.Drawing.SystemIcons]::Information; $n.Visible = $true; "+
+				"$n.ShowBalloonTip(5000, $env:PLANE_NOTIFY_TITLE, $env:PLANE_NOTIFY_MESSAGE, 'Info'); "+
+				"Start-Sleep -Seconds 5; $n.Dispose()")
+		cmd.Env = append(os.Environ(), "PLANE_NOTIFY_TITLE="+title, "PLANE_NOTIFY_MESSAGE="+message)
+	default:
+		cmd = exec.Command("notify-send", title, message)
+	}
+	if output, err := cmd.CombinedOutput(); err != nil {
+		if msg := strings.TrimSpace(string(output)); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+//go:build !windows
+
+package console
+
+// enableVirtualTerminal is a no-op: Unix terminals process ANSI sequences
+func enableVirtualTerminal() bool {
+	return true
+}
@@ -0,0 +1,103 @@
+package console
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"unix line endings", "first\nsecond\n", "first\nsecond"},
+		{"windows line endings", "first\r\nsecond\r\n", "first\nsecond"},
+		{"mixed line endings", "first\r\nsecond\nthird\r\n", "first\nsecond\nthird"},
+		{"no final newline", "first\r\nsecond", "first\nsecond"},
+		{"ctrl+z ends the input", "first\n\x1a\nignored\n", "first"},
+		{"ctrl+z after windows line endings", "first\r\nsecond\r\n\x1a\r\nignored\r\n", "first\nsecond"},
+		{"ctrl+z inside a line is kept", "a\x1ab\n", "a\x1ab"},
+		{"blank lines are kept", "first\r\n\r\nthird\r\n", "first\n\nthird"},
+		{"empty input", "", ""},
+		{"only ctrl+z", "\x1a\r\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadLines(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("ReadLines(%q) error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ReadLines(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSupportsANSINotTerminal(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	file, err := os.Create(filepath.Join(t.TempDir(), "out.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	tests := []struct {
+		name string
+		f    *os.File
+	}{
+		{"pipe", w},
+		{"regular file", file},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if IsTerminal(tt.f) {
+				t.Errorf("IsTerminal(%s) = true, want false", tt.name)
+			}
+			if supportsANSI(tt.f) {
+				t.Errorf("supportsANSI(%s) = true, want false", tt.name)
+			}
+		})
+	}
+}
+
+func TestCleanPath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"plain", "docs/notes.md", filepath.Join("docs", "notes.md")},
+		{"surrounding spaces", "  notes.md \r\n", "notes.md"},
+		{"double quotes", `"my notes.md"`, "my notes.md"},
+		{"single quotes", `'my notes.md'`, "my notes.md"},
+		{"unbalanced quote is kept", `"notes.md`, `"notes.md`},
+		{"home", "~", home},
+		{"home with slash", "~/notes.md", filepath.Join(home, "notes.md")},
+		{"home with backslash", `~\notes.md`, filepath.Join(home, "notes.md")},
+		{"quoted home", `"~/my notes.md"`, filepath.Join(home, "my notes.md")},
+		{"empty", "", ""},
+		{"empty quotes", `""`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CleanPath(tt.input); got != tt.want {
+				t.Errorf("CleanPath(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
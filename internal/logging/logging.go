@@ -0,0 +1,100 @@
+// Package logging writes the CLI's diagnostics to stderr, keeping them out
+// of command output, with API tokens and other secrets redacted.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Level selects how much is logged
+type Level int
+
+const (
+	// Quiet logs nothing
+	Quiet Level = iota
+	// Verbose logs one line per API request and response
+	Verbose
+	// Debug also logs headers and bodies
+	Debug
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	// tokenPattern matches Plane API tokens wherever they appear
+	tokenPattern = regexp.MustCompile(`plane_api_[A-Za-z0-9]+`)
+	// secretFieldPattern matches the values of secret-looking fields in
+	// JSON, headers and query strings
+	secretFieldPattern = regexp.MustCompile(`(?i)("?(?:x-api-key|api[_-]?key|api[_-]?token|token|password|secret|x-amz-signature|x-amz-credential|signature)"?\s*[:=]\s*"?)[^"\s,&]+`)
+)
+
+// Logger writes redacted diagnostics at or below its level
+type Logger struct {
+	mu      sync.Mutex
+	w       io.Writer
+	level   Level
+	secrets []string
+}
+
+// New returns a logger writing to w. Every occurrence of the given secrets,
+// such as the configured API token, is redacted.
+func New(w io.Writer, level Level, secrets ...string) *Logger {
+	l := &Logger{w: w, level: level}
+	for _, s := range secrets {
+		// Very short values would redact ordinary words
+		if len(s) >= 8 {
+			l.secrets = append(l.secrets, s)
+		}
+	}
+	return l
+}
+
+// Infof logs a line in verbose and debug mode
+func (l *Logger) Infof(format string, args ...any) {
+	l.logf(Verbose, format, args...)
+}
+
+// Debugf logs a line in debug mode
+func (l *Logger) Debugf(format string, args ...any) {
+	l.logf(Debug, format, args...)
+}
+
+// DebugEnabled reports whether debug lines are logged, so callers can skip
+// collecting expensive details such as bodies
+func (l *Logger) DebugEnabled() bool {
+	return l != nil && l.level >= Debug
+}
+
+func (l *Logger) logf(level Level, format string, args ...any) {
+	if l == nil || l.level < level {
+		return
+	}
+	msg := l.Redact(fmt.Sprintf(format, args...))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, line := range strings.Split(strings.TrimRight(msg, "\n"), "\n") {
+		fmt.Fprintf(l.w, "[%s] %s\n", levelName(level), line)
+	}
+}
+
+// Redact masks the logger's secrets, Plane API tokens and the values of
+// secret-looking fields in s
+func (l *Logger) Redact(s string) string {
+	for _, secret := range l.secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+	s = tokenPattern.ReplaceAllString(s, redacted)
+	return secretFieldPattern.ReplaceAllString(s, "${1}"+redacted)
+}
+
+func levelName(level Level) string {
+	if level >= Debug {
+		return "debug"
+	}
+	return "info"
+}
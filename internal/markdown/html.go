@@ -0,0 +1,333 @@
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// token is a piece of parsed HTML
+type token struct {
+	text    string
+	tag     string
+	closing bool
+	attrs   map[string]string
+}
+
+var (
+	tagPattern  = regexp.MustCompile(`(?s)<(/?)([a-zA-Z][a-zA-Z0-9]*)([^>]*?)(/?)>`)
+	attrPattern = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+)))?`)
+	blankLines  = regexp.MustCompile(`\n{3,}`)
+	spaces      = regexp.MustCompile(`[ \t\r\n]+`)
+)
+
+// tokenize splits HTML into text and tag tokens. Comments are dropped.
+func tokenize(s string) []token {
+	s = regexp.MustCompile(`(?s)<!--.*?-->`).ReplaceAllString(s, "")
+
+	var tokens []token
+	last := 0
+	for _, m := range tagPattern.FindAllStringSubmatchIndex(s, -1) {
+		if m[0] > last {
+			tokens = append(tokens, token{text: s[last:m[0]]})
+		}
+		t := token{
+			tag:     strings.ToLower(s[m[4]:m[5]]),
+			closing: m[3] > m[2],
+			attrs:   make(map[string]string),
+		}
+		for _, a := range attrPattern.FindAllStringSubmatch(s[m[6]:m[7]], -1) {
+			value := a[2] + a[3] + a[4]
+			t.attrs[strings.ToLower(a[1])] = html.UnescapeString(value)
+		}
+		tokens = append(tokens, t)
+		last = m[1]
+	}
+	if last < len(s) {
+		tokens = append(tokens, token{text: s[last:]})
+	}
+	return tokens
+}
+
+// listContext tracks an open list while converting
+type listContext struct {
+	ordered bool
+	index   int
+	task    bool
+}
+
+// converter holds the state of a single HTML to Markdown conversion
+type converter struct {
+	out        strings.Builder
+	lists      []listContext
+	links      []string
+	quoteDepth int
+	inPre      bool
+	itemMarked bool
+}
+
+// FromHTML converts the HTML produced by Plane's editor into Markdown.
+// It understands headings, paragraphs, emphasis, links, images, code blocks,
+// block quotes, nested lists and task lists; unknown tags are dropped.
+func FromHTML(s string) string {
+	if !strings.Contains(s, "<") {
+		return strings.TrimSpace(html.UnescapeString(s))
+	}
+
+	c := &converter{}
+	for _, t := range tokenize(s) {
+		if t.tag == "" {
+			c.text(t.text)
+			continue
+		}
+		if t.closing {
+			c.end(t)
+		} else {
+			c.start(t)
+		}
+	}
+
+	result := blankLines.ReplaceAllString(c.out.String(), "\n\n")
+	return strings.TrimSpace(result)
+}
+
+func (c *converter) write(s string) {
+	c.out.WriteString(s)
+}
+
+// newline starts a new line keeping quote prefixes
+func (c *converter) newline() {
+	c.write("\n")
+	if c.quoteDepth > 0 {
+		c.write(strings.Repeat("> ", c.quoteDepth))
+	}
+}
+
+// blankLine ensures the next block starts after an empty line
+func (c *converter) blankLine() {
+	if len(c.lists) > 0 {
+		return
+	}
+	current := c.out.String()
+	if current == "" {
+		return
+	}
+	lines := strings.Split(current, "\n")
+	n := len(lines)
+	if isEmptyLine(lines[n-1]) && (n == 1 || isEmptyLine(lines[n-2])) {
+		return
+	}
+	if !isEmptyLine(lines[n-1]) {
+		c.newline()
+	}
+	c.newline()
+}
+
+// trimTrailingLines drops trailing lines that hold nothing but quote prefixes
+func (c *converter) trimTrailingLines() {
+	current := c.out.String()
+	for {
+		i := strings.LastIndex(current, "\n")
+		if i < 0 || !isEmptyLine(current[i+1:]) {
+			break
+		}
+		current = current[:i]
+	}
+	c.out.Reset()
+	c.out.WriteString(current)
+}
+
+// isEmptyLine reports whether a line holds nothing but quote prefixes
+func isEmptyLine(line string) bool {
+	return strings.Trim(line, "> ") == ""
+}
+
+func (c *converter) text(s string) {
+	if c.inPre {
+		c.write(html.UnescapeString(s))
+		return
+	}
+	s = spaces.ReplaceAllString(s, " ")
+	if strings.TrimSpace(s) == "" {
+		current := c.out.String()
+		if current != "" && !strings.HasSuffix(current, " ") && !strings.HasSuffix(current, "\n") {
+			c.write(" ")
+		}
+		return
+	}
+	current := c.out.String()
+	if current == "" || strings.HasSuffix(current, "\n") || strings.HasSuffix(current, "> ") || strings.HasSuffix(current, " ") {
+		s = strings.TrimLeft(s, " ")
+	}
+	c.write(html.UnescapeString(s))
+}
+
+func (c *converter) start(t token) {
+	switch t.tag {
+	case "p", "div", "section", "article":
+		c.blankLine()
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level, _ := strconv.Atoi(t.tag[1:])
+		c.blankLine()
+		c.write(strings.Repeat("#", level) + " ")
+	case "br":
+		c.newline()
+		if len(c.lists) > 0 {
+			c.write(strings.Repeat("  ", len(c.lists)))
+		}
+	case "hr":
+		c.blankLine()
+		c.write("---")
+		c.blankLine()
+	case "strong", "b":
+		c.write("**")
+	case "em", "i":
+		c.write("_")
+	case "s", "del", "strike":
+		c.write("~~")
+	case "code":
+		if !c.inPre {
+			c.write("`")
+		}
+	case "pre":
+		c.blankLine()
+		c.write("```")
+		if lang := t.attrs["data-language"]; lang != "" {
+			c.write(lang)
+		}
+		c.newline()
+		c.inPre = true
+	case "blockquote":
+		c.blankLine()
+		c.quoteDepth++
+		c.write("> ")
+	case "ul", "ol":
+		if len(c.lists) == 0 {
+			c.blankLine()
+		}
+		c.lists = append(c.lists, listContext{
+			ordered: t.tag == "ol",
+			task:    t.attrs["data-type"] == "taskList",
+		})
+	case "li":
+		c.startListItem(t)
+	case "input":
+		if t.attrs["type"] == "checkbox" && !c.itemMarked {
+			if _, checked := t.attrs["checked"]; checked {
+				c.write("[x] ")
+			} else {
+				c.write("[ ] ")
+			}
+			c.itemMarked = true
+		}
+	case "a":
+		c.links = append(c.links, t.attrs["href"])
+		c.write("[")
+	case "img":
+		src := t.attrs["src"]
+		if src != "" {
+			c.write("![" + t.attrs["alt"] + "](" + src + ")")
+		}
+	}
+}
+
+func (c *converter) startListItem(t token) {
+	if len(c.lists) == 0 {
+		c.lists = append(c.lists, listContext{})
+	}
+	current := &c.lists[len(c.lists)-1]
+	current.index++
+
+	if c.out.Len() > 0 {
+		c.newline()
+	}
+	c.write(strings.Repeat("  ", len(c.lists)-1))
+	if current.ordered {
+		c.write(strconv.Itoa(current.index) + ". ")
+	} else {
+		c.write("- ")
+	}
+
+	c.itemMarked = false
+	if checked, ok := t.attrs["data-checked"]; ok || current.task {
+		if checked == "true" {
+			c.write("[x] ")
+		} else {
+			c.write("[ ] ")
+		}
+		c.itemMarked = true
+	}
+}
+
+func (c *converter) end(t token) {
+	switch t.tag {
+	case "p", "div", "section", "article":
+		c.blankLine()
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		c.blankLine()
+	case "strong", "b":
+		c.write("**")
+	case "em", "i":
+		c.write("_")
+	case "s", "del", "strike":
+		c.write("~~")
+	case "code":
+		if !c.inPre {
+			c.write("`")
+		}
+	case "pre":
+		c.inPre = false
+		if !strings.HasSuffix(c.out.String(), "\n") {
+			c.newline()
+		}
+		c.write("```")
+		c.blankLine()
+	case "blockquote":
+		c.trimTrailingLines()
+		if c.quoteDepth > 0 {
+			c.quoteDepth--
+		}
+		c.blankLine()
+	case "ul", "ol":
+		if len(c.lists) > 0 {
+			c.lists = c.lists[:len(c.lists)-1]
+		}
+		if len(c.lists) == 0 {
+			c.blankLine()
+		}
+	case "a":
+		href := ""
+		if n := len(c.links); n > 0 {
+			href = c.links[n-1]
+			c.links = c.links[:n-1]
+		}
+		c.write("](" + href + ")")
+	}
+}
+
+var imagePattern = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)\)`)
+
+// ImageURLs returns the image URLs referenced by Markdown content, in order of appearance
+func ImageURLs(md string) []string {
+	var urls []string
+	seen := make(map[string]bool)
+	for _, m := range imagePattern.FindAllStringSubmatch(md, -1) {
+		if !seen[m[2]] {
+			seen[m[2]] = true
+			urls = append(urls, m[2])
+		}
+	}
+	return urls
+}
+
+// ReplaceImageURLs rewrites image URLs in Markdown content using the given mapping
+func ReplaceImageURLs(md string, mapping map[string]string) string {
+	return imagePattern.ReplaceAllStringFunc(md, func(m string) string {
+		parts := imagePattern.FindStringSubmatch(m)
+		if replacement, ok := mapping[parts[2]]; ok {
+			return "![" + parts[1] + "](" + replacement + ")"
+		}
+		return m
+	})
+}
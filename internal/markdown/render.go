@@ -0,0 +1,142 @@
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+// htmlStart matches content that is HTML already rather than Markdown
+var htmlStart = regexp.MustCompile(`^<(?:!--|[a-zA-Z][a-zA-Z0-9]*[\s/>])`)
+
+// planeMarkdown renders GitHub flavoured Markdown the way Plane's editor
+// stores it. Raw HTML is kept, since descriptions may mix both.
+var planeMarkdown = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithRendererOptions(
+		html.WithUnsafe(),
+		renderer.WithNodeRenderers(util.Prioritized(&planeRenderer{}, 100)),
+	),
+)
+
+// ToHTML converts Markdown into the HTML Plane's editor expects: headings,
+// paragraphs, emphasis, links, images, code blocks, tables and lists, with
+// task lists as the editor's task items and list item text in paragraphs.
+// Content that already is HTML is returned as it is.
+func ToHTML(md string) string {
+	md = strings.TrimSpace(md)
+	if md == "" || htmlStart.MatchString(md) {
+		return md
+	}
+
+	var buf bytes.Buffer
+	if err := planeMarkdown.Convert([]byte(md), &buf); err != nil {
+		// Never lose the text; Plane shows it unformatted
+		return "<p>" + htmlEscaper.Replace(md) + "</p>"
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+var htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\n", "<br>")
+
+// planeRenderer renders lists the way Plane's editor (TipTap) stores them;
+// everything else is left to goldmark's HTML renderer
+type planeRenderer struct{}
+
+func (r *planeRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindList, r.renderList)
+	reg.Register(ast.KindListItem, r.renderListItem)
+	reg.Register(ast.KindTextBlock, r.renderTextBlock)
+	reg.Register(extast.KindTaskCheckBox, r.renderTaskCheckBox)
+}
+
+func (r *planeRenderer) renderList(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*ast.List)
+	tag := "ul"
+	if n.IsOrdered() {
+		tag = "ol"
+	}
+	if !entering {
+		w.WriteString("</" + tag + ">\n")
+		return ast.WalkContinue, nil
+	}
+
+	w.WriteString("<" + tag)
+	switch {
+	case taskCheckBox(n.FirstChild()) != nil:
+		w.WriteString(` data-type="taskList"`)
+	case n.IsOrdered() && n.Start != 1:
+		w.WriteString(` start="` + strconv.Itoa(n.Start) + `"`)
+	}
+	w.WriteString(">\n")
+	return ast.WalkContinue, nil
+}
+
+func (r *planeRenderer) renderListItem(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	box := taskCheckBox(node)
+	if box == nil {
+		if entering {
+			w.WriteString("<li>")
+		} else {
+			w.WriteString("</li>\n")
+		}
+		return ast.WalkContinue, nil
+	}
+
+	if !entering {
+		w.WriteString("</div></li>\n")
+		return ast.WalkContinue, nil
+	}
+	if box.IsChecked {
+		w.WriteString(`<li data-type="taskItem" data-checked="true"><label><input type="checkbox" checked="checked"><span></span></label><div>`)
+	} else {
+		w.WriteString(`<li data-type="taskItem" data-checked="false"><label><input type="checkbox"><span></span></label><div>`)
+	}
+	return ast.WalkContinue, nil
+}
+
+// renderTextBlock wraps the text of tight list items in a paragraph, as the
+// editor requires
+func (r *planeRenderer) renderTextBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if _, ok := node.Parent().(*ast.ListItem); !ok {
+		if !entering && node.NextSibling() != nil && node.FirstChild() != nil {
+			w.WriteByte('\n')
+		}
+		return ast.WalkContinue, nil
+	}
+	if entering {
+		w.WriteString("<p>")
+	} else {
+		w.WriteString("</p>")
+	}
+	return ast.WalkContinue, nil
+}
+
+// renderTaskCheckBox drops the checkbox; the task item carries its state
+func (r *planeRenderer) renderTaskCheckBox(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		// Skip the space the parser leaves after the checkbox
+		if t, ok := node.NextSibling().(*ast.Text); ok {
+			t.Segment = t.Segment.TrimLeftSpace(source)
+		}
+	}
+	return ast.WalkContinue, nil
+}
+
+// taskCheckBox returns the checkbox a list item starts with, or nil
+func taskCheckBox(item ast.Node) *extast.TaskCheckBox {
+	if item == nil || item.FirstChild() == nil {
+		return nil
+	}
+	box, _ := item.FirstChild().FirstChild().(*extast.TaskCheckBox)
+	return box
+}
@@ -0,0 +1,166 @@
+package markdown
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ANSI escape sequences used by RenderANSI. Each style is turned off on its
+// own, so styles nest (a link inside bold text stays bold).
+const (
+	ansiBold      = "\x1b[1m"
+	ansiDim       = "\x1b[2m"
+	ansiNormal    = "\x1b[22m"
+	ansiItalic    = "\x1b[3m"
+	ansiNoItalic  = "\x1b[23m"
+	ansiUnderline = "\x1b[4m"
+	ansiNoUnder   = "\x1b[24m"
+	ansiStrike    = "\x1b[9m"
+	ansiNoStrike  = "\x1b[29m"
+	ansiCyan      = "\x1b[36m"
+	ansiGreen     = "\x1b[32m"
+	ansiDefault   = "\x1b[39m"
+)
+
+// ruleWidth is the width of rendered horizontal rules, matching the
+// separators printed by the commands
+const ruleWidth = 70
+
+var (
+	headingLine = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	quoteLine   = regexp.MustCompile(`^((?:>\s?)+)(.*)$`)
+	listLine    = regexp.MustCompile(`^(\s*)([-*+]|\d+\.)\s+(?:\[([ xX])\]\s+)?(.*)$`)
+	ruleLine    = regexp.MustCompile(`^\s*(?:-{3,}|\*{3,}|_{3,})\s*$`)
+
+	codeSpan    = regexp.MustCompile("`([^`]+)`")
+	linkPattern = regexp.MustCompile(`(!?)\[([^\]]*)\]\(([^)\s]+)\)`)
+	boldPattern = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	strikeSpan  = regexp.MustCompile(`~~([^~]+)~~`)
+	emPattern   = regexp.MustCompile(`(^|[^\w])_([^_]+)_([^\w]|$)`)
+	placeholder = regexp.MustCompile("\x00(\\d+)\x00")
+)
+
+// RenderANSI styles Markdown for a terminal: headings are bold, list
+// bullets and task checkboxes are drawn as symbols, code blocks are boxed,
+// block quotes get a bar and links are underlined with their URL after
+// them. Other text is printed as written.
+func RenderANSI(md string) string {
+	var out []string
+	var code []string
+	inCode, lang := false, ""
+
+	for _, line := range strings.Split(md, "\n") {
+		if fence, ok := strings.CutPrefix(strings.TrimSpace(line), "```"); ok {
+			if inCode {
+				out = append(out, codeBox(lang, code)...)
+				code, inCode = nil, false
+			} else {
+				inCode, lang = true, strings.TrimSpace(fence)
+			}
+			continue
+		}
+		if inCode {
+			code = append(code, line)
+			continue
+		}
+		out = append(out, renderLine(line))
+	}
+	// An unclosed fence still shows its code
+	if inCode {
+		out = append(out, codeBox(lang, code)...)
+	}
+	return strings.Join(out, "\n")
+}
+
+// renderLine styles a single line outside code blocks
+func renderLine(line string) string {
+	if ruleLine.MatchString(line) {
+		return ansiDim + strings.Repeat("─", ruleWidth) + ansiNormal
+	}
+	if m := headingLine.FindStringSubmatch(line); m != nil {
+		if len(m[1]) == 1 {
+			return ansiBold + ansiUnderline + renderInline(m[2]) + ansiNoUnder + ansiNormal
+		}
+		return ansiBold + renderInline(m[2]) + ansiNormal
+	}
+	if m := quoteLine.FindStringSubmatch(line); m != nil {
+		depth := strings.Count(m[1], ">")
+		return ansiDim + strings.Repeat("│ ", depth) + ansiNormal + renderLine(m[2])
+	}
+	if m := listLine.FindStringSubmatch(line); m != nil {
+		marker := "•"
+		if _, err := strconv.Atoi(strings.TrimSuffix(m[2], ".")); err == nil {
+			marker = m[2]
+		}
+		switch m[3] {
+		case " ":
+			marker = "☐"
+		case "x", "X":
+			return m[1] + ansiGreen + "☑" + ansiDefault + " " + ansiDim + renderInline(m[4]) + ansiNormal
+		}
+		return m[1] + marker + " " + renderInline(m[4])
+	}
+	return renderInline(line)
+}
+
+// renderInline styles emphasis, code spans and links within a line. Code
+// spans and links are set aside first so underscores and asterisks in them
+// are left alone.
+func renderInline(s string) string {
+	var held []string
+	hold := func(styled string) string {
+		held = append(held, styled)
+		return "\x00" + strconv.Itoa(len(held)-1) + "\x00"
+	}
+
+	s = codeSpan.ReplaceAllStringFunc(s, func(m string) string {
+		return hold(ansiCyan + codeSpan.FindStringSubmatch(m)[1] + ansiDefault)
+	})
+	s = linkPattern.ReplaceAllStringFunc(s, func(m string) string {
+		parts := linkPattern.FindStringSubmatch(m)
+		text, url := parts[2], parts[3]
+		if parts[1] == "!" {
+			if text == "" {
+				text = "image"
+			}
+			return hold("🖼  " + text + " " + ansiDim + "(" + url + ")" + ansiNormal)
+		}
+		if text == "" || text == url {
+			return hold(ansiUnderline + url + ansiNoUnder)
+		}
+		return hold(ansiUnderline + text + ansiNoUnder + " " + ansiDim + "(" + url + ")" + ansiNormal)
+	})
+
+	s = boldPattern.ReplaceAllString(s, ansiBold+"$1"+ansiNormal)
+	s = strikeSpan.ReplaceAllString(s, ansiStrike+"$1"+ansiNoStrike)
+	s = emPattern.ReplaceAllString(s, "$1"+ansiItalic+"$2"+ansiNoItalic+"$3")
+
+	return placeholder.ReplaceAllStringFunc(s, func(m string) string {
+		i, _ := strconv.Atoi(strings.Trim(m, "\x00"))
+		return held[i]
+	})
+}
+
+// codeBox draws a border around the lines of a code block, with its
+// language in the top border
+func codeBox(lang string, lines []string) []string {
+	width := utf8.RuneCountInString(lang) + 4
+	for i, line := range lines {
+		lines[i] = strings.ReplaceAll(line, "\t", "    ")
+		width = max(width, utf8.RuneCountInString(lines[i]))
+	}
+
+	top := strings.Repeat("─", width+2)
+	if lang != "" {
+		top = "─ " + lang + " " + strings.Repeat("─", width-utf8.RuneCountInString(lang)-1)
+	}
+	box := []string{ansiDim + "┌" + top + "┐" + ansiNormal}
+	for _, line := range lines {
+		pad := strings.Repeat(" ", width-utf8.RuneCountInString(line))
+		box = append(box, ansiDim+"│"+ansiNormal+" "+line+pad+" "+ansiDim+"│"+ansiNormal)
+	}
+	box = append(box, ansiDim+"└"+strings.Repeat("─", width+2)+"┘"+ansiNormal)
+	return box
+}
@@ -0,0 +1,90 @@
+package offline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// queueFile is the file holding the queue, under the offline directory
+const queueFile = "queue.json"
+
+// Operation is a command queued while the API was unreachable, to be run
+// again as it was given
+type Operation struct {
+	ID int `json:"id"`
+	// Args are the arguments of the command, without --queue
+	Args []string `json:"args"`
+	// Dir is the working directory, for arguments naming files
+	Dir       string `json:"dir"`
+	Profile   string `json:"profile,omitempty"`
+	Workspace string `json:"workspace"`
+	Project   string `json:"project,omitempty"`
+	// Targets are the work items the command changes, as given: PROJ-12,
+	// a sequence number or a UUID
+	Targets  []string  `json:"targets,omitempty"`
+	QueuedAt time.Time `json:"queued_at"`
+	Error    string    `json:"error"`
+}
+
+// Queue is the list of queued operations, oldest first
+type Queue struct {
+	NextID     int         `json:"next_id"`
+	Operations []Operation `json:"operations"`
+}
+
+// LoadQueue reads the queue under dir; a missing file is an empty queue
+func LoadQueue(dir string) (*Queue, error) {
+	data, err := os.ReadFile(filepath.Join(dir, queueFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return &Queue{NextID: 1}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read queue: %w", err)
+	}
+	var q Queue
+	if err := json.Unmarshal(data, &q); err != nil {
+		return nil, fmt.Errorf("invalid queue %s: %w", filepath.Join(dir, queueFile), err)
+	}
+	if q.NextID < 1 {
+		q.NextID = 1
+	}
+	return &q, nil
+}
+
+// Add appends an operation, numbering it
+func (q *Queue) Add(op Operation) Operation {
+	op.ID = q.NextID
+	q.NextID++
+	q.Operations = append(q.Operations, op)
+	return op
+}
+
+// Remove drops the operation with the given number
+func (q *Queue) Remove(id int) bool {
+	for i, op := range q.Operations {
+		if op.ID == id {
+			q.Operations = append(q.Operations[:i], q.Operations[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Save writes the queue under dir
+func (q *Queue) Save(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create offline directory: %w", err)
+	}
+	data, err := json.MarshalIndent(q, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode queue: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, queueFile), append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write queue: %w", err)
+	}
+	return nil
+}
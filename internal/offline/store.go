@@ -0,0 +1,118 @@
+// Package offline keeps a local copy of a project's work items and
+// metadata, downloaded by 'plane-cli sync', so commands can read it
+// without the API
+package offline
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"plane-cli/internal/plane"
+)
+
+// Store is the local copy of one project
+type Store struct {
+	Workspace string        `json:"workspace"`
+	Project   plane.Project `json:"project"`
+	SyncedAt  time.Time     `json:"synced_at"`
+	// CurrentUser is the ID of the token owner, for assignee:me
+	CurrentUser string           `json:"current_user,omitempty"`
+	WorkItems   []plane.WorkItem `json:"work_items"`
+	States      []plane.State    `json:"states"`
+	Labels      []plane.Label    `json:"labels"`
+	Members     []plane.Member   `json:"members"`
+	Modules     []plane.Module   `json:"modules"`
+	Cycles      []plane.Cycle    `json:"cycles,omitempty"`
+}
+
+// ErrNotSynced is returned when a project has no local copy yet
+var ErrNotSynced = errors.New("project has not been synced")
+
+// Path returns the file holding the copy of a project, under dir
+func Path(dir, workspace, projectID string) string {
+	return filepath.Join(dir, workspace, projectID+".json")
+}
+
+// Load reads the copy of a project
+func Load(dir, workspace, projectID string) (*Store, error) {
+	path := Path(dir, workspace, projectID)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotSynced
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read offline copy: %w", err)
+	}
+	var s Store
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("invalid offline copy %s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// LoadByIdentifier reads the copy of the project with the given
+// identifier, e.g. PROJ, among the synced projects of a workspace
+func LoadByIdentifier(dir, workspace, identifier string) (*Store, error) {
+	files, err := filepath.Glob(filepath.Join(dir, workspace, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		s, err := Load(dir, workspace, strings.TrimSuffix(filepath.Base(f), ".json"))
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(s.Project.Identifier, identifier) {
+			return s, nil
+		}
+	}
+	return nil, ErrNotSynced
+}
+
+// Save writes the copy, replacing the previous one at once so readers never
+// see a partial file
+func (s *Store) Save(dir string) error {
+	path := Path(dir, s.Workspace, s.Project.ID)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create offline directory: %w", err)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to encode offline copy: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write offline copy: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write offline copy: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write offline copy: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write offline copy: %w", err)
+	}
+	return nil
+}
+
+// Item returns the work item with the given ID or sequence number
+func (s *Store) Item(ref string) (*plane.WorkItem, bool) {
+	for i := range s.WorkItems {
+		item := &s.WorkItems[i]
+		if item.ID == ref || fmt.Sprint(item.SequenceID) == ref {
+			return item, true
+		}
+	}
+	return nil, false
+}
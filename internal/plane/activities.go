@@ -0,0 +1,44 @@
+package plane
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// GetWorkItemActivities retrieves the full history of a work item, oldest first
+func (c *Client) GetWorkItemActivities(projectID, workItemID string) ([]Activity, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/activities/", c.workspace, projectID, workItemID)
+
+	var activities []Activity
+	params := url.Values{}
+	params.Set("per_page", "100")
+	for {
+		var response ActivityListResponse
+		if err := c.getWithQuery(endpoint, params, &response); err != nil {
+			return nil, fmt.Errorf("failed to get activities: %w", err)
+		}
+		activities = append(activities, response.Results...)
+
+		if !response.NextPageResults || response.NextCursor == nil || *response.NextCursor == params.Get("cursor") {
+			break
+		}
+		params.Set("cursor", *response.NextCursor)
+	}
+
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].CreatedAt.Before(activities[j].CreatedAt)
+	})
+
+	return activities, nil
+}
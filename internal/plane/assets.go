@@ -0,0 +1,170 @@
+package plane
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+// maxAssetSize bounds the size of a downloaded asset or attachment
+const maxAssetSize = 100 << 20
+
+// DownloadAsset fetches a file referenced from a description, such as an
+// embedded image. Relative URLs are resolved against the base URL, and the
+// API token is only sent to the Plane host itself, also across redirects.
+func (c *Client) DownloadAsset(assetURL string) ([]byte, string, error) {
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, "", err
+	}
+	ref, err := url.Parse(assetURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid asset URL: %w", err)
+	}
+	u := base.ResolveReference(ref)
+
+	req, err := http.NewRequestWithContext(c.context(), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create request: %w", err)
+	}
+	if u.Host == base.Host {
+		req.Header.Set("X-API-Key", c.apiToken)
+	}
+
+	// Redirects to storage hosts must not carry the token along
+	redirects := *c.httpClient
+	redirects.CheckRedirect = func(next *http.Request, via []*http.Request) error {
+		if len(via) >= 10 {
+			return fmt.Errorf("stopped after 10 redirects")
+		}
+		if next.URL.Host != base.Host {
+			next.Header.Del("X-API-Key")
+		}
+		return nil
+	}
+	resp, err := c.send(&redirects, req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download asset: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, "", fmt.Errorf("failed to download asset: %w", newAPIError(resp))
+	}
+
+	data, err := readAsset(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read asset: %w", err)
+	}
+
+	return data, resp.Header.Get("Content-Type"), nil
+}
+
+// readAsset reads a downloaded file, refusing ones over maxAssetSize
+func readAsset(body io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(body, maxAssetSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxAssetSize {
+		return nil, fmt.Errorf("file is larger than %d MB", maxAssetSize>>20)
+	}
+	return data, nil
+}
+
+// assetUploadRequest asks the API for a presigned upload of a workspace asset
+type assetUploadRequest struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	Size      int    `json:"size"`
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// AssetUpload is the API response describing where to upload an asset
+type AssetUpload struct {
+	AssetID    string `json:"asset_id"`
+	AssetURL   string `json:"asset_url"`
+	UploadData struct {
+		URL    string            `json:"url"`
+		Fields map[string]string `json:"fields"`
+	} `json:"upload_data"`
+}
+
+// UploadAsset uploads a file to the workspace asset storage and returns the
+// URL to reference it from descriptions. The file is sent straight to the
+// presigned storage URL returned by the API, then marked as uploaded.
+func (c *Client) UploadAsset(projectID, name, contentType string, data []byte) (string, error) {
+	if c.workspace == "" {
+		return "", fmt.Errorf("workspace is not set")
+	}
+	if name == "" {
+		return "", fmt.Errorf("asset name is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/assets/", c.workspace)
+	request := &assetUploadRequest{Name: name, Type: contentType, Size: len(data), ProjectID: projectID}
+
+	var upload AssetUpload
+	if err := c.post(endpoint, request, &upload); err != nil {
+		return "", fmt.Errorf("failed to create asset: %w", err)
+	}
+	if upload.UploadData.URL == "" {
+		return "", fmt.Errorf("failed to create asset: no upload URL returned")
+	}
+
+	if err := c.uploadToStorage(&upload, name, data); err != nil {
+		return "", fmt.Errorf("failed to upload asset: %w", err)
+	}
+
+	endpoint = fmt.Sprintf("/api/v1/workspaces/%s/assets/%s/", c.workspace, upload.AssetID)
+	if err := c.patch(endpoint, map[string]bool{"is_uploaded": true}, nil); err != nil {
+		return "", fmt.Errorf("failed to confirm asset upload: %w", err)
+	}
+
+	if upload.AssetURL == "" {
+		return "", fmt.Errorf("failed to upload asset: no asset URL returned")
+	}
+	return upload.AssetURL, nil
+}
+
+// uploadToStorage sends a file to the presigned storage URL of an upload
+// with a multipart POST. The API token is not sent to the storage host.
+func (c *Client) uploadToStorage(upload *AssetUpload, name string, data []byte) error {
+	// Presigned POST: the policy fields come first and the file last
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for k, v := range upload.UploadData.Fields {
+		if err := form.WriteField(k, v); err != nil {
+			return err
+		}
+	}
+	part, err := form.CreateFormFile("file", name)
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(data); err != nil {
+		return err
+	}
+	if err := form.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(c.context(), http.MethodPost, upload.UploadData.URL, &body)
+	if err != nil {
+		return fmt.Errorf("failed to create upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	resp, err := c.send(c.httpClient, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return newAPIError(resp)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package plane
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// attachmentUploadRequest asks the API for a presigned upload of an attachment
+type attachmentUploadRequest struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Size int    `json:"size"`
+}
+
+// attachmentUpload is the API response describing where to upload an attachment
+type attachmentUpload struct {
+	AssetUpload
+	Attachment Attachment `json:"attachment"`
+}
+
+// ListAttachments retrieves the attachments of a work item
+func (c *Client) ListAttachments(projectID, workItemID string) ([]Attachment, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/attachments/", c.workspace, projectID, workItemID)
+
+	var attachments []Attachment
+	if err := c.get(endpoint, &attachments); err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// UploadAttachment attaches a file to a work item. Like UploadAsset, the file
+// is sent to the presigned storage URL returned by the API and then marked
+// as uploaded.
+func (c *Client) UploadAttachment(projectID, workItemID, name, contentType string, data []byte) (*Attachment, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+	if name == "" {
+		return nil, fmt.Errorf("attachment name is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/attachments/", c.workspace, projectID, workItemID)
+	request := &attachmentUploadRequest{Name: name, Type: contentType, Size: len(data)}
+
+	var upload attachmentUpload
+	if err := c.post(endpoint, request, &upload); err != nil {
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+	if upload.UploadData.URL == "" {
+		return nil, fmt.Errorf("failed to create attachment: no upload URL returned")
+	}
+
+	if err := c.uploadToStorage(&upload.AssetUpload, name, data); err != nil {
+		return nil, fmt.Errorf("failed to upload attachment: %w", err)
+	}
+
+	endpoint = fmt.Sprintf("%s%s/", endpoint, upload.AssetID)
+	if err := c.patch(endpoint, map[string]bool{"is_uploaded": true}, nil); err != nil {
+		return nil, fmt.Errorf("failed to confirm attachment upload: %w", err)
+	}
+
+	attachment := upload.Attachment
+	if attachment.ID == "" {
+		attachment.ID = upload.AssetID
+	}
+	if attachment.Attributes.Name == "" {
+		attachment.Attributes = AttachmentAttributes{Name: name, Type: contentType, Size: int64(len(data))}
+	}
+	attachment.IsUploaded = true
+	return &attachment, nil
+}
+
+// DownloadAttachment fetches the file of an attachment. The API answers with
+// a redirect to presigned storage, which is followed without the API token.
+func (c *Client) DownloadAttachment(projectID, workItemID, attachmentID string) ([]byte, string, error) {
+	if c.workspace == "" {
+		return nil, "", fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, "", fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, "", fmt.Errorf("work item ID is required")
+	}
+	if attachmentID == "" {
+		return nil, "", fmt.Errorf("attachment ID is required")
+	}
+
+	u, err := c.endpointURL(fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/attachments/%s/", c.workspace, projectID, workItemID, attachmentID))
+	if err != nil {
+		return nil, "", err
+	}
+	req, err := http.NewRequestWithContext(c.context(), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-API-Key", c.apiToken)
+
+	noRedirect := *c.httpClient
+	noRedirect.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	resp, err := c.send(&noRedirect, req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download attachment: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 300 && resp.StatusCode < 400:
+		location, err := resp.Location()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to download attachment: %w", err)
+		}
+		return c.DownloadAsset(location.String())
+	case resp.StatusCode >= 400:
+		return nil, "", fmt.Errorf("failed to download attachment: %w", newAPIError(resp))
+	}
+
+	data, err := readAsset(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read attachment: %w", err)
+	}
+	return data, resp.Header.Get("Content-Type"), nil
+}
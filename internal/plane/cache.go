@@ -0,0 +1,99 @@
+package plane
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ResponseCache stores API responses between runs. Entries are versioned;
+// a lookup with a different version than the stored one is a miss.
+type ResponseCache interface {
+	Get(key, version string) ([]byte, bool)
+	Put(key, version string, data []byte) error
+}
+
+// WithCache makes the client reuse cached work item details whose
+// updated_at has not changed instead of downloading them again
+func WithCache(cache ResponseCache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+func workItemCacheKey(workItemID string) string {
+	return "work-item/" + workItemID
+}
+
+func workItemCacheVersion(updatedAt time.Time) string {
+	return updatedAt.UTC().Format(time.RFC3339Nano)
+}
+
+// CachedWorkItem returns the cached detail of a work item if it was cached
+// at the given updated_at
+func (c *Client) CachedWorkItem(workItemID string, updatedAt time.Time) (*WorkItem, bool) {
+	if c.cache == nil || workItemID == "" || updatedAt.IsZero() {
+		return nil, false
+	}
+	data, ok := c.cache.Get(workItemCacheKey(workItemID), workItemCacheVersion(updatedAt))
+	if !ok {
+		return nil, false
+	}
+	var item WorkItem
+	if err := json.Unmarshal(data, &item); err != nil {
+		return nil, false
+	}
+	return &item, true
+}
+
+// CacheWorkItem stores the detail of a work item. Failures are ignored as
+// the cache only saves bandwidth.
+func (c *Client) CacheWorkItem(item *WorkItem) {
+	if c.cache == nil || item == nil || item.ID == "" || item.UpdatedAt.IsZero() {
+		return
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return
+	}
+	_ = c.cache.Put(workItemCacheKey(item.ID), workItemCacheVersion(item.UpdatedAt), data)
+}
+
+// HasCache reports whether the client was created with a response cache
+func (c *Client) HasCache() bool {
+	return c.cache != nil
+}
+
+// getWorkItem fetches a work item detail, through the cache when one is set
+func (c *Client) getWorkItem(endpoint string, result *WorkItem) error {
+	if c.cache != nil {
+		return c.getWorkItemCached(endpoint, result)
+	}
+	return c.get(endpoint, result)
+}
+
+// getWorkItemCached fetches a work item detail through the cache. Only the
+// ID and updated_at are requested first; the full body is downloaded when
+// the cached copy is missing or out of date.
+func (c *Client) getWorkItemCached(endpoint string, result *WorkItem) error {
+	var header WorkItem
+	query := url.Values{}
+	query.Set("fields", "id,updated_at")
+	if err := c.getWithQuery(endpoint, query, &header); err != nil {
+		return err
+	}
+	if cached, ok := c.CachedWorkItem(header.ID, header.UpdatedAt); ok {
+		*result = *cached
+		return nil
+	}
+
+	if err := c.get(endpoint, result); err != nil {
+		return err
+	}
+	if result.ID == "" {
+		return fmt.Errorf("work item response has no ID")
+	}
+	c.CacheWorkItem(result)
+	return nil
+}
@@ -0,0 +1,64 @@
+package plane
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// Change is a request that modified data, i.e. any request but a GET
+type Change struct {
+	Method   string
+	Endpoint string
+	// Status is the HTTP status of the response, 0 when there was none
+	Status int
+	// CreatedID is the ID of the object a POST created, when the response
+	// names one
+	CreatedID string
+	Err       error
+}
+
+// ChangeNotifyFunc is called after every request that modified data, once
+// its outcome is known
+type ChangeNotifyFunc func(Change)
+
+// WithChangeNotify calls fn after every POST, PATCH, PUT and DELETE request
+func WithChangeNotify(fn ChangeNotifyFunc) ClientOption {
+	return func(c *Client) {
+		c.onChange = fn
+	}
+}
+
+// notifyChange reports the outcome of a request to the change callback.
+// The body of a successful POST is read to find the ID of what it created
+// and put back for the caller.
+func (c *Client) notifyChange(method, endpoint string, resp *http.Response, err error) {
+	if c.onChange == nil || method == http.MethodGet || method == http.MethodHead {
+		return
+	}
+
+	change := Change{Method: method, Endpoint: endpoint, Err: err}
+	var apiErr *APIError
+	switch {
+	case resp != nil:
+		change.Status = resp.StatusCode
+	case errors.As(err, &apiErr):
+		change.Status = apiErr.StatusCode
+	}
+	if resp != nil && method == http.MethodPost && resp.Body != nil {
+		data, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if readErr == nil {
+			var created struct {
+				ID string `json:"id"`
+			}
+			if json.Unmarshal(data, &created) == nil {
+				change.CreatedID = created.ID
+			}
+		}
+	}
+	c.onChange(change)
+}
@@ -2,6 +2,7 @@ package plane
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -18,6 +19,16 @@ type Client struct {
 	apiToken   string
 	httpClient *http.Client
 	workspace  string
+	strict     bool
+	cache      ResponseCache
+	ctx        context.Context
+	maxRetries int
+	onRetry    RetryNotifyFunc
+	limiter    *tokenBucket
+	logger     Logger
+	pageSize   int
+	onChange   ChangeNotifyFunc
+	metadata   *metadataCache
 }
 
 // ClientOption allows customizing the client
@@ -37,6 +48,22 @@ func WithWorkspace(workspace string) ClientOption {
 	}
 }
 
+// WithStrict makes the client reject responses containing fields that the
+// client types do not know about, to catch drift in the server schema
+func WithStrict(strict bool) ClientOption {
+	return func(c *Client) {
+		c.strict = strict
+	}
+}
+
+// WithContext makes every request of the client use ctx, so cancelling it
+// aborts requests in flight and a deadline on it bounds the whole command
+func WithContext(ctx context.Context) ClientOption {
+	return func(c *Client) {
+		c.ctx = ctx
+	}
+}
+
 // NewClient creates a new Plane API client
 func NewClient(baseURL, apiToken string, options ...ClientOption) (*Client, error) {
 	// Validate inputs
@@ -74,9 +101,26 @@ func (c *Client) SetWorkspace(workspace string) {
 	c.workspace = workspace
 }
 
-// doRequest makes an HTTP request to the API
-func (c *Client) doRequest(method, endpoint string, body interface{}) (*http.Response, error) {
-	// Build full URL
+// BaseURL returns the API base URL of the client
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// Workspace returns the workspace of subsequent API calls
+func (c *Client) Workspace() string {
+	return c.workspace
+}
+
+// context returns the context requests are made with
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// endpointURL builds the full URL of an API endpoint
+func (c *Client) endpointURL(endpoint string) (*url.URL, error) {
 	u, err := url.Parse(c.baseURL)
 	if err != nil {
 		return nil, err
@@ -89,6 +133,19 @@ func (c *Client) doRequest(method, endpoint string, body interface{}) (*http.Res
 	if hasTrailingSlash && !strings.HasSuffix(u.Path, "/") {
 		u.Path = u.Path + "/"
 	}
+	return u, nil
+}
+
+// doRequest makes an HTTP request to the API
+func (c *Client) doRequest(method, endpoint string, body interface{}) (*http.Response, error) {
+	if resp, ok := c.cachedResponse(method, endpoint); ok {
+		return resp, nil
+	}
+
+	u, err := c.endpointURL(endpoint)
+	if err != nil {
+		return nil, err
+	}
 
 	// Marshal body if provided
 	var bodyReader io.Reader
@@ -102,7 +159,7 @@ func (c *Client) doRequest(method, endpoint string, body interface{}) (*http.Res
 	}
 
 	// Create request
-	req, err := http.NewRequest(method, u.String(), bodyReader)
+	req, err := http.NewRequestWithContext(c.context(), method, u.String(), bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -120,18 +177,23 @@ func (c *Client) doRequest(method, endpoint string, body interface{}) (*http.Res
 	}
 
 	// Execute request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(c.httpClient, req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		err = fmt.Errorf("request failed: %w", err)
+		c.notifyChange(method, endpoint, nil, err)
+		return nil, err
 	}
 
 	// Check for HTTP errors
 	if resp.StatusCode >= 400 {
-		body, _ := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+		defer resp.Body.Close()
+		apiErr := newAPIError(resp)
+		c.notifyChange(method, endpoint, nil, apiErr)
+		return nil, apiErr
 	}
 
+	c.recordResponse(method, endpoint, resp)
+	c.notifyChange(method, endpoint, resp, nil)
 	return resp, nil
 }
 
@@ -144,8 +206,8 @@ func (c *Client) get(endpoint string, result interface{}) error {
 	defer resp.Body.Close()
 
 	if result != nil {
-		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
+		if err := c.decode(resp.Body, result); err != nil {
+			return err
 		}
 	}
 
@@ -163,7 +225,7 @@ func (c *Client) getWithQuery(endpoint string, query url.Values, result interfac
 	u.RawQuery = query.Encode()
 
 	// Create request
-	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	req, err := http.NewRequestWithContext(c.context(), http.MethodGet, u.String(), nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
@@ -174,29 +236,95 @@ func (c *Client) getWithQuery(endpoint string, query url.Values, result interfac
 	req.Header.Set("Accept", "application/json")
 
 	// Execute request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(c.httpClient, req)
 	if err != nil {
 		return fmt.Errorf("request failed: %w", err)
 	}
 
 	// Check for HTTP errors
 	if resp.StatusCode >= 400 {
-		body, _ := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		return fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+		defer resp.Body.Close()
+		return newAPIError(resp)
 	}
 
 	defer resp.Body.Close()
 
 	if result != nil {
-		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
+		if err := c.decode(resp.Body, result); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// Validators holds the cache validators of a previous response. Sending them
+// back makes the server answer 304 Not Modified when nothing has changed.
+type Validators struct {
+	ETag         string
+	LastModified string
+}
+
+// IsZero reports whether no validators were captured
+func (v Validators) IsZero() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
+// getConditional makes a conditional GET request. When the server answers
+// 304 Not Modified, notModified is true and result is left untouched.
+func (c *Client) getConditional(endpoint string, query url.Values, prev Validators, result interface{}) (Validators, bool, error) {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return prev, false, err
+	}
+	hasTrailingSlash := strings.HasSuffix(endpoint, "/")
+	u.Path = path.Join(u.Path, endpoint)
+	if hasTrailingSlash && !strings.HasSuffix(u.Path, "/") {
+		u.Path = u.Path + "/"
+	}
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(c.context(), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return prev, false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("X-API-Key", c.apiToken)
+	req.Header.Set("Accept", "application/json")
+	if prev.ETag != "" {
+		req.Header.Set("If-None-Match", prev.ETag)
+	}
+	if prev.LastModified != "" {
+		req.Header.Set("If-Modified-Since", prev.LastModified)
+	}
+
+	resp, err := c.send(c.httpClient, req)
+	if err != nil {
+		return prev, false, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return prev, true, nil
+	}
+	if resp.StatusCode >= 400 {
+		return prev, false, newAPIError(resp)
+	}
+
+	next := Validators{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+
+	if result != nil {
+		if err := c.decode(resp.Body, result); err != nil {
+			return prev, false, err
+		}
+	}
+
+	return next, false, nil
+}
+
 // post makes a POST request
 func (c *Client) post(endpoint string, body, result interface{}) error {
 	resp, err := c.doRequest(http.MethodPost, endpoint, body)
@@ -206,8 +334,8 @@ func (c *Client) post(endpoint string, body, result interface{}) error {
 	defer resp.Body.Close()
 
 	if result != nil {
-		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
+		if err := c.decode(resp.Body, result); err != nil {
+			return err
 		}
 	}
 
@@ -223,8 +351,8 @@ func (c *Client) patch(endpoint string, body, result interface{}) error {
 	defer resp.Body.Close()
 
 	if result != nil {
-		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
+		if err := c.decode(resp.Body, result); err != nil {
+			return err
 		}
 	}
 
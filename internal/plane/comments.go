@@ -0,0 +1,113 @@
+package plane
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ListComments retrieves the comments of a work item
+func (c *Client) ListComments(projectID, workItemID string) ([]Comment, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/comments/", c.workspace, projectID, workItemID)
+
+	var comments []Comment
+	params := url.Values{}
+	params.Set("per_page", "100")
+	for {
+		var response CommentListResponse
+		if err := c.getWithQuery(endpoint, params, &response); err != nil {
+			return nil, fmt.Errorf("failed to get comments: %w", err)
+		}
+		comments = append(comments, response.Results...)
+
+		if !response.NextPageResults || response.NextCursor == nil || *response.NextCursor == params.Get("cursor") {
+			break
+		}
+		params.Set("cursor", *response.NextCursor)
+	}
+
+	return comments, nil
+}
+
+// CreateComment adds a comment to a work item
+func (c *Client) CreateComment(projectID, workItemID string, create *CommentCreate) (*Comment, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+	if create == nil || create.CommentHTML == "" {
+		return nil, fmt.Errorf("comment text is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/comments/", c.workspace, projectID, workItemID)
+
+	var comment Comment
+	if err := c.post(endpoint, create, &comment); err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// UpdateComment changes the text of a comment
+func (c *Client) UpdateComment(projectID, workItemID, commentID string, update *CommentUpdate) (*Comment, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+	if commentID == "" {
+		return nil, fmt.Errorf("comment ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/comments/%s/", c.workspace, projectID, workItemID, commentID)
+
+	var comment Comment
+	if err := c.patch(endpoint, update, &comment); err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// DeleteComment deletes a comment
+func (c *Client) DeleteComment(projectID, workItemID, commentID string) error {
+	if c.workspace == "" {
+		return fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return fmt.Errorf("work item ID is required")
+	}
+	if commentID == "" {
+		return fmt.Errorf("comment ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/comments/%s/", c.workspace, projectID, workItemID, commentID)
+
+	if err := c.delete(endpoint); err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	return nil
+}
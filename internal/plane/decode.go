@@ -0,0 +1,151 @@
+package plane
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SchemaDriftError reports response fields that the client types do not
+// declare. It is only returned in strict mode.
+type SchemaDriftError struct {
+	Type   string
+	Fields []string
+}
+
+func (e *SchemaDriftError) Error() string {
+	return fmt.Sprintf("strict mode: response has fields not declared in %s: %s",
+		e.Type, strings.Join(e.Fields, ", "))
+}
+
+// decode decodes a response body into result. In strict mode unknown fields
+// are rejected and every one of them is listed, not just the first.
+func (c *Client) decode(body io.Reader, result interface{}) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if !c.strict {
+		if err := json.Unmarshal(data, result); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(result); err != nil {
+		if !strings.HasPrefix(err.Error(), "json: unknown field") {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		var raw interface{}
+		if jsonErr := json.Unmarshal(data, &raw); jsonErr != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		t := reflect.TypeOf(result)
+		seen := make(map[string]bool)
+		collectUnknownFields(raw, t, "", seen)
+
+		fields := make([]string, 0, len(seen))
+		for f := range seen {
+			fields = append(fields, f)
+		}
+		sort.Strings(fields)
+
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		return &SchemaDriftError{Type: t.String(), Fields: fields}
+	}
+
+	return nil
+}
+
+// collectUnknownFields walks a decoded JSON value alongside the Go type it is
+// decoded into and records the paths of object keys with no matching field
+func collectUnknownFields(value interface{}, t reflect.Type, path string, unknown map[string]bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for key, child := range v {
+				field, ok := fields[key]
+				if !ok {
+					field, ok = fields[strings.ToLower(key)]
+				}
+				if !ok {
+					unknown[joinPath(path, key)] = true
+					continue
+				}
+				collectUnknownFields(child, field, joinPath(path, key), unknown)
+			}
+		case reflect.Map:
+			for key, child := range v {
+				collectUnknownFields(child, t.Elem(), joinPath(path, key), unknown)
+			}
+		}
+
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, child := range v {
+				collectUnknownFields(child, t.Elem(), path+"[]", unknown)
+			}
+		}
+	}
+}
+
+// jsonFields maps the JSON names of a struct's fields to their types,
+// including fields promoted from embedded structs. Names are also indexed in
+// lower case, since encoding/json matches keys case-insensitively.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			embedded := f.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for k, v := range jsonFields(embedded) {
+					fields[k] = v
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+		fields[strings.ToLower(name)] = f.Type
+	}
+	return fields
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,96 @@
+package plane
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when the server answers a request with an error
+// status, so callers can tell an expired token from a missing work item
+// or a rate limit
+type APIError struct {
+	StatusCode int
+	// Code is the machine-readable error code of the response, when the
+	// server sent one
+	Code string
+	// Detail is the error message of the response, or its body when it
+	// isn't JSON
+	Detail   string
+	Method   string
+	Endpoint string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API error %d", e.StatusCode)
+	if e.Endpoint != "" {
+		msg += fmt.Sprintf(" (%s %s)", e.Method, e.Endpoint)
+	}
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	return msg
+}
+
+// newAPIError builds the error of a failed response, reading its body
+func newAPIError(resp *http.Response) *APIError {
+	e := &APIError{StatusCode: resp.StatusCode}
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.Endpoint = resp.Request.URL.Path
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	e.Code, e.Detail = parseErrorBody(body)
+	return e
+}
+
+// parseErrorBody extracts the code and message of an error response. Plane
+// answers with {"error": ...}, {"detail": ...} or a map of field errors;
+// anything else is kept as text.
+func parseErrorBody(body []byte) (code, detail string) {
+	text := strings.TrimSpace(string(body))
+
+	var fields map[string]any
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return "", truncateDetail(text)
+	}
+
+	for _, key := range []string{"code", "error_code"} {
+		if v, ok := fields[key]; ok {
+			code = fmt.Sprint(v)
+			break
+		}
+	}
+	for _, key := range []string{"detail", "error", "message"} {
+		if v, ok := fields[key].(string); ok && v != "" {
+			return code, v
+		}
+	}
+	return code, truncateDetail(text)
+}
+
+func truncateDetail(s string) string {
+	if r := []rune(s); len(r) > 500 {
+		return string(r[:500]) + "..."
+	}
+	return s
+}
+
+// StatusCode returns the HTTP status of an API error anywhere in err's
+// chain, or 0 when err isn't one
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is a 404 from the API
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
@@ -0,0 +1,78 @@
+package plane
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxLoggedBody is how much of a request or response body is logged
+const maxLoggedBody = 4096
+
+// Logger receives the client's diagnostics. The client never writes to
+// stdout or stderr itself.
+type Logger interface {
+	// Infof logs one line per request and response
+	Infof(format string, args ...any)
+	// Debugf logs headers and bodies
+	Debugf(format string, args ...any)
+	// DebugEnabled reports whether Debugf output is kept, so bodies are
+	// only read when they will be logged
+	DebugEnabled() bool
+}
+
+// WithLogger sends request diagnostics to l
+func WithLogger(l Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = l
+	}
+}
+
+// do executes one HTTP request, logging it and its response
+func (c *Client) do(hc *http.Client, req *http.Request) (*http.Response, error) {
+	if c.logger == nil {
+		return hc.Do(req)
+	}
+
+	c.logger.Infof("→ %s %s", req.Method, req.URL.Redacted())
+	if c.logger.DebugEnabled() {
+		for name, values := range req.Header {
+			c.logger.Debugf("  %s: %s", name, values[0])
+		}
+		if req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				data, _ := io.ReadAll(io.LimitReader(body, maxLoggedBody))
+				body.Close()
+				if len(data) > 0 {
+					c.logger.Debugf("  body: %s", data)
+				}
+			}
+		}
+	}
+
+	start := time.Now()
+	resp, err := hc.Do(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		c.logger.Infof("✗ %s %s failed after %s: %v", req.Method, req.URL.Path, elapsed, err)
+		return nil, err
+	}
+	c.logger.Infof("← %d %s %s (%s)", resp.StatusCode, req.Method, req.URL.Path, elapsed)
+
+	if c.logger.DebugEnabled() {
+		data, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if readErr != nil {
+			return nil, readErr
+		}
+		if len(data) > maxLoggedBody {
+			data = append(data[:maxLoggedBody:maxLoggedBody], "..."...)
+		}
+		if len(data) > 0 {
+			c.logger.Debugf("  response: %s", data)
+		}
+	}
+	return resp, nil
+}
@@ -1,8 +1,10 @@
 package plane
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 )
 
 // GetWorkspaceMembers retrieves all members in the workspace
@@ -19,30 +21,7 @@ func (c *Client) GetWorkspaceMembers() ([]Member, error) {
 	}
 	defer resp.Body.Close()
 
-	// Try to decode as array first (direct response)
-	var membersArray []Member
-	if err := json.NewDecoder(resp.Body).Decode(&membersArray); err == nil {
-		return membersArray, nil
-	}
-
-	// If that fails, try as object with results field
-	var response struct {
-		Count   int      `json:"count"`
-		Results []Member `json:"results"`
-	}
-
-	// Need to re-read body, so make request again
-	resp2, err := c.doRequest("GET", endpoint, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get workspace members: %w", err)
-	}
-	defer resp2.Body.Close()
-
-	if err := json.NewDecoder(resp2.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return response.Results, nil
+	return c.decodeMembers(resp.Body)
 }
 
 // GetProjectMembers retrieves all members assigned to a project
@@ -62,32 +41,60 @@ func (c *Client) GetProjectMembers(projectID string) ([]Member, error) {
 	}
 	defer resp.Body.Close()
 
-	// Try to decode as array first (direct response)
-	var membersArray []Member
-	if err := json.NewDecoder(resp.Body).Decode(&membersArray); err == nil {
+	return c.decodeMembers(resp.Body)
+}
+
+// decodeMembers decodes a member listing, which the API returns either as a
+// plain array or as an object with a results field
+func (c *Client) decodeMembers(body io.Reader) ([]Member, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var membersArray []Member
+		if err := c.decode(bytes.NewReader(data), &membersArray); err != nil {
+			return nil, err
+		}
 		return membersArray, nil
 	}
 
-	// If that fails, try as object with results field
 	var response struct {
 		Count   int      `json:"count"`
 		Results []Member `json:"results"`
 	}
-
-	// Need to re-read body, so make request again
-	resp2, err := c.doRequest("GET", endpoint, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get project members: %w", err)
-	}
-	defer resp2.Body.Close()
-
-	if err := json.NewDecoder(resp2.Body).Decode(&response); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	if err := c.decode(bytes.NewReader(data), &response); err != nil {
+		return nil, err
 	}
 
 	return response.Results, nil
 }
 
+// Member roles as returned by the API
+const (
+	RoleGuest  = 5
+	RoleViewer = 10
+	RoleMember = 15
+	RoleAdmin  = 20
+)
+
+// RoleName returns a readable name for a member role
+func RoleName(role int) string {
+	switch role {
+	case RoleAdmin:
+		return "admin"
+	case RoleMember:
+		return "member"
+	case RoleViewer:
+		return "viewer"
+	case RoleGuest:
+		return "guest"
+	default:
+		return ""
+	}
+}
+
 // Helper to get display name for a member
 func (m *Member) GetDisplayName() string {
 	if m.DisplayName != "" {
@@ -101,3 +108,34 @@ func (m *Member) GetDisplayName() string {
 	}
 	return m.Email
 }
+
+// GetCurrentUser retrieves the user that owns the API token
+func (c *Client) GetCurrentUser() (*Member, error) {
+	var user Member
+	if err := c.get("/api/v1/users/me/", &user); err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	return &user, nil
+}
+
+// GetUserWorkspaces retrieves the workspaces the user that owns the API
+// token belongs to
+func (c *Client) GetUserWorkspaces() ([]Workspace, error) {
+	// Plain lists and paginated responses are both accepted
+	var raw json.RawMessage
+	if err := c.get("/api/v1/users/me/workspaces/", &raw); err != nil {
+		return nil, fmt.Errorf("failed to get workspaces: %w", err)
+	}
+	var workspaces []Workspace
+	if err := json.Unmarshal(raw, &workspaces); err == nil {
+		return workspaces, nil
+	}
+	var response struct {
+		Results []Workspace `json:"results"`
+	}
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode workspaces: %w", err)
+	}
+	return response.Results, nil
+}
@@ -0,0 +1,227 @@
+package plane
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// Metadata resources kept by WithMetadataCache
+const (
+	MetadataProjects = "projects"
+	MetadataMembers  = "members"
+	MetadataStates   = "states"
+	MetadataLabels   = "labels"
+	MetadataModules  = "modules"
+	MetadataCycles   = "cycles"
+)
+
+// MetadataResources lists the resources WithMetadataCache can keep
+var MetadataResources = []string{MetadataProjects, MetadataMembers, MetadataStates, MetadataLabels, MetadataModules, MetadataCycles}
+
+var (
+	// metadataListPattern matches the endpoints listing a metadata resource
+	metadataListPattern = regexp.MustCompile(`^/api/v1/workspaces/[^/]+/(?:(projects|members)|projects/[^/]+/(members|states|labels|modules|cycles))/$`)
+	// metadataChangePattern matches the endpoints whose changes make a
+	// metadata listing stale
+	metadataChangePattern = regexp.MustCompile(`^/api/v1/workspaces/[^/]+/(?:projects/[^/]+/(members|states|labels|modules|cycles)/|(projects)/(?:[^/]+/?)?$|(members)/)`)
+)
+
+// metadataEntry is a listing of a metadata resource
+type metadataEntry struct {
+	Resource  string          `json:"resource"`
+	FetchedAt time.Time       `json:"fetched_at"`
+	Body      json.RawMessage `json:"body"`
+}
+
+// metadataCache keeps listings of projects, members, states, labels,
+// modules and cycles in memory for the client's lifetime and, optionally,
+// on disk between runs. A listing is reused while it is younger than the
+// resource's TTL and no request through the client or an earlier run has
+// changed the resource since.
+type metadataCache struct {
+	baseURL string
+	ttl     map[string]time.Duration
+	disk    ResponseCache
+
+	mu      sync.Mutex
+	entries map[string]metadataEntry
+	// changed is when each resource was last changed in this run
+	changed map[string]time.Time
+}
+
+// metadataVersion is the version of metadata entries in the disk cache
+const metadataVersion = "metadata-1"
+
+// WithMetadataCache keeps the listings of metadata resources for the given
+// time each; a resource without a positive TTL is not kept. With a disk
+// cache the listings also outlive the run.
+func WithMetadataCache(ttl map[string]time.Duration, disk ResponseCache) ClientOption {
+	return func(c *Client) {
+		c.metadata = &metadataCache{
+			baseURL: c.baseURL,
+			ttl:     ttl,
+			disk:    disk,
+			entries: make(map[string]metadataEntry),
+			changed: make(map[string]time.Time),
+		}
+	}
+}
+
+// listedResource returns the metadata resource an endpoint lists, if any
+func listedResource(endpoint string) string {
+	m := metadataListPattern.FindStringSubmatch(endpoint)
+	if m == nil {
+		return ""
+	}
+	return m[1] + m[2]
+}
+
+// changedResource returns the metadata resource a change to an endpoint
+// makes stale, if any
+func changedResource(endpoint string) string {
+	m := metadataChangePattern.FindStringSubmatch(endpoint)
+	if m == nil {
+		return ""
+	}
+	return m[1] + m[2] + m[3]
+}
+
+func (m *metadataCache) diskKey(endpoint string) string {
+	return "metadata/" + m.baseURL + endpoint
+}
+
+func (m *metadataCache) changedKey(resource string) string {
+	return "metadata-changed/" + m.baseURL + resource
+}
+
+// lastChanged returns when a resource was last changed, in this run or,
+// with a disk cache, an earlier one
+func (m *metadataCache) lastChanged(resource string) time.Time {
+	changed := m.changed[resource]
+	if m.disk == nil {
+		return changed
+	}
+	if data, ok := m.disk.Get(m.changedKey(resource), metadataVersion); ok {
+		var t time.Time
+		if json.Unmarshal(data, &t) == nil && t.After(changed) {
+			return t
+		}
+	}
+	return changed
+}
+
+// get returns the cached listing of endpoint if it is still fresh
+func (m *metadataCache) get(endpoint string) ([]byte, bool) {
+	resource := listedResource(endpoint)
+	ttl := m.ttl[resource]
+	if resource == "" || ttl <= 0 {
+		return nil, false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fresh := func(e metadataEntry) bool {
+		return time.Since(e.FetchedAt) < ttl && e.FetchedAt.After(m.lastChanged(resource))
+	}
+	if e, ok := m.entries[endpoint]; ok && fresh(e) {
+		return e.Body, true
+	}
+	if m.disk == nil {
+		return nil, false
+	}
+	data, ok := m.disk.Get(m.diskKey(endpoint), metadataVersion)
+	if !ok {
+		return nil, false
+	}
+	var e metadataEntry
+	if json.Unmarshal(data, &e) != nil || !fresh(e) {
+		return nil, false
+	}
+	m.entries[endpoint] = e
+	return e.Body, true
+}
+
+// put stores the listing of endpoint. Failures to write the disk cache are
+// ignored as it is only an optimisation.
+func (m *metadataCache) put(endpoint string, body []byte) {
+	resource := listedResource(endpoint)
+	if resource == "" || m.ttl[resource] <= 0 || !json.Valid(body) {
+		return
+	}
+	e := metadataEntry{Resource: resource, FetchedAt: time.Now(), Body: body}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[endpoint] = e
+	if m.disk != nil {
+		if data, err := json.Marshal(e); err == nil {
+			_ = m.disk.Put(m.diskKey(endpoint), metadataVersion, data)
+		}
+	}
+}
+
+// invalidate marks the resource changed by a request to endpoint as stale
+func (m *metadataCache) invalidate(endpoint string) {
+	resource := changedResource(endpoint)
+	if resource == "" {
+		return
+	}
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.changed[resource] = now
+	for key, e := range m.entries {
+		if e.Resource == resource {
+			delete(m.entries, key)
+		}
+	}
+	if m.disk != nil {
+		if data, err := json.Marshal(now); err == nil {
+			_ = m.disk.Put(m.changedKey(resource), metadataVersion, data)
+		}
+	}
+}
+
+// cachedResponse answers a GET of a metadata listing from the cache
+func (c *Client) cachedResponse(method, endpoint string) (*http.Response, bool) {
+	if c.metadata == nil || method != http.MethodGet {
+		return nil, false
+	}
+	body, ok := c.metadata.get(endpoint)
+	if !ok {
+		return nil, false
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}, true
+}
+
+// recordResponse caches a successful metadata listing, putting its body
+// back for the caller, and marks the resources a change makes stale
+func (c *Client) recordResponse(method, endpoint string, resp *http.Response) {
+	if c.metadata == nil {
+		return
+	}
+	if method != http.MethodGet {
+		c.metadata.invalidate(endpoint)
+		return
+	}
+	if listedResource(endpoint) == "" || resp.Body == nil {
+		return
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err == nil {
+		c.metadata.put(endpoint, data)
+	}
+}
@@ -116,7 +116,8 @@ func (c *Client) DeleteModule(projectID, moduleID string) error {
 	return nil
 }
 
-// GetModuleWorkItems retrieves work items associated with a module
+// GetModuleWorkItems retrieves all work items associated with a module,
+// page by page
 func (c *Client) GetModuleWorkItems(projectID, moduleID string) ([]WorkItem, error) {
 	if c.workspace == "" {
 		return nil, fmt.Errorf("workspace is not set")
@@ -130,10 +131,32 @@ func (c *Client) GetModuleWorkItems(projectID, moduleID string) ([]WorkItem, err
 
 	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/modules/%s/work-items/", c.workspace, projectID, moduleID)
 
-	var response ListResponse
-	if err := c.get(endpoint, &response); err != nil {
+	items, err := c.getWorkItemPages(endpoint)
+	if err != nil {
 		return nil, fmt.Errorf("failed to get module work items: %w", err)
 	}
 
-	return response.Results, nil
+	return items, nil
+}
+
+// AddWorkItemsToModule adds work items to a module
+func (c *Client) AddWorkItemsToModule(projectID, moduleID string, workItemIDs []string) error {
+	if c.workspace == "" {
+		return fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return fmt.Errorf("project ID is required")
+	}
+	if moduleID == "" {
+		return fmt.Errorf("module ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/modules/%s/module-issues/", c.workspace, projectID, moduleID)
+
+	body := map[string][]string{"issues": workItemIDs}
+	if err := c.post(endpoint, body, nil); err != nil {
+		return fmt.Errorf("failed to add work items to module: %w", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,144 @@
+package plane
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// defaultPageSize is the number of work items requested per page
+const defaultPageSize = 100
+
+// WorkItemsPager iterates over a work item listing page by page, following
+// next_cursor until the server reports no more results
+type WorkItemsPager struct {
+	client    *Client
+	projectID string
+	options   map[string]string
+	total     int
+	done      bool
+}
+
+// WithPageSize sets the number of work items requested per page by pagers.
+// Smaller pages help servers that time out on large responses; 0 keeps the
+// default of 100.
+func WithPageSize(size int) ClientOption {
+	return func(c *Client) {
+		c.pageSize = max(size, 0)
+	}
+}
+
+// WorkItemsPager returns a pager over the work items of a project. options
+// holds filters and field selection; per_page defaults to the client's page
+// size.
+func (c *Client) WorkItemsPager(projectID string, options map[string]string) *WorkItemsPager {
+	opts := make(map[string]string, len(options)+1)
+	for k, v := range options {
+		opts[k] = v
+	}
+	if opts["per_page"] == "" {
+		size := defaultPageSize
+		if c.pageSize > 0 {
+			size = c.pageSize
+		}
+		opts["per_page"] = strconv.Itoa(size)
+	}
+	return &WorkItemsPager{client: c, projectID: projectID, options: opts}
+}
+
+// More reports whether Next may return further items
+func (p *WorkItemsPager) More() bool {
+	return !p.done
+}
+
+// Total returns the total number of items reported by the last page
+func (p *WorkItemsPager) Total() int {
+	return p.total
+}
+
+// PageSize returns the number of items requested per page
+func (p *WorkItemsPager) PageSize() int {
+	size, _ := strconv.Atoi(p.options["per_page"])
+	return size
+}
+
+// Count asks for the total number of items in the listing with a one-item
+// page, so long fetches can show their size up front. It doesn't move the
+// pager.
+func (p *WorkItemsPager) Count() (int, error) {
+	opts := make(map[string]string, len(p.options))
+	for k, v := range p.options {
+		opts[k] = v
+	}
+	opts["per_page"] = "1"
+	opts["fields"] = "id"
+	delete(opts, "cursor")
+	delete(opts, "expand")
+
+	response, err := p.client.GetWorkItems(p.projectID, opts)
+	if err != nil {
+		return 0, err
+	}
+	p.total = response.TotalCount
+	return response.TotalCount, nil
+}
+
+// Next fetches the next page. It returns nil once the listing is exhausted.
+func (p *WorkItemsPager) Next() ([]WorkItem, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	response, err := p.client.GetWorkItems(p.projectID, p.options)
+	if err != nil {
+		return nil, err
+	}
+	p.total = response.TotalCount
+
+	// A repeated cursor would loop forever; treat it as the last page
+	if !response.NextPageResults || response.NextCursor == nil || *response.NextCursor == "" || *response.NextCursor == p.options["cursor"] {
+		p.done = true
+	} else {
+		p.options["cursor"] = *response.NextCursor
+	}
+
+	return response.Results, nil
+}
+
+// All fetches every remaining page
+func (p *WorkItemsPager) All() ([]WorkItem, error) {
+	var items []WorkItem
+	for p.More() {
+		page, err := p.Next()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+	}
+	return items, nil
+}
+
+// getWorkItemPages fetches every page of a work item listing at endpoint,
+// following next_cursor like WorkItemsPager does
+func (c *Client) getWorkItemPages(endpoint string) ([]WorkItem, error) {
+	size := defaultPageSize
+	if c.pageSize > 0 {
+		size = c.pageSize
+	}
+	params := url.Values{}
+	params.Set("per_page", strconv.Itoa(size))
+
+	var items []WorkItem
+	for {
+		var response ListResponse
+		if err := c.getWithQuery(endpoint, params, &response); err != nil {
+			return nil, err
+		}
+		items = append(items, response.Results...)
+
+		// A repeated cursor would loop forever; treat it as the last page
+		if !response.NextPageResults || response.NextCursor == nil || *response.NextCursor == "" || *response.NextCursor == params.Get("cursor") {
+			return items, nil
+		}
+		params.Set("cursor", *response.NextCursor)
+	}
+}
@@ -46,6 +46,68 @@ func (c *Client) GetProject(projectID string) (*Project, error) {
 	return &project, nil
 }
 
+// CreateProject creates a new project in the workspace
+func (c *Client) CreateProject(create *ProjectCreate) (*Project, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if create == nil || create.Name == "" {
+		return nil, fmt.Errorf("project name is required")
+	}
+	if create.Identifier == "" {
+		return nil, fmt.Errorf("project identifier is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/", c.workspace)
+
+	var project Project
+	if err := c.post(endpoint, create, &project); err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	return &project, nil
+}
+
+// UpdateProject updates an existing project
+func (c *Client) UpdateProject(projectID string, update *ProjectUpdate) (*Project, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if update == nil {
+		return nil, fmt.Errorf("update data is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/", c.workspace, projectID)
+
+	var project Project
+	if err := c.patch(endpoint, update, &project); err != nil {
+		return nil, fmt.Errorf("failed to update project: %w", err)
+	}
+
+	return &project, nil
+}
+
+// DeleteProject deletes a project with all its work items
+func (c *Client) DeleteProject(projectID string) error {
+	if c.workspace == "" {
+		return fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return fmt.Errorf("project ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/", c.workspace, projectID)
+
+	if err := c.delete(endpoint); err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	return nil
+}
+
 // GetProjectStates retrieves all workflow states for a project
 func (c *Client) GetProjectStates(projectID string) ([]State, error) {
 	if c.workspace == "" {
@@ -112,6 +174,51 @@ func (c *Client) GetProjectCycles(projectID string) ([]Cycle, error) {
 	return response.Results, nil
 }
 
+// CreateCycle creates a new cycle
+func (c *Client) CreateCycle(projectID string, create *CycleCreate) (*Cycle, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if create == nil || create.Name == "" {
+		return nil, fmt.Errorf("cycle name is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/cycles/", c.workspace, projectID)
+
+	create.ProjectID = projectID
+	var cycle Cycle
+	if err := c.post(endpoint, create, &cycle); err != nil {
+		return nil, fmt.Errorf("failed to create cycle: %w", err)
+	}
+
+	return &cycle, nil
+}
+
+// UpdateCycle updates an existing cycle
+func (c *Client) UpdateCycle(projectID, cycleID string, update *CycleUpdate) (*Cycle, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if cycleID == "" {
+		return nil, fmt.Errorf("cycle ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/cycles/%s/", c.workspace, projectID, cycleID)
+
+	var cycle Cycle
+	if err := c.patch(endpoint, update, &cycle); err != nil {
+		return nil, fmt.Errorf("failed to update cycle: %w", err)
+	}
+
+	return &cycle, nil
+}
+
 // GetProjectModules retrieves all modules for a project
 func (c *Client) GetProjectModules(projectID string) ([]Module, error) {
 	if c.workspace == "" {
@@ -169,3 +276,48 @@ func (c *Client) ProjectExists(projectID string) (bool, error) {
 	}
 	return true, nil
 }
+
+// AddWorkItemsToCycle adds work items to a cycle, moving them out of any
+// cycle they were in
+func (c *Client) AddWorkItemsToCycle(projectID, cycleID string, workItemIDs []string) error {
+	if c.workspace == "" {
+		return fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return fmt.Errorf("project ID is required")
+	}
+	if cycleID == "" {
+		return fmt.Errorf("cycle ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/cycles/%s/cycle-issues/", c.workspace, projectID, cycleID)
+
+	body := map[string][]string{"issues": workItemIDs}
+	if err := c.post(endpoint, body, nil); err != nil {
+		return fmt.Errorf("failed to add work items to cycle: %w", err)
+	}
+
+	return nil
+}
+
+// GetCycleWorkItems retrieves all the work items in a cycle, page by page
+func (c *Client) GetCycleWorkItems(projectID, cycleID string) ([]WorkItem, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if cycleID == "" {
+		return nil, fmt.Errorf("cycle ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/cycles/%s/cycle-issues/", c.workspace, projectID, cycleID)
+
+	items, err := c.getWorkItemPages(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cycle work items: %w", err)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,160 @@
+package plane
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GetWorkItemTypes retrieves the work item types of a project
+func (c *Client) GetWorkItemTypes(projectID string) ([]WorkItemType, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-item-types/", c.workspace, projectID)
+
+	var types []WorkItemType
+	if err := c.get(endpoint, &types); err != nil {
+		return nil, fmt.Errorf("failed to get work item types: %w", err)
+	}
+
+	return types, nil
+}
+
+// GetWorkItemProperties retrieves the custom properties of a work item type,
+// including the options of OPTION properties
+func (c *Client) GetWorkItemProperties(projectID, typeID string) ([]WorkItemProperty, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if typeID == "" {
+		return nil, fmt.Errorf("work item type ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-item-types/%s/work-item-properties/", c.workspace, projectID, typeID)
+
+	var properties []WorkItemProperty
+	if err := c.get(endpoint, &properties); err != nil {
+		return nil, fmt.Errorf("failed to get work item properties: %w", err)
+	}
+
+	for i := range properties {
+		if properties[i].PropertyType != "OPTION" {
+			continue
+		}
+		optionsEndpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-item-properties/%s/options/", c.workspace, projectID, properties[i].ID)
+		if err := c.get(optionsEndpoint, &properties[i].Options); err != nil {
+			return nil, fmt.Errorf("failed to get options of property %s: %w", properties[i].Name, err)
+		}
+	}
+
+	return properties, nil
+}
+
+// GetWorkItemPropertyValues retrieves the values a work item has for a custom
+// property. Values are returned as strings; OPTION values are option IDs.
+func (c *Client) GetWorkItemPropertyValues(projectID, workItemID, propertyID string) ([]string, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+	if propertyID == "" {
+		return nil, fmt.Errorf("property ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/work-item-properties/%s/values/", c.workspace, projectID, workItemID, propertyID)
+
+	var raw json.RawMessage
+	if err := c.get(endpoint, &raw); err != nil {
+		return nil, fmt.Errorf("failed to get property values: %w", err)
+	}
+
+	return parsePropertyValues(raw)
+}
+
+// parsePropertyValues accepts the shapes the values endpoint is known to
+// return: a bare value, a list of values, a list of {"value": ...} objects,
+// or an object wrapping any of these in "values"
+func parsePropertyValues(raw json.RawMessage) ([]string, error) {
+	var wrapped struct {
+		Values json.RawMessage `json:"values"`
+		Value  json.RawMessage `json:"value"`
+	}
+	if len(raw) > 0 && raw[0] == '{' {
+		if err := json.Unmarshal(raw, &wrapped); err != nil {
+			return nil, fmt.Errorf("failed to decode property values: %w", err)
+		}
+		if wrapped.Values != nil {
+			return parsePropertyValues(wrapped.Values)
+		}
+		return parsePropertyValues(wrapped.Value)
+	}
+
+	var list []json.RawMessage
+	if len(raw) > 0 && raw[0] == '[' {
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return nil, fmt.Errorf("failed to decode property values: %w", err)
+		}
+	} else if len(raw) > 0 && string(raw) != "null" {
+		list = []json.RawMessage{raw}
+	}
+
+	var values []string
+	for _, item := range list {
+		if len(item) > 0 && item[0] == '{' {
+			nested, err := parsePropertyValues(item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, nested...)
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(item, &s); err != nil {
+			// Numbers and booleans are kept in their JSON form
+			s = strings.TrimSpace(string(item))
+		}
+		if s != "" && s != "null" {
+			values = append(values, s)
+		}
+	}
+	return values, nil
+}
+
+// SetWorkItemPropertyValues replaces the values a work item has for a
+// custom property
+func (c *Client) SetWorkItemPropertyValues(projectID, workItemID, propertyID string, values []string) error {
+	if c.workspace == "" {
+		return fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return fmt.Errorf("work item ID is required")
+	}
+	if propertyID == "" {
+		return fmt.Errorf("property ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/work-item-properties/%s/values/", c.workspace, projectID, workItemID, propertyID)
+
+	body := map[string][]string{"values": values}
+	if err := c.post(endpoint, body, nil); err != nil {
+		return fmt.Errorf("failed to set property values: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package plane
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimitBurst is how many requests may be sent back to back before the
+// rate limit applies, so short commands aren't slowed down
+const rateLimitBurst = 10
+
+// WithRateLimit paces requests to at most perMinute per minute on average,
+// so bulk commands stay under the server's rate limit instead of running
+// into 429 responses. 0 disables the limit.
+func WithRateLimit(perMinute int) ClientOption {
+	return func(c *Client) {
+		if perMinute <= 0 {
+			c.limiter = nil
+			return
+		}
+		c.limiter = newTokenBucket(float64(perMinute)/60, rateLimitBurst)
+	}
+}
+
+// tokenBucket is a token bucket rate limiter: tokens refill at rate per
+// second up to burst, and every request takes one
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// wait blocks until a token is available or ctx is done
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	// A negative balance reserves a future token; wait until it is refilled
+	delay := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,42 @@
+package plane
+
+import "fmt"
+
+// Work item relation types
+const (
+	RelationRelatesTo = "relates_to"
+	RelationBlocking  = "blocking"
+	RelationBlockedBy = "blocked_by"
+	RelationDuplicate = "duplicate"
+)
+
+// WorkItemRelationCreate represents payload for relating work items
+type WorkItemRelationCreate struct {
+	RelationType string   `json:"relation_type"`
+	Issues       []string `json:"issues"`
+}
+
+// CreateWorkItemRelation relates a work item to other work items
+func (c *Client) CreateWorkItemRelation(projectID, workItemID, relationType string, relatedIDs []string) error {
+	if c.workspace == "" {
+		return fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return fmt.Errorf("work item ID is required")
+	}
+	if len(relatedIDs) == 0 {
+		return fmt.Errorf("at least one related work item is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/relations/", c.workspace, projectID, workItemID)
+
+	payload := &WorkItemRelationCreate{RelationType: relationType, Issues: relatedIDs}
+	if err := c.post(endpoint, payload, nil); err != nil {
+		return fmt.Errorf("failed to create work item relation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package plane
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// retryBaseDelay is the delay before the first retry; it doubles with
+	// every further attempt
+	retryBaseDelay = 500 * time.Millisecond
+	// retryMaxDelay caps the delay between attempts, including delays asked
+	// for with Retry-After
+	retryMaxDelay = 30 * time.Second
+)
+
+// RetryNotifyFunc is called before a request is retried with the status
+// that caused the retry, the delay and the attempt about to be made
+type RetryNotifyFunc func(status int, wait time.Duration, attempt, maxRetries int)
+
+// WithRetries retries requests answered with 429, 502, 503 or 504 up to
+// maxRetries times, backing off exponentially with jitter and honouring
+// Retry-After. 0 disables retries.
+func WithRetries(maxRetries int) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = max(maxRetries, 0)
+	}
+}
+
+// WithRetryNotify sets a function told about every retry, e.g. to let users
+// know why a long run has slowed down
+func WithRetryNotify(fn RetryNotifyFunc) ClientOption {
+	return func(c *Client) {
+		c.onRetry = fn
+	}
+}
+
+// retryableStatus reports whether a response status is transient: the
+// server is overloaded, rate limiting, or behind a failing proxy
+func retryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// send executes a request within the rate limit, retrying transient
+// failures. Request bodies are rewound with GetBody, which http.NewRequest
+// sets for in-memory bodies; requests whose body can't be rewound are sent
+// once.
+func (c *Client) send(hc *http.Client, req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := c.do(hc, req)
+		if err != nil || attempt > c.maxRetries || !retryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+		if req.Body != nil && req.GetBody == nil {
+			return resp, nil
+		}
+
+		wait := retryDelay(resp, attempt)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		if c.onRetry != nil {
+			c.onRetry(resp.StatusCode, wait, attempt, c.maxRetries)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+	}
+}
+
+// retryDelay returns how long to wait before the given retry attempt: the
+// server's Retry-After when present, otherwise an exponential backoff
+// randomised between half and all of its length
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if after := resp.Header.Get("Retry-After"); after != "" {
+		if seconds, err := strconv.Atoi(after); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, retryMaxDelay)
+		}
+		if t, err := http.ParseTime(after); err == nil {
+			return min(max(time.Until(t), 0), retryMaxDelay)
+		}
+	}
+
+	backoff := min(retryBaseDelay<<min(attempt-1, 10), retryMaxDelay)
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
@@ -0,0 +1,102 @@
+package plane
+
+import (
+	"fmt"
+)
+
+// StateGroups lists the state groups understood by Plane, in workflow order
+var StateGroups = []string{"backlog", "unstarted", "started", "completed", "cancelled"}
+
+// GetState retrieves a single workflow state by ID
+func (c *Client) GetState(projectID, stateID string) (*State, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if stateID == "" {
+		return nil, fmt.Errorf("state ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/states/%s/", c.workspace, projectID, stateID)
+
+	var state State
+	if err := c.get(endpoint, &state); err != nil {
+		return nil, fmt.Errorf("failed to get state: %w", err)
+	}
+
+	return &state, nil
+}
+
+// CreateState creates a new workflow state
+func (c *Client) CreateState(projectID string, create *StateCreate) (*State, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if create == nil {
+		return nil, fmt.Errorf("state data is required")
+	}
+	if create.Name == "" {
+		return nil, fmt.Errorf("state name is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/states/", c.workspace, projectID)
+
+	var state State
+	if err := c.post(endpoint, create, &state); err != nil {
+		return nil, fmt.Errorf("failed to create state: %w", err)
+	}
+
+	return &state, nil
+}
+
+// UpdateState updates an existing workflow state
+func (c *Client) UpdateState(projectID, stateID string, update *StateUpdate) (*State, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if stateID == "" {
+		return nil, fmt.Errorf("state ID is required")
+	}
+	if update == nil {
+		return nil, fmt.Errorf("update data is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/states/%s/", c.workspace, projectID, stateID)
+
+	var state State
+	if err := c.patch(endpoint, update, &state); err != nil {
+		return nil, fmt.Errorf("failed to update state: %w", err)
+	}
+
+	return &state, nil
+}
+
+// DeleteState deletes a workflow state. Plane refuses to delete states that
+// still hold work items or are the project default.
+func (c *Client) DeleteState(projectID, stateID string) error {
+	if c.workspace == "" {
+		return fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return fmt.Errorf("project ID is required")
+	}
+	if stateID == "" {
+		return fmt.Errorf("state ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/states/%s/", c.workspace, projectID, stateID)
+
+	if err := c.delete(endpoint); err != nil {
+		return fmt.Errorf("failed to delete state: %w", err)
+	}
+
+	return nil
+}
@@ -6,47 +6,54 @@ import (
 
 // WorkItem represents a Plane.so work item (issue)
 type WorkItem struct {
-	ID              string    `json:"id"`
-	Name            string    `json:"name"`
-	Description     string    `json:"description,omitempty"`
-	DescriptionHTML string    `json:"description_html,omitempty"`
-	State           string    `json:"state"`
-	StateID         string    `json:"state_id"`
-	Priority        string    `json:"priority"`
-	Assignees       []string  `json:"assignees,omitempty"`
-	AssigneeIDs     []string  `json:"assignee_ids,omitempty"`
-	Labels          []string  `json:"labels,omitempty"`
-	LabelIDs        []string  `json:"label_ids,omitempty"`
-	ProjectID       string    `json:"project_id"`
-	Project         string    `json:"project"`
-	WorkspaceID     string    `json:"workspace_id"`
-	SequenceID      int       `json:"sequence_id"`
-	StartDate       *string   `json:"start_date,omitempty"`
-	TargetDate      *string   `json:"target_date,omitempty"`
-	EstimatePoint   *string   `json:"estimate_point,omitempty"`
-	Module          string    `json:"module,omitempty"`
-	ModuleID        string    `json:"module_id,omitempty"`
-	Cycle           string    `json:"cycle,omitempty"`
-	CycleID         string    `json:"cycle_id,omitempty"`
-	ParentID        string    `json:"parent,omitempty"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
+	ID              string     `json:"id"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description,omitempty"`
+	DescriptionHTML string     `json:"description_html,omitempty"`
+	State           string     `json:"state"`
+	StateID         string     `json:"state_id"`
+	Priority        string     `json:"priority"`
+	Assignees       []string   `json:"assignees,omitempty"`
+	AssigneeIDs     []string   `json:"assignee_ids,omitempty"`
+	Labels          []string   `json:"labels,omitempty"`
+	LabelIDs        []string   `json:"label_ids,omitempty"`
+	ProjectID       string     `json:"project_id"`
+	Project         string     `json:"project"`
+	WorkspaceID     string     `json:"workspace_id"`
+	SequenceID      int        `json:"sequence_id"`
+	StartDate       *string    `json:"start_date,omitempty"`
+	TargetDate      *string    `json:"target_date,omitempty"`
+	EstimatePoint   *string    `json:"estimate_point,omitempty"`
+	Module          string     `json:"module,omitempty"`
+	ModuleID        string     `json:"module_id,omitempty"`
+	Cycle           string     `json:"cycle,omitempty"`
+	CycleID         string     `json:"cycle_id,omitempty"`
+	ParentID        string     `json:"parent,omitempty"`
+	ExternalID      string     `json:"external_id,omitempty"`
+	ExternalSource  string     `json:"external_source,omitempty"`
+	TypeID          string     `json:"type_id,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
 }
 
 // WorkItemCreate represents the payload for creating a work item
 type WorkItemCreate struct {
-	Name          string   `json:"name"`
-	Description   string   `json:"description,omitempty"`
-	State         string   `json:"state,omitempty"`
-	Priority      string   `json:"priority,omitempty"`
-	Assignees     []string `json:"assignees,omitempty"`
-	Labels        []string `json:"labels,omitempty"`
-	StartDate     string   `json:"start_date,omitempty"`
-	TargetDate    string   `json:"target_date,omitempty"`
-	EstimatePoint string   `json:"estimate_point,omitempty"`
-	Module        string   `json:"module,omitempty"`
-	Cycle         string   `json:"cycle,omitempty"`
-	Parent        string   `json:"parent,omitempty"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description,omitempty"`
+	DescriptionHTML string   `json:"description_html,omitempty"`
+	State           string   `json:"state,omitempty"`
+	Priority        string   `json:"priority,omitempty"`
+	Assignees       []string `json:"assignees,omitempty"`
+	Labels          []string `json:"labels,omitempty"`
+	StartDate       string   `json:"start_date,omitempty"`
+	TargetDate      string   `json:"target_date,omitempty"`
+	EstimatePoint   string   `json:"estimate_point,omitempty"`
+	Module          string   `json:"module,omitempty"`
+	Cycle           string   `json:"cycle,omitempty"`
+	Parent          string   `json:"parent,omitempty"`
+	ExternalID      string   `json:"external_id,omitempty"`
+	ExternalSource  string   `json:"external_source,omitempty"`
 }
 
 // WorkItemUpdate represents the payload for updating a work item
@@ -74,6 +81,27 @@ type Project struct {
 	WorkspaceID string `json:"workspace_id"`
 }
 
+// Project network values: who in the workspace can see the project
+const (
+	ProjectNetworkPrivate = 0
+	ProjectNetworkPublic  = 2
+)
+
+// ProjectCreate represents payload for creating a project
+type ProjectCreate struct {
+	Name        string `json:"name"`
+	Identifier  string `json:"identifier"`
+	Description string `json:"description,omitempty"`
+	Network     int    `json:"network"`
+}
+
+// ProjectUpdate represents payload for updating a project
+type ProjectUpdate struct {
+	Name        string `json:"name,omitempty"`
+	Identifier  string `json:"identifier,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
 // State represents a workflow state in a project
 type State struct {
 	ID          string `json:"id"`
@@ -84,6 +112,21 @@ type State struct {
 	WorkspaceID string `json:"workspace_id"`
 }
 
+// StateCreate represents payload for creating a workflow state
+type StateCreate struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Group       string `json:"group"`
+	Description string `json:"description,omitempty"`
+}
+
+// StateUpdate represents payload for updating a workflow state
+type StateUpdate struct {
+	Name  string `json:"name,omitempty"`
+	Color string `json:"color,omitempty"`
+	Group string `json:"group,omitempty"`
+}
+
 // Label represents a label/tag in a project
 type Label struct {
 	ID          string    `json:"id"`
@@ -109,10 +152,65 @@ type LabelUpdate struct {
 
 // Cycle represents a sprint/cycle in a project
 type Cycle struct {
-	ID          string `json:"id"`
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	StartDate   *string `json:"start_date,omitempty"`
+	EndDate     *string `json:"end_date,omitempty"`
+	ProjectID   string  `json:"project_id"`
+	WorkspaceID string  `json:"workspace_id"`
+}
+
+// CycleCreate represents payload for creating a cycle
+type CycleCreate struct {
 	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	StartDate   string `json:"start_date,omitempty"`
+	EndDate     string `json:"end_date,omitempty"`
 	ProjectID   string `json:"project_id"`
-	WorkspaceID string `json:"workspace_id"`
+}
+
+// CycleUpdate represents payload for updating a cycle
+type CycleUpdate struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	StartDate   string `json:"start_date,omitempty"`
+	EndDate     string `json:"end_date,omitempty"`
+}
+
+// WorkItemType represents a work item type, which defines custom properties
+type WorkItemType struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IsDefault   bool   `json:"is_default"`
+	IsActive    bool   `json:"is_active"`
+	ProjectID   string `json:"project_id,omitempty"`
+	WorkspaceID string `json:"workspace_id,omitempty"`
+}
+
+// WorkItemProperty represents a custom property of a work item type
+type WorkItemProperty struct {
+	ID           string                   `json:"id"`
+	Name         string                   `json:"name"`
+	DisplayName  string                   `json:"display_name"`
+	Description  string                   `json:"description,omitempty"`
+	PropertyType string                   `json:"property_type"`
+	RelationType string                   `json:"relation_type,omitempty"`
+	IsMulti      bool                     `json:"is_multi"`
+	IsRequired   bool                     `json:"is_required"`
+	IsActive     bool                     `json:"is_active"`
+	TypeID       string                   `json:"issue_type,omitempty"`
+	Options      []WorkItemPropertyOption `json:"-"`
+}
+
+// WorkItemPropertyOption is a choice of an OPTION property
+type WorkItemPropertyOption struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	PropertyID string `json:"property,omitempty"`
+	IsDefault  bool   `json:"is_default"`
+	IsActive   bool   `json:"is_active"`
 }
 
 // Estimate represents an estimate configuration in a project
@@ -140,6 +238,8 @@ type Module struct {
 	Description string    `json:"description,omitempty"`
 	Color       string    `json:"color,omitempty"`
 	Status      string    `json:"status,omitempty"`
+	StartDate   *string   `json:"start_date,omitempty"`
+	TargetDate  *string   `json:"target_date,omitempty"`
 	ProjectID   string    `json:"project_id"`
 	WorkspaceID string    `json:"workspace_id"`
 	CreatedAt   time.Time `json:"created_at"`
@@ -152,6 +252,8 @@ type ModuleCreate struct {
 	Description string `json:"description,omitempty"`
 	Color       string `json:"color,omitempty"`
 	Status      string `json:"status,omitempty"`
+	StartDate   string `json:"start_date,omitempty"`
+	TargetDate  string `json:"target_date,omitempty"`
 }
 
 // ModuleUpdate represents payload for updating a module
@@ -160,6 +262,8 @@ type ModuleUpdate struct {
 	Description string `json:"description,omitempty"`
 	Color       string `json:"color,omitempty"`
 	Status      string `json:"status,omitempty"`
+	StartDate   string `json:"start_date,omitempty"`
+	TargetDate  string `json:"target_date,omitempty"`
 }
 
 // Page represents a page/document in a project
@@ -202,6 +306,20 @@ type Member struct {
 	LastName    string `json:"last_name"`
 	DisplayName string `json:"display_name"`
 	AvatarURL   string `json:"avatar_url,omitempty"`
+	Role        int    `json:"role,omitempty"`
+	// DateJoined is when the account was created; only user endpoints
+	// return it
+	DateJoined string `json:"date_joined,omitempty"`
+}
+
+// Workspace is a workspace the user belongs to
+type Workspace struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+	// Role is the user's role in the workspace; not every Plane version
+	// returns it
+	Role int `json:"role,omitempty"`
 }
 
 // ListResponse represents a paginated API response
@@ -233,6 +351,104 @@ type LabelListResponse struct {
 	Previous *string `json:"previous"`
 }
 
+// Activity is a single entry in a work item's history
+type Activity struct {
+	ID            string    `json:"id"`
+	Verb          string    `json:"verb"`
+	Field         string    `json:"field,omitempty"`
+	OldValue      string    `json:"old_value,omitempty"`
+	NewValue      string    `json:"new_value,omitempty"`
+	OldIdentifier string    `json:"old_identifier,omitempty"`
+	NewIdentifier string    `json:"new_identifier,omitempty"`
+	Comment       string    `json:"comment,omitempty"`
+	Actor         string    `json:"actor,omitempty"`
+	WorkItemID    string    `json:"issue,omitempty"`
+	ProjectID     string    `json:"project,omitempty"`
+	WorkspaceID   string    `json:"workspace,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ActivityListResponse represents paginated activities response
+type ActivityListResponse struct {
+	TotalCount      int        `json:"total_count"`
+	NextCursor      *string    `json:"next_cursor"`
+	NextPageResults bool       `json:"next_page_results"`
+	Results         []Activity `json:"results"`
+}
+
+// Comment is a comment on a work item
+type Comment struct {
+	ID          string    `json:"id"`
+	CommentHTML string    `json:"comment_html,omitempty"`
+	Actor       string    `json:"actor,omitempty"`
+	WorkItemID  string    `json:"issue,omitempty"`
+	ProjectID   string    `json:"project,omitempty"`
+	WorkspaceID string    `json:"workspace,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CommentCreate represents payload for creating a comment
+type CommentCreate struct {
+	CommentHTML string `json:"comment_html"`
+	Access      string `json:"access,omitempty"`
+}
+
+// CommentUpdate represents payload for updating a comment
+type CommentUpdate struct {
+	CommentHTML string `json:"comment_html,omitempty"`
+}
+
+// CommentListResponse represents paginated comments response
+type CommentListResponse struct {
+	TotalCount      int       `json:"total_count"`
+	NextCursor      *string   `json:"next_cursor"`
+	NextPageResults bool      `json:"next_page_results"`
+	Results         []Comment `json:"results"`
+}
+
+// Worklog is time logged against a work item
+type Worklog struct {
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+	// Duration is in minutes
+	Duration    int       `json:"duration"`
+	LoggedBy    string    `json:"logged_by,omitempty"`
+	WorkItemID  string    `json:"issue,omitempty"`
+	ProjectID   string    `json:"project,omitempty"`
+	WorkspaceID string    `json:"workspace,omitempty"`
+	CreatedBy   string    `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WorklogCreate represents payload for logging time
+type WorklogCreate struct {
+	Description string `json:"description,omitempty"`
+	Duration    int    `json:"duration"`
+}
+
+// Attachment is a file attached to a work item
+type Attachment struct {
+	ID         string               `json:"id"`
+	Attributes AttachmentAttributes `json:"attributes"`
+	Asset      string               `json:"asset,omitempty"`
+	IsUploaded bool                 `json:"is_uploaded"`
+	WorkItemID string               `json:"issue,omitempty"`
+	ProjectID  string               `json:"project,omitempty"`
+	CreatedBy  string               `json:"created_by,omitempty"`
+	CreatedAt  time.Time            `json:"created_at"`
+	UpdatedAt  time.Time            `json:"updated_at"`
+}
+
+// AttachmentAttributes describes the file of an attachment
+type AttachmentAttributes struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Size int64  `json:"size"`
+}
+
 // PageListResponse represents paginated pages response
 type PageListResponse struct {
 	Count    int     `json:"count"`
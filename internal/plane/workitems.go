@@ -1,11 +1,34 @@
 package plane
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
+// SelectFields adds the fields= and expand= parameters to list options so
+// the API only returns the given fields and expands the given relations.
+// Empty lists leave the response unchanged. The work item ID is always
+// requested.
+func SelectFields(options map[string]string, fields, expand []string) {
+	if len(fields) > 0 {
+		selected := []string{"id"}
+		seen := map[string]bool{"id": true}
+		for _, f := range fields {
+			if !seen[f] {
+				seen[f] = true
+				selected = append(selected, f)
+			}
+		}
+		options["fields"] = strings.Join(selected, ",")
+	}
+	if len(expand) > 0 {
+		options["expand"] = strings.Join(expand, ",")
+	}
+}
+
 // GetWorkItems retrieves a list of work items for a project
 func (c *Client) GetWorkItems(projectID string, options map[string]string) (*ListResponse, error) {
 	if c.workspace == "" {
@@ -31,6 +54,36 @@ func (c *Client) GetWorkItems(projectID string, options map[string]string) (*Lis
 	return &response, nil
 }
 
+// GetWorkItemsIfChanged retrieves a list of work items with a conditional
+// request. It returns a nil response when the server reports that nothing
+// changed since the request that produced prev.
+func (c *Client) GetWorkItemsIfChanged(projectID string, options map[string]string, prev Validators) (*ListResponse, Validators, error) {
+	if c.workspace == "" {
+		return nil, prev, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, prev, fmt.Errorf("project ID is required")
+	}
+
+	params := url.Values{}
+	for key, value := range options {
+		params.Add(key, value)
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/", c.workspace, projectID)
+
+	var response ListResponse
+	next, notModified, err := c.getConditional(endpoint, params, prev, &response)
+	if err != nil {
+		return nil, prev, fmt.Errorf("failed to get work items: %w", err)
+	}
+	if notModified {
+		return nil, next, nil
+	}
+
+	return &response, next, nil
+}
+
 // GetWorkItem retrieves a single work item by ID
 func (c *Client) GetWorkItem(projectID, workItemID string) (*WorkItem, error) {
 	if c.workspace == "" {
@@ -46,10 +99,53 @@ func (c *Client) GetWorkItem(projectID, workItemID string) (*WorkItem, error) {
 	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/", c.workspace, projectID, workItemID)
 
 	var workItem WorkItem
-	if err := c.get(endpoint, &workItem); err != nil {
+	if err := c.getWorkItem(endpoint, &workItem); err != nil {
+		return nil, fmt.Errorf("failed to get work item: %w", err)
+	}
+
+	return &workItem, nil
+}
+
+// GetWorkItemJSON retrieves a work item as the API returns it, with fields
+// the WorkItem type doesn't know. It bypasses the cache.
+func (c *Client) GetWorkItemJSON(projectID, workItemID string) (json.RawMessage, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/", c.workspace, projectID, workItemID)
+
+	var raw json.RawMessage
+	if err := c.get(endpoint, &raw); err != nil {
 		return nil, fmt.Errorf("failed to get work item: %w", err)
 	}
 
+	return raw, nil
+}
+
+// GetWorkItemByIdentifier retrieves a work item by its readable identifier,
+// e.g. PROJ-123, without knowing its project ID
+func (c *Client) GetWorkItemByIdentifier(identifier string) (*WorkItem, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if identifier == "" {
+		return nil, fmt.Errorf("work item identifier is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/work-items/%s/", c.workspace, identifier)
+
+	var workItem WorkItem
+	if err := c.getWorkItem(endpoint, &workItem); err != nil {
+		return nil, fmt.Errorf("failed to get work item %s: %w", identifier, err)
+	}
+
 	return &workItem, nil
 }
 
@@ -103,6 +199,32 @@ func (c *Client) UpdateWorkItem(projectID, workItemID string, update *WorkItemUp
 	return &workItem, nil
 }
 
+// PatchWorkItem sends fields to the API as they are, for fields that
+// WorkItemUpdate doesn't cover or must be cleared with null
+func (c *Client) PatchWorkItem(projectID, workItemID string, fields map[string]any) (*WorkItem, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no fields to update")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/", c.workspace, projectID, workItemID)
+
+	var workItem WorkItem
+	if err := c.patch(endpoint, fields, &workItem); err != nil {
+		return nil, fmt.Errorf("failed to update work item: %w", err)
+	}
+
+	return &workItem, nil
+}
+
 // DeleteWorkItem deletes a work item
 func (c *Client) DeleteWorkItem(projectID, workItemID string) error {
 	if c.workspace == "" {
@@ -124,44 +246,100 @@ func (c *Client) DeleteWorkItem(projectID, workItemID string) error {
 	return nil
 }
 
-// SearchWorkItems searches work items by title (client-side filtering)
-// Note: This fetches all work items and filters locally. For large projects,
-// consider implementing server-side search if Plane API supports it
-func (c *Client) SearchWorkItems(projectID, query string) ([]WorkItem, error) {
+// GetWorkItemChildren retrieves the direct sub-items of a work item. The
+// listing is filtered by parent on the server; items are checked again
+// locally in case the filter is not applied.
+func (c *Client) GetWorkItemChildren(projectID, parentID string) ([]WorkItem, error) {
 	if c.workspace == "" {
 		return nil, fmt.Errorf("workspace is not set")
 	}
 	if projectID == "" {
 		return nil, fmt.Errorf("project ID is required")
 	}
+	if parentID == "" {
+		return nil, fmt.Errorf("parent work item ID is required")
+	}
 
-	// Get all work items (handle pagination if needed)
-	var allItems []WorkItem
-	nextURL := ""
+	items, err := c.WorkItemsPager(projectID, map[string]string{"parent": parentID}).All()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sub-items: %w", err)
+	}
 
-	for {
-		options := map[string]string{}
-		if nextURL != "" {
-			// Parse next URL to extract cursor/page
-			// This is a simplified version - actual implementation may vary
+	var children []WorkItem
+	for _, item := range items {
+		if item.ParentID == parentID {
+			children = append(children, item)
 		}
+	}
+	return children, nil
+}
 
-		response, err := c.GetWorkItems(projectID, options)
-		if err != nil {
-			return nil, err
-		}
+// SetWorkItemParent moves a work item under another one; an empty parentID
+// detaches it from its parent
+func (c *Client) SetWorkItemParent(projectID, workItemID, parentID string) (*WorkItem, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
 
-		allItems = append(allItems, response.Results...)
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/", c.workspace, projectID, workItemID)
 
-		// Check if there are more results using cursor-based pagination
-		if !response.NextPageResults || response.NextCursor == nil {
-			break
-		}
-		// Use cursor for next page (stored in options for next iteration)
-		options["cursor"] = *response.NextCursor
+	// WorkItemUpdate omits an empty parent, so send null explicitly
+	payload := map[string]interface{}{"parent": nil}
+	if parentID != "" {
+		payload["parent"] = parentID
+	}
+
+	var workItem WorkItem
+	if err := c.patch(endpoint, payload, &workItem); err != nil {
+		return nil, fmt.Errorf("failed to set parent: %w", err)
+	}
+
+	return &workItem, nil
+}
+
+// WorkItemSearchResult is a work item found by SearchWorkItems
+type WorkItemSearchResult struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	SequenceID        int    `json:"sequence_id"`
+	ProjectID         string `json:"project_id"`
+	ProjectIdentifier string `json:"project__identifier"`
+	WorkspaceSlug     string `json:"workspace__slug,omitempty"`
+	TypeID            string `json:"type_id,omitempty"`
+}
+
+// SearchWorkItems searches the work items of a project server-side, by
+// title and readable identifier, returning at most limit results. Servers
+// without the search endpoint answer with a 404.
+func (c *Client) SearchWorkItems(projectID, text string, limit int) ([]WorkItemSearchResult, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/work-items/search/", c.workspace)
+	query := url.Values{}
+	query.Set("search", text)
+	query.Set("project_id", projectID)
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
 	}
 
-	return allItems, nil
+	var response struct {
+		Issues []WorkItemSearchResult `json:"issues"`
+	}
+	if err := c.getWithQuery(endpoint, query, &response); err != nil {
+		return nil, err
+	}
+	return response.Issues, nil
 }
 
 // Helper function to convert int to string
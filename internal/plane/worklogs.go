@@ -0,0 +1,64 @@
+package plane
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ListWorklogs retrieves the time logged against a work item. Only
+// instances with time tracking serve worklogs; others answer 404.
+func (c *Client) ListWorklogs(projectID, workItemID string) ([]Worklog, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/worklogs/", c.workspace, projectID, workItemID)
+
+	// The endpoint returns a plain list; paginated responses are accepted too
+	var raw json.RawMessage
+	if err := c.get(endpoint, &raw); err != nil {
+		return nil, fmt.Errorf("failed to get worklogs: %w", err)
+	}
+	var worklogs []Worklog
+	if err := json.Unmarshal(raw, &worklogs); err == nil {
+		return worklogs, nil
+	}
+	var response struct {
+		Results []Worklog `json:"results"`
+	}
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode worklogs: %w", err)
+	}
+	return response.Results, nil
+}
+
+// CreateWorklog logs time against a work item
+func (c *Client) CreateWorklog(projectID, workItemID string, create *WorklogCreate) (*Worklog, error) {
+	if c.workspace == "" {
+		return nil, fmt.Errorf("workspace is not set")
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+	if workItemID == "" {
+		return nil, fmt.Errorf("work item ID is required")
+	}
+	if create == nil || create.Duration <= 0 {
+		return nil, fmt.Errorf("a duration is required")
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/workspaces/%s/projects/%s/work-items/%s/worklogs/", c.workspace, projectID, workItemID)
+
+	var worklog Worklog
+	if err := c.post(endpoint, create, &worklog); err != nil {
+		return nil, fmt.Errorf("failed to log time: %w", err)
+	}
+
+	return &worklog, nil
+}
@@ -0,0 +1,152 @@
+// Package poll provides a shared scheduler for commands that repeatedly poll
+// the Plane API, such as watch and notification modes.
+//
+// The scheduler keeps the load on the API predictable when many users poll
+// at once: every delay is jittered so clients drift apart, the interval backs
+// off while nothing changes, and it never drops below a minimum interval.
+package poll
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// Outcome describes the result of a single poll
+type Outcome int
+
+const (
+	// Changed means the poll returned new data
+	Changed Outcome = iota
+	// Unchanged means the data was the same as last time (e.g. HTTP 304)
+	Unchanged
+	// Failed means the poll returned an error
+	Failed
+)
+
+// Config controls the polling intervals
+type Config struct {
+	// Interval is the base delay between polls, used after a change
+	Interval time.Duration
+	// MinInterval is the lowest delay allowed, whatever Interval says
+	MinInterval time.Duration
+	// MaxInterval caps the delay reached by backing off
+	MaxInterval time.Duration
+	// Backoff multiplies the delay after each unchanged poll
+	Backoff float64
+	// Jitter is the fraction of the delay randomly added or removed (0.2 = ±20%)
+	Jitter float64
+}
+
+// DefaultConfig returns the intervals used when none are configured
+func DefaultConfig() Config {
+	return Config{
+		Interval:    60 * time.Second,
+		MinInterval: 15 * time.Second,
+		MaxInterval: 10 * time.Minute,
+		Backoff:     1.5,
+		Jitter:      0.2,
+	}
+}
+
+// Scheduler computes the delay before each poll
+type Scheduler struct {
+	cfg     Config
+	current time.Duration
+	rand    *rand.Rand
+}
+
+// New creates a scheduler, filling invalid settings from DefaultConfig
+func New(cfg Config) *Scheduler {
+	defaults := DefaultConfig()
+	if cfg.MinInterval <= 0 {
+		cfg.MinInterval = defaults.MinInterval
+	}
+	if cfg.Interval < cfg.MinInterval {
+		cfg.Interval = cfg.MinInterval
+	}
+	if cfg.MaxInterval < cfg.Interval {
+		cfg.MaxInterval = cfg.Interval
+	}
+	if cfg.Backoff < 1 {
+		cfg.Backoff = defaults.Backoff
+	}
+	if cfg.Jitter < 0 || cfg.Jitter >= 1 {
+		cfg.Jitter = defaults.Jitter
+	}
+
+	return &Scheduler{
+		cfg:     cfg,
+		current: cfg.Interval,
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Interval returns the current delay before jitter is applied
+func (s *Scheduler) Interval() time.Duration {
+	return s.current
+}
+
+// Next records the outcome of a poll and returns how long to wait before
+// the next one. Changes reset the delay to the base interval; unchanged
+// polls back off gradually and failures back off twice as fast.
+func (s *Scheduler) Next(outcome Outcome) time.Duration {
+	switch outcome {
+	case Changed:
+		s.current = s.cfg.Interval
+	case Unchanged:
+		s.current = time.Duration(float64(s.current) * s.cfg.Backoff)
+	case Failed:
+		s.current *= 2
+	}
+	if s.current > s.cfg.MaxInterval {
+		s.current = s.cfg.MaxInterval
+	}
+
+	return s.jitter(s.current)
+}
+
+// jitter spreads a delay by up to ±Jitter, never going below MinInterval
+func (s *Scheduler) jitter(d time.Duration) time.Duration {
+	if s.cfg.Jitter > 0 {
+		spread := float64(d) * s.cfg.Jitter
+		d += time.Duration((s.rand.Float64()*2 - 1) * spread)
+	}
+	if d < s.cfg.MinInterval {
+		d = s.cfg.MinInterval
+	}
+	return d
+}
+
+// PollFunc performs one poll and reports whether anything changed
+type PollFunc func(ctx context.Context) (Outcome, error)
+
+// Run polls until the context is cancelled. The first poll happens after a
+// short random delay so clients started together do not poll in lockstep.
+// Errors are passed to onError (if set) and polling continues with backoff.
+func (s *Scheduler) Run(ctx context.Context, poll PollFunc, onError func(error)) error {
+	delay := time.Duration(s.rand.Float64() * s.cfg.Jitter * float64(s.cfg.Interval))
+
+	for {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		outcome, err := poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if onError != nil {
+				onError(err)
+			}
+			outcome = Failed
+		}
+
+		delay = s.Next(outcome)
+	}
+}
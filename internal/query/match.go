@@ -0,0 +1,502 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"plane-cli/internal/plane"
+)
+
+// Context holds the project data needed to evaluate names in a query
+type Context struct {
+	States  map[string]plane.State
+	Labels  map[string]plane.Label
+	Members map[string]plane.Member
+	// Me is the ID of the current user, used for assignee:me
+	Me  string
+	Now time.Time
+
+	// Cycles and Modules are set when the query filters on them; ItemCycles
+	// and ItemModules list the cycle and module IDs of each work item ID.
+	Cycles      map[string]plane.Cycle
+	Modules     map[string]plane.Module
+	ItemCycles  map[string][]string
+	ItemModules map[string][]string
+
+	// Properties holds the custom properties by lower-case name. A name may
+	// belong to several work item types.
+	Properties map[string][]plane.WorkItemProperty
+	// PropertyValues loads the values of a custom property for a work item.
+	// Results are cached for the lifetime of the context.
+	PropertyValues func(item *plane.WorkItem, property *plane.WorkItemProperty) ([]string, error)
+
+	propertyCache map[string][]string
+}
+
+// NewContext indexes project metadata for matching
+func NewContext(states []plane.State, labels []plane.Label, members []plane.Member) *Context {
+	ctx := &Context{
+		States:  make(map[string]plane.State),
+		Labels:  make(map[string]plane.Label),
+		Members: make(map[string]plane.Member),
+		Now:     time.Now(),
+	}
+	for _, s := range states {
+		ctx.States[s.ID] = s
+	}
+	for _, l := range labels {
+		ctx.Labels[l.ID] = l
+	}
+	for _, m := range members {
+		ctx.Members[m.ID] = m
+	}
+	return ctx
+}
+
+// priorityRanks orders priorities from lowest to highest
+var priorityRanks = map[string]int{
+	"none":   0,
+	"low":    1,
+	"medium": 2,
+	"high":   3,
+	"urgent": 4,
+}
+
+// validate checks that values of ordered fields can be interpreted
+func (q *Query) validate() error {
+	for _, t := range q.Terms {
+		for _, v := range t.Values {
+			switch t.Field {
+			case "priority":
+				if _, ok := priorityRanks[strings.ToLower(v)]; !ok {
+					return fmt.Errorf("invalid priority %q (use urgent, high, medium, low or none)", v)
+				}
+			case "updated", "created", "start", "due":
+				if strings.EqualFold(v, "none") {
+					continue
+				}
+				if _, _, err := parseTimeValue(v, time.Now(), t.Field); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Match reports whether a work item satisfies every term of the query.
+// Custom property terms are evaluated last, since their values may have to
+// be fetched per item.
+func (q *Query) Match(item *plane.WorkItem, ctx *Context) bool {
+	title := strings.ToLower(item.Name)
+	for _, w := range q.Words {
+		if !strings.Contains(title, strings.ToLower(w)) {
+			return false
+		}
+	}
+
+	for _, t := range q.Terms {
+		if _, ok := t.Property(); ok {
+			continue
+		}
+		if t.match(item, ctx) == t.Negate {
+			return false
+		}
+	}
+	for _, t := range q.Terms {
+		if _, ok := t.Property(); !ok {
+			continue
+		}
+		if t.match(item, ctx) == t.Negate {
+			return false
+		}
+	}
+	return true
+}
+
+// match evaluates a term ignoring negation; multiple values are alternatives
+func (t Term) match(item *plane.WorkItem, ctx *Context) bool {
+	for _, v := range t.Values {
+		if t.matchValue(item, ctx, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t Term) matchValue(item *plane.WorkItem, ctx *Context, value string) bool {
+	if name, ok := t.Property(); ok {
+		return t.matchProperty(ctx.propertyValues(item, name), value)
+	}
+
+	switch t.Field {
+	case "title":
+		return strings.Contains(strings.ToLower(item.Name), strings.ToLower(value))
+
+	case "state", "group":
+		stateID := item.StateID
+		if stateID == "" {
+			stateID = item.State
+		}
+		state, ok := ctx.States[stateID]
+		if !ok {
+			return stateID == value
+		}
+		if t.Field == "group" {
+			return strings.EqualFold(state.Group, value)
+		}
+		return state.ID == value || strings.EqualFold(state.Name, value)
+
+	case "priority":
+		priority := strings.ToLower(item.Priority)
+		if priority == "" {
+			priority = "none"
+		}
+		return compareInts(priorityRanks[priority], t.Op, priorityRanks[strings.ToLower(value)])
+
+	case "label":
+		ids := item.LabelIDs
+		if len(ids) == 0 {
+			ids = item.Labels
+		}
+		if strings.EqualFold(value, "none") {
+			return len(ids) == 0
+		}
+		for _, id := range ids {
+			if id == value {
+				return true
+			}
+			if label, ok := ctx.Labels[id]; ok && strings.EqualFold(label.Name, value) {
+				return true
+			}
+		}
+		return false
+
+	case "assignee":
+		ids := item.AssigneeIDs
+		if len(ids) == 0 {
+			ids = item.Assignees
+		}
+		switch strings.ToLower(value) {
+		case "none":
+			return len(ids) == 0
+		case "me":
+			value = ctx.Me
+		}
+		for _, id := range ids {
+			if id == value {
+				return true
+			}
+			if m, ok := ctx.Members[id]; ok {
+				for _, name := range []string{m.DisplayName, m.Email, m.FirstName, m.GetDisplayName()} {
+					if name != "" && strings.EqualFold(name, value) {
+						return true
+					}
+				}
+			}
+		}
+		return false
+
+	case "cycle":
+		ids := ctx.ItemCycles[item.ID]
+		if len(ids) == 0 {
+			ids = nonEmpty(item.CycleID, item.Cycle)
+		}
+		return matchGroup(ids, value, func(id string) string { return ctx.Cycles[id].Name })
+
+	case "module":
+		ids := ctx.ItemModules[item.ID]
+		if len(ids) == 0 {
+			ids = nonEmpty(item.ModuleID, item.Module)
+		}
+		return matchGroup(ids, value, func(id string) string { return ctx.Modules[id].Name })
+
+	case "updated", "created":
+		at := item.UpdatedAt
+		if t.Field == "created" {
+			at = item.CreatedAt
+		}
+		if at.IsZero() {
+			return strings.EqualFold(value, "none")
+		}
+		return t.matchTime(at, value, ctx.Now)
+
+	case "start", "due":
+		date := item.StartDate
+		if t.Field == "due" {
+			date = item.TargetDate
+		}
+		if date == nil || *date == "" {
+			return strings.EqualFold(value, "none")
+		}
+		at, err := time.ParseInLocation("2006-01-02", (*date)[:min(10, len(*date))], time.Local)
+		if err != nil {
+			return false
+		}
+		return t.matchTime(at, value, ctx.Now)
+	}
+
+	return false
+}
+
+// matchGroup matches the cycle or module IDs of an item against an ID, a
+// name or none
+func matchGroup(ids []string, value string, name func(id string) string) bool {
+	if strings.EqualFold(value, "none") {
+		return len(ids) == 0
+	}
+	for _, id := range ids {
+		if id == value || strings.EqualFold(name(id), value) {
+			return true
+		}
+	}
+	return false
+}
+
+// nonEmpty returns the first non-empty value as a slice
+func nonEmpty(values ...string) []string {
+	for _, v := range values {
+		if v != "" {
+			return []string{v}
+		}
+	}
+	return nil
+}
+
+// matchTime compares a timestamp with a date or a relative duration. For
+// updated/created a duration is an age: updated<7d means "less than 7 days
+// ago". For start/due it is an offset into the future: due<7d means "due
+// within the next 7 days".
+func (t Term) matchTime(at time.Time, value string, now time.Time) bool {
+	if strings.EqualFold(value, "none") {
+		return false
+	}
+	ref, isAge, err := parseTimeValue(value, now, t.Field)
+	if err != nil {
+		return false
+	}
+
+	if isAge {
+		age := now.Sub(at)
+		limit := now.Sub(ref)
+		op := t.Op
+		if op == OpEqual {
+			op = OpLessEqual
+		}
+		return compareInts64(int64(age), op, int64(limit))
+	}
+
+	day := func(x time.Time) int64 {
+		y, m, d := x.In(time.Local).Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, time.Local).Unix()
+	}
+	return compareInts64(day(at), t.Op, day(ref))
+}
+
+// parseTimeValue turns a query value into a reference time. isAge is true
+// when the value was a duration on a past-facing field.
+func parseTimeValue(value string, now time.Time, field string) (time.Time, bool, error) {
+	switch strings.ToLower(value) {
+	case "today":
+		return now, false, nil
+	case "yesterday":
+		return now.AddDate(0, 0, -1), false, nil
+	case "tomorrow":
+		return now.AddDate(0, 0, 1), false, nil
+	}
+
+	if d, err := parseDuration(value); err == nil {
+		if field == "updated" || field == "created" {
+			return now.Add(-d), true, nil
+		}
+		return now.Add(d), false, nil
+	}
+
+	at, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s value %q (use a date like 2024-05-01, today, or a duration like 7d)", field, value)
+	}
+	return at, false, nil
+}
+
+// parseDuration parses durations with day and week units, e.g. 7d, 2w, 12h
+func parseDuration(s string) (time.Duration, error) {
+	if len(s) < 2 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(s[:len(s)-1], "+"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+
+	switch s[len(s)-1] {
+	case 'h':
+		return time.Duration(n) * time.Hour, nil
+	case 'd':
+		return time.Duration(n) * 24 * time.Hour, nil
+	case 'w':
+		return time.Duration(n) * 7 * 24 * time.Hour, nil
+	}
+	return 0, fmt.Errorf("invalid duration %q", s)
+}
+
+// propertyValues returns the display values of a custom property for an
+// item, resolving option IDs to option names
+func (ctx *Context) propertyValues(item *plane.WorkItem, name string) []string {
+	candidates := ctx.Properties[strings.ToLower(name)]
+	if len(candidates) == 0 || ctx.PropertyValues == nil {
+		return nil
+	}
+
+	// Pick the property defined by the item's type when types are known
+	property := &candidates[0]
+	for i := range candidates {
+		if item.TypeID != "" && candidates[i].TypeID == item.TypeID {
+			property = &candidates[i]
+			break
+		}
+	}
+	if item.TypeID != "" && property.TypeID != "" && property.TypeID != item.TypeID {
+		return nil
+	}
+
+	key := item.ID + "/" + property.ID
+	if values, ok := ctx.propertyCache[key]; ok {
+		return values
+	}
+
+	values, err := ctx.PropertyValues(item, property)
+	if err != nil {
+		values = nil
+	}
+	for i, v := range values {
+		for _, o := range property.Options {
+			if o.ID == v {
+				values[i] = o.Name
+			}
+		}
+	}
+
+	if ctx.propertyCache == nil {
+		ctx.propertyCache = make(map[string][]string)
+	}
+	ctx.propertyCache[key] = values
+	return values
+}
+
+// matchProperty compares property values against a query value. Ordered
+// operators compare numbers numerically and anything else as text, which
+// orders ISO dates correctly.
+func (t Term) matchProperty(values []string, value string) bool {
+	if strings.EqualFold(value, "none") {
+		return len(values) == 0
+	}
+	for _, v := range values {
+		if t.Op == OpEqual {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+			continue
+		}
+
+		a, errA := strconv.ParseFloat(v, 64)
+		b, errB := strconv.ParseFloat(value, 64)
+		var cmp int
+		if errA == nil && errB == nil {
+			cmp = compareFloats(a, b)
+		} else {
+			cmp = strings.Compare(strings.ToLower(v), strings.ToLower(value))
+		}
+		if compareInts(cmp, t.Op, 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareInts(a int, op string, b int) bool {
+	return compareInts64(int64(a), op, int64(b))
+}
+
+func compareInts64(a int64, op string, b int64) bool {
+	switch op {
+	case OpGreater:
+		return a > b
+	case OpGreaterEqual:
+		return a >= b
+	case OpLess:
+		return a < b
+	case OpLessEqual:
+		return a <= b
+	default:
+		return a == b
+	}
+}
+
+// apiFields maps query fields to the work item fields they read. Cycle and
+// module membership is not a work item field; it is loaded into the Context.
+var apiFields = map[string][]string{
+	"state":    {"state"},
+	"group":    {"state"},
+	"priority": {"priority"},
+	"label":    {"labels"},
+	"assignee": {"assignees"},
+	"title":    {"name"},
+	"updated":  {"updated_at"},
+	"created":  {"created_at"},
+	"start":    {"start_date"},
+	"due":      {"target_date"},
+}
+
+// APIFields returns the work item fields Match needs, for use with the
+// API's field selection
+func (q *Query) APIFields() []string {
+	fields := []string{"id", "name"}
+	for _, t := range q.Terms {
+		if _, ok := t.Property(); ok {
+			fields = append(fields, "type_id")
+			continue
+		}
+		fields = append(fields, apiFields[t.Field]...)
+	}
+	return fields
+}
+
+// APIFilters returns the query parameters that can be sent to the API to
+// narrow results server-side. The query must still be applied with Match,
+// as the API only supports a subset of the language. Custom property terms
+// are never sent: the work items endpoint has no filter for them, so they
+// are always evaluated client-side.
+func (q *Query) APIFilters(ctx *Context) map[string]string {
+	filters := make(map[string]string)
+	for _, t := range q.Terms {
+		if t.Negate || t.Op != OpEqual || len(t.Values) != 1 {
+			continue
+		}
+		value := t.Values[0]
+		switch t.Field {
+		case "priority":
+			filters["priority"] = strings.ToLower(value)
+		case "state":
+			for _, s := range ctx.States {
+				if s.ID == value || strings.EqualFold(s.Name, value) {
+					filters["state"] = s.ID
+					break
+				}
+			}
+		}
+	}
+	return filters
+}
@@ -0,0 +1,346 @@
+// Package query implements the quick-filter language used to select work
+// items, for example:
+//
+//	state:"In Progress" priority>=high label:bug assignee:me updated<7d
+//
+// A query is a list of terms that must all match. Each term is a field, an
+// operator (: = != > >= < <=) and a value; values may be quoted and may list
+// alternatives separated by commas (label:bug,ui). A leading minus negates a
+// term (-label:wontfix) and bare words match the title.
+//
+// Custom properties are addressed as prop.<name>, for example
+// prop.severity=s1 or prop.points>=5.
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Operators understood by the parser. ":" is an alias for "=".
+const (
+	OpEqual        = "="
+	OpNotEqual     = "!="
+	OpGreater      = ">"
+	OpGreaterEqual = ">="
+	OpLess         = "<"
+	OpLessEqual    = "<="
+)
+
+// Term is a single condition of a query
+type Term struct {
+	Field  string
+	Op     string
+	Values []string
+	Negate bool
+}
+
+// Query is a parsed filter expression
+type Query struct {
+	Terms []Term
+	// Words are bare words that must all appear in the title
+	Words []string
+}
+
+// fields lists the supported fields and whether they allow ordering operators
+var fields = map[string]bool{
+	"state":    false,
+	"group":    false,
+	"priority": true,
+	"label":    false,
+	"assignee": false,
+	"title":    false,
+	"cycle":    false,
+	"module":   false,
+	"updated":  true,
+	"created":  true,
+	"start":    true,
+	"due":      true,
+}
+
+// PropertyPrefix marks a field that names a custom work item property
+const PropertyPrefix = "prop."
+
+// fieldAliases maps alternative spellings to canonical field names
+var fieldAliases = map[string]string{
+	"status":    "state",
+	"labels":    "label",
+	"assignees": "assignee",
+	"target":    "due",
+	"name":      "title",
+	"sprint":    "cycle",
+	"modules":   "module",
+}
+
+// Parse parses a query string
+func Parse(s string) (*Query, error) {
+	q := &Query{}
+	p := &parser{input: []rune(s)}
+
+	for {
+		p.skipSpace()
+		if p.done() {
+			break
+		}
+
+		negate := false
+		if p.peek() == '-' {
+			negate = true
+			p.pos++
+		}
+
+		start := p.pos
+		field := p.readIdent()
+		op := p.readOp()
+		if field == "" || op == "" {
+			// Not a field term: treat it as a title word
+			p.pos = start
+			word, err := p.readValue()
+			if err != nil {
+				return nil, err
+			}
+			if negate {
+				q.Terms = append(q.Terms, Term{Field: "title", Op: OpEqual, Values: []string{word}, Negate: true})
+			} else if word != "" {
+				q.Words = append(q.Words, word)
+			}
+			continue
+		}
+
+		field = strings.ToLower(field)
+		if alias, ok := fieldAliases[field]; ok {
+			field = alias
+		}
+		ordered, known := fields[field]
+		if strings.HasPrefix(field, PropertyPrefix) && len(field) > len(PropertyPrefix) {
+			// Property types are only known once the project is loaded, so
+			// every operator is accepted here
+			ordered, known = true, true
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown filter field %q (supported: %s, prop.<name>)", field, strings.Join(FieldNames(), ", "))
+		}
+		if !ordered && op != OpEqual && op != OpNotEqual {
+			return nil, fmt.Errorf("operator %q is not supported for %s", op, field)
+		}
+
+		value, err := p.readValue()
+		if err != nil {
+			return nil, err
+		}
+		if value == "" {
+			return nil, fmt.Errorf("missing value for %s", field)
+		}
+
+		var values []string
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+
+		if op == OpNotEqual {
+			op = OpEqual
+			negate = !negate
+		}
+		q.Terms = append(q.Terms, Term{Field: field, Op: op, Values: values, Negate: negate})
+	}
+
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// ParseConditions parses conditions given one per argument, such as
+// state=Backlog or "state=In Progress". Each is a single field term whose
+// value runs to the end of the argument, so values with spaces need no
+// quotes; commas still separate alternatives.
+func ParseConditions(conditions []string) (*Query, error) {
+	var terms []string
+	for _, c := range conditions {
+		p := &parser{input: []rune(strings.TrimSpace(c))}
+		negate := ""
+		if p.peek() == '-' {
+			negate = "-"
+			p.pos++
+		}
+		field := p.readIdent()
+		start := p.pos
+		op := p.readOp()
+		if field == "" || op == "" {
+			return nil, fmt.Errorf("invalid condition %q (use field=value, e.g. state=Backlog)", c)
+		}
+		op = string(p.input[start:p.pos])
+
+		value := strings.TrimSpace(string(p.input[p.pos:]))
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		value = strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+		terms = append(terms, negate+field+op+`"`+value+`"`)
+	}
+	return Parse(strings.Join(terms, " "))
+}
+
+// FieldNames returns the supported field names, sorted
+func FieldNames() []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Uses reports whether the query has a term on the given field
+func (q *Query) Uses(field string) bool {
+	for _, t := range q.Terms {
+		if t.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// UsesValue reports whether any term on field has the given value
+func (q *Query) UsesValue(field, value string) bool {
+	for _, t := range q.Terms {
+		if t.Field != field {
+			continue
+		}
+		for _, v := range t.Values {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Property returns the custom property name of a prop.<name> term
+func (t Term) Property() (string, bool) {
+	if !strings.HasPrefix(t.Field, PropertyPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(t.Field, PropertyPrefix), true
+}
+
+// Properties returns the custom property names the query refers to
+func (q *Query) Properties() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, t := range q.Terms {
+		if name, ok := t.Property(); ok && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Empty reports whether the query has no conditions
+func (q *Query) Empty() bool {
+	return len(q.Terms) == 0 && len(q.Words) == 0
+}
+
+// parser is a small hand-written scanner over the query input
+type parser struct {
+	input []rune
+	pos   int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *parser) peek() rune {
+	if p.done() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for !p.done() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// readIdent reads a field name made of letters, digits, '_' and '.'
+func (p *parser) readIdent() string {
+	start := p.pos
+	for !p.done() {
+		r := p.peek()
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '.' {
+			break
+		}
+		p.pos++
+	}
+	return string(p.input[start:p.pos])
+}
+
+// readOp reads an operator, returning "" if none is present
+func (p *parser) readOp() string {
+	if p.done() {
+		return ""
+	}
+	two := ""
+	if p.pos+1 < len(p.input) {
+		two = string(p.input[p.pos : p.pos+2])
+	}
+	switch two {
+	case ">=", "<=", "!=":
+		p.pos += 2
+		return two
+	}
+	switch p.peek() {
+	case ':', '=':
+		p.pos++
+		return OpEqual
+	case '>':
+		p.pos++
+		return OpGreater
+	case '<':
+		p.pos++
+		return OpLess
+	}
+	return ""
+}
+
+// readValue reads a quoted or unquoted value
+func (p *parser) readValue() (string, error) {
+	var sb strings.Builder
+	for !p.done() && !unicode.IsSpace(p.peek()) {
+		r := p.peek()
+		if r != '"' && r != '\'' {
+			sb.WriteRune(r)
+			p.pos++
+			continue
+		}
+
+		quote := r
+		p.pos++
+		closed := false
+		for !p.done() {
+			c := p.peek()
+			p.pos++
+			if c == '\\' && !p.done() {
+				sb.WriteRune(p.peek())
+				p.pos++
+				continue
+			}
+			if c == quote {
+				closed = true
+				break
+			}
+			sb.WriteRune(c)
+		}
+		if !closed {
+			return "", fmt.Errorf("unterminated quote in query")
+		}
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,210 @@
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+)
+
+// Heatmap counts events per day over whole weeks, like GitHub's
+// contribution graph: one column per week starting on Monday and one row
+// per weekday. Counts starts on Start and may end before the last week is
+// over; missing days are drawn empty.
+type Heatmap struct {
+	Start  time.Time
+	Counts []int
+}
+
+// heatmapGlyphs draws the levels of a heatmap cell in text
+var heatmapGlyphs = []string{"·", "░", "▒", "▓", "█"}
+
+// heatmapColors fills the levels of a heatmap cell in SVG and HTML
+var heatmapColors = []string{"#ebedf0", "#c6d7ff", "#8fb0ff", "#5b8cff", "#2f5fe0"}
+
+// SVG layout of a heatmap, in pixels
+const (
+	svgCell   = 11
+	svgStep   = 14
+	svgLeft   = 34
+	svgTop    = 34
+	svgLegend = 26
+)
+
+// NewHeatmap returns an empty heatmap of the given number of weeks ending
+// with the week of end
+func NewHeatmap(end time.Time, weeks int) *Heatmap {
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
+	monday := end.AddDate(0, 0, -((int(end.Weekday()) + 6) % 7))
+	start := monday.AddDate(0, 0, -7*(weeks-1))
+	return &Heatmap{Start: start, Counts: make([]int, int(end.Sub(start).Hours()/24+0.5)+1)}
+}
+
+// Add counts an event at t; events outside the heatmap are ignored
+func (h *Heatmap) Add(t time.Time) {
+	t = t.In(h.Start.Location())
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	i := int(day.Sub(h.Start).Hours()/24 + 0.5)
+	if i >= 0 && i < len(h.Counts) {
+		h.Counts[i]++
+	}
+}
+
+// Weeks returns the number of columns
+func (h *Heatmap) Weeks() int {
+	return (len(h.Counts) + 6) / 7
+}
+
+// Total returns the number of events
+func (h *Heatmap) Total() int {
+	total := 0
+	for _, c := range h.Counts {
+		total += c
+	}
+	return total
+}
+
+// Busiest returns the day with the most events and their count
+func (h *Heatmap) Busiest() (time.Time, int) {
+	best := 0
+	for i, c := range h.Counts {
+		if c > h.Counts[best] {
+			best = i
+		}
+	}
+	if len(h.Counts) == 0 {
+		return h.Start, 0
+	}
+	return h.Start.AddDate(0, 0, best), h.Counts[best]
+}
+
+// level maps a count to one of five shades relative to the busiest day
+func (h *Heatmap) level(count int) int {
+	_, most := h.Busiest()
+	if count <= 0 || most == 0 {
+		return 0
+	}
+	return min(1+(count-1)*4/most, 4)
+}
+
+// cell returns the count of a week and weekday, or -1 past the end
+func (h *Heatmap) cell(week, weekday int) int {
+	i := week*7 + weekday
+	if i >= len(h.Counts) {
+		return -1
+	}
+	return h.Counts[i]
+}
+
+// monthLabels returns the abbreviated month starting in each week, or ""
+func (h *Heatmap) monthLabels() []string {
+	labels := make([]string, h.Weeks())
+	for w := range labels {
+		first := h.Start.AddDate(0, 0, 7*w)
+		if w == 0 || first.Day() <= 7 {
+			labels[w] = first.Format("Jan")
+		}
+	}
+	// The first week's month would run into the next one starting soon after
+	for w := 1; w < min(3, len(labels)); w++ {
+		if labels[w] != "" {
+			labels[0] = ""
+		}
+	}
+	return labels
+}
+
+// weekdayLabels are shown on alternate rows, as on GitHub
+var weekdayLabels = []string{"Mon", "", "Wed", "", "Fri", "", "Sun"}
+
+// renderText draws the heatmap with shade characters, two columns per week
+func (h *Heatmap) renderText(w io.Writer) {
+	months := "      "
+	for i, label := range h.monthLabels() {
+		col := 6 + 2*i
+		if label == "" || len(months) > col {
+			continue
+		}
+		months += strings.Repeat(" ", col-len(months)) + label
+	}
+	fmt.Fprintln(w, months)
+
+	for day := 0; day < 7; day++ {
+		fmt.Fprintf(w, "  %-4s", weekdayLabels[day])
+		for week := 0; week < h.Weeks(); week++ {
+			if c := h.cell(week, day); c >= 0 {
+				fmt.Fprintf(w, "%s ", heatmapGlyphs[h.level(c)])
+			}
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "\n      Less %s More\n", strings.Join(heatmapGlyphs, " "))
+}
+
+// svgBody returns the SVG elements of the heatmap and its size
+func (h *Heatmap) svgBody(title string) (string, int, int) {
+	var b strings.Builder
+	width := svgLeft + h.Weeks()*svgStep + 10
+	height := svgTop + 7*svgStep + svgLegend
+
+	fmt.Fprintf(&b, `<text x="0" y="14" font-size="13" font-weight="600">%s</text>`, template.HTMLEscapeString(title))
+	for w, label := range h.monthLabels() {
+		if label != "" {
+			fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="10" fill="#616e7c">%s</text>`, svgLeft+w*svgStep, svgTop-6, label)
+		}
+	}
+	for day, label := range weekdayLabels {
+		if label != "" {
+			fmt.Fprintf(&b, `<text x="0" y="%d" font-size="10" fill="#616e7c">%s</text>`, svgTop+day*svgStep+9, label)
+		}
+	}
+	for week := 0; week < h.Weeks(); week++ {
+		for day := 0; day < 7; day++ {
+			c := h.cell(week, day)
+			if c < 0 {
+				continue
+			}
+			date := h.Start.AddDate(0, 0, week*7+day).Format("2006-01-02")
+			fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"><title>%s: %d</title></rect>`,
+				svgLeft+week*svgStep, svgTop+day*svgStep, svgCell, svgCell, heatmapColors[h.level(c)], date, c)
+		}
+	}
+
+	legendY := svgTop + 7*svgStep + 8
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="10" fill="#616e7c">Less</text>`, svgLeft, legendY+9)
+	for i, color := range heatmapColors {
+		fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"/>`, svgLeft+28+i*svgStep, legendY, svgCell, svgCell, color)
+	}
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="10" fill="#616e7c">More</text>`, svgLeft+32+len(heatmapColors)*svgStep, legendY+9)
+	return b.String(), width, height
+}
+
+// SVG returns the heatmap as an inline SVG element for HTML reports
+func (h *Heatmap) SVG() template.HTML {
+	body, width, height := h.svgBody("")
+	return template.HTML(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="Helvetica, Arial, sans-serif">%s</svg>`, width, height, body))
+}
+
+// RenderSVG writes the heatmaps of the document, one below the other with
+// their section headings, as a standalone SVG image
+func RenderSVG(w io.Writer, doc *Document) error {
+	var body strings.Builder
+	width, y := 0, 0
+	for _, s := range doc.Sections {
+		if s.Heatmap == nil {
+			continue
+		}
+		part, pw, ph := s.Heatmap.svgBody(s.Heading)
+		fmt.Fprintf(&body, `<g transform="translate(10,%d)">%s</g>`, y+10, part)
+		width = max(width, pw+20)
+		y += ph + 20
+	}
+	if y == 0 {
+		return fmt.Errorf("this report has no charts to write as SVG (use text, html or pdf)")
+	}
+
+	_, err := fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="Helvetica, Arial, sans-serif" fill="#1f2933"><rect width="100%%" height="100%%" fill="#ffffff"/>%s</svg>`+"\n",
+		width, y, body.String())
+	return err
+}
@@ -0,0 +1,213 @@
+// Package report renders reports built by the CLI as plain text, as a
+// standalone HTML page, or as a PDF produced from that page by a headless
+// browser.
+package report
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// Document is a report made of titled sections
+type Document struct {
+	Title       string
+	Subtitle    string
+	GeneratedAt time.Time
+	Sections    []Section
+}
+
+// Section is one part of a report. Any of its parts may be empty.
+type Section struct {
+	Heading string
+	Metrics []Metric
+	Bars    []Bar
+	Table   *Table
+	Heatmap *Heatmap
+	Note    string
+}
+
+// Metric is a headline number
+type Metric struct {
+	Label string
+	Value string
+}
+
+// Bar is one row of a horizontal bar chart; Value is relative to Max
+type Bar struct {
+	Label string
+	Value float64
+	Max   float64
+	Text  string
+}
+
+// Percent returns the bar length as a percentage of Max
+func (b Bar) Percent() float64 {
+	if b.Max <= 0 {
+		return 0
+	}
+	return b.Value / b.Max * 100
+}
+
+// Table is a simple table of strings. Links optionally holds a URL per row
+// that the HTML output puts on the first cell.
+type Table struct {
+	Columns []string
+	Rows    [][]string
+	Links   []string
+}
+
+// Link returns the URL of row i, or "" when it has none
+func (t *Table) Link(i int) string {
+	if i < 0 || i >= len(t.Links) {
+		return ""
+	}
+	return t.Links[i]
+}
+
+// Formats supported by Render
+const (
+	FormatText = "text"
+	FormatHTML = "html"
+	FormatPDF  = "pdf"
+	// FormatSVG writes only the heatmaps of a document, as an image
+	FormatSVG = "svg"
+)
+
+// RenderText writes the document as aligned plain text
+func RenderText(w io.Writer, doc *Document) error {
+	rule := strings.Repeat("=", 70)
+	fmt.Fprintf(w, "\n%s\n  %s\n", rule, doc.Title)
+	if doc.Subtitle != "" {
+		fmt.Fprintf(w, "  %s\n", doc.Subtitle)
+	}
+	fmt.Fprintln(w, rule)
+
+	for _, s := range doc.Sections {
+		if s.Heading != "" {
+			fmt.Fprintf(w, "\n%s\n%s\n", s.Heading, strings.Repeat("-", 70))
+		}
+		for _, m := range s.Metrics {
+			fmt.Fprintf(w, "  %-24s %s\n", m.Label+":", m.Value)
+		}
+		if len(s.Bars) > 0 {
+			width := 0
+			for _, b := range s.Bars {
+				width = max(width, len(b.Label))
+			}
+			for _, b := range s.Bars {
+				blocks := min(int(b.Percent()/100*30), 30)
+				bar := strings.Repeat("█", blocks) + strings.Repeat(" ", 30-blocks)
+				fmt.Fprintf(w, "  %-*s %s %s\n", width, b.Label, bar, b.Text)
+			}
+		}
+		if s.Heatmap != nil {
+			if len(s.Metrics) > 0 || len(s.Bars) > 0 {
+				fmt.Fprintln(w)
+			}
+			s.Heatmap.renderText(w)
+		}
+		if s.Table != nil && len(s.Table.Rows) > 0 {
+			if len(s.Metrics) > 0 || len(s.Bars) > 0 {
+				fmt.Fprintln(w)
+			}
+			tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(tw, "  "+strings.Join(s.Table.Columns, "\t"))
+			for _, row := range s.Table.Rows {
+				fmt.Fprintln(tw, "  "+strings.Join(row, "\t"))
+			}
+			tw.Flush()
+		}
+		if s.Note != "" {
+			fmt.Fprintf(w, "\n  %s\n", s.Note)
+		}
+	}
+
+	fmt.Fprintf(w, "\nGenerated %s\n", doc.GeneratedAt.Format("2006-01-02 15:04"))
+	return nil
+}
+
+// RenderHTML writes the document as a standalone HTML page
+func RenderHTML(w io.Writer, doc *Document) error {
+	tmpl, err := template.ParseFS(templateFS, "templates/report.html")
+	if err != nil {
+		return fmt.Errorf("failed to load report template: %w", err)
+	}
+	if err := tmpl.Execute(w, doc); err != nil {
+		return fmt.Errorf("failed to render report: %w", err)
+	}
+	return nil
+}
+
+// pdfRenderers lists the headless renderers tried in order
+var pdfRenderers = []string{
+	"wkhtmltopdf",
+	"chromium",
+	"chromium-browser",
+	"google-chrome",
+	"google-chrome-stable",
+	"microsoft-edge",
+}
+
+// FindPDFRenderer returns the first headless renderer found on PATH
+func FindPDFRenderer() (string, error) {
+	for _, name := range pdfRenderers {
+		if path, err := exec.LookPath(name); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("no PDF renderer found; install wkhtmltopdf or Chromium, or pass --pdf-renderer")
+}
+
+// RenderPDF renders the document to HTML and converts it to a PDF file with
+// a headless renderer. If renderer is empty one is looked up on PATH.
+func RenderPDF(doc *Document, outPath, renderer string) error {
+	if renderer == "" {
+		var err error
+		if renderer, err = FindPDFRenderer(); err != nil {
+			return err
+		}
+	}
+
+	tmp, err := os.CreateTemp("", "plane-report-*.html")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := RenderHTML(tmp, doc); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	absOut, err := filepath.Abs(outPath)
+	if err != nil {
+		return err
+	}
+
+	var cmd *exec.Cmd
+	if strings.Contains(filepath.Base(renderer), "wkhtmltopdf") {
+		cmd = exec.Command(renderer, "--quiet", "--enable-local-file-access", tmp.Name(), absOut)
+	} else {
+		cmd = exec.Command(renderer, "--headless", "--disable-gpu", "--no-pdf-header-footer",
+			"--print-to-pdf="+absOut, "file://"+filepath.ToSlash(tmp.Name()))
+	}
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("PDF renderer failed: %w\n%s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
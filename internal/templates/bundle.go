@@ -0,0 +1,117 @@
+package templates
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// maxBundleTemplate bounds the size of one template read from a bundle
+const maxBundleTemplate = 1 << 20
+
+// validName matches template names that are safe as file names
+var validName = regexp.MustCompile(`^[\w][\w.-]*$`)
+
+// ValidName reports whether name can be used as a template name: letters,
+// digits, underscores, dots and dashes, not starting with a dot or dash
+func ValidName(name string) bool {
+	return validName.MatchString(name)
+}
+
+// WriteBundle writes templates to w as a gzipped tar archive with one
+// <name>.json file per template, as stored in the templates directory
+func WriteBundle(w io.Writer, tmpls []*Template) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, tmpl := range tmpls {
+		data, err := json.MarshalIndent(tmpl, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal template %s: %w", tmpl.Name, err)
+		}
+		header := &tar.Header{
+			Name:    tmpl.Name + ".json",
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to write bundle: %w", err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			return fmt.Errorf("failed to write bundle: %w", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return gz.Close()
+}
+
+// ReadBundle reads the templates of a bundle written by WriteBundle. Files
+// other than .json are ignored; a template that is invalid JSON, has no
+// content, an unsafe name or doesn't parse fails the whole bundle, so
+// nothing is imported from a damaged one.
+func ReadBundle(r io.Reader) ([]*Template, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("not a template bundle: %w", err)
+	}
+	defer gz.Close()
+
+	var tmpls []*Template
+	seen := make(map[string]bool)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundle: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg || !strings.HasSuffix(header.Name, ".json") {
+			continue
+		}
+		if header.Size > maxBundleTemplate {
+			return nil, fmt.Errorf("%s is larger than %d KB", header.Name, maxBundleTemplate/1024)
+		}
+
+		data, err := io.ReadAll(io.LimitReader(tr, maxBundleTemplate))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", header.Name, err)
+		}
+		var tmpl Template
+		if err := json.Unmarshal(data, &tmpl); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", header.Name, err)
+		}
+		if tmpl.Name == "" {
+			tmpl.Name = strings.TrimSuffix(path.Base(header.Name), ".json")
+		}
+		if !ValidName(tmpl.Name) {
+			return nil, fmt.Errorf("%s: invalid template name '%s'", header.Name, tmpl.Name)
+		}
+		if tmpl.Content == "" {
+			return nil, fmt.Errorf("%s: template content cannot be empty", header.Name)
+		}
+		if seen[tmpl.Name] {
+			return nil, fmt.Errorf("template '%s' is in the bundle twice", tmpl.Name)
+		}
+		seen[tmpl.Name] = true
+
+		tmpl.Content = convertMustache(tmpl.Content)
+		if _, err := template.New(tmpl.Name).Parse(tmpl.Content); err != nil {
+			return nil, fmt.Errorf("template '%s': %w", tmpl.Name, err)
+		}
+		tmpls = append(tmpls, &tmpl)
+	}
+	return tmpls, nil
+}
@@ -6,10 +6,22 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"text/template"
 )
 
+var (
+	// rangePattern finds the variables a template ranges over, which hold
+	// lists
+	rangePattern = regexp.MustCompile(`{{-?\s*range\s+(?:\$\w+\s*(?:,\s*\$\w+\s*)?:=\s*)?\.(\w+)`)
+	// mustacheTag matches the tags of mustache-style templates written for
+	// earlier versions: {{name}}, {{#list}}, {{^list}} and {{/list}}
+	mustacheTag = regexp.MustCompile(`{{\s*([#^/]?)\s*(\w+(?:\.\w+)*)\s*}}`)
+	// listSeparator splits list values given as plain text
+	listSeparator = regexp.MustCompile(`\s*[;\n]\s*`)
+)
+
 // Template represents a work item description template
 type Template struct {
 	Name        string   `json:"name"`
@@ -94,6 +106,7 @@ func (m *Manager) Load(name string) error {
 	if tmpl.Content == "" {
 		return fmt.Errorf("template content cannot be empty")
 	}
+	tmpl.Content = convertMustache(tmpl.Content)
 
 	m.templates[name] = &tmpl
 	return nil
@@ -163,23 +176,109 @@ func (m *Manager) Render(name string, variables map[string]string) (string, erro
 	return RenderTemplate(tmpl, variables)
 }
 
-// RenderTemplate renders a template with variables using Go's text/template
+// RenderTemplate renders a template with variables using Go's text/template.
+// Values are typed by Data, so templates can range over lists and read
+// nested fields.
 func RenderTemplate(tmpl *Template, variables map[string]string) (string, error) {
 	// Create Go template
-	t, err := template.New(tmpl.Name).Parse(tmpl.Content)
+	t, err := template.New(tmpl.Name).Option("missingkey=zero").Parse(tmpl.Content)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse template: %w", err)
 	}
 
 	// Execute template
 	var buf bytes.Buffer
-	if err := t.Execute(&buf, variables); err != nil {
+	if err := t.Execute(&buf, tmpl.Data(variables)); err != nil {
 		return "", fmt.Errorf("failed to render template: %w", err)
 	}
 
 	return buf.String(), nil
 }
 
+// ListVariables returns the variables the template ranges over
+func (t *Template) ListVariables() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, m := range rangePattern.FindAllStringSubmatch(t.Content, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			names = append(names, m[1])
+		}
+	}
+	return names
+}
+
+// Data turns variables given as text into template data. A value that is a
+// JSON array or object is decoded, so it can be ranged over or have its
+// fields read ({{.env.os}}); other values of list variables are split at
+// semicolons and line breaks. Declared variables that aren't given are
+// empty rather than "<no value>".
+func (t *Template) Data(variables map[string]string) map[string]any {
+	lists := make(map[string]bool)
+	for _, name := range t.ListVariables() {
+		lists[name] = true
+	}
+
+	data := make(map[string]any, len(variables)+len(t.Variables))
+	for _, name := range t.Variables {
+		if lists[name] {
+			data[name] = []string{}
+		} else {
+			data[name] = ""
+		}
+	}
+	for name := range lists {
+		if _, ok := data[name]; !ok {
+			data[name] = []string{}
+		}
+	}
+
+	for name, value := range variables {
+		trimmed := strings.TrimSpace(value)
+		if strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "{") {
+			var decoded any
+			if err := json.Unmarshal([]byte(trimmed), &decoded); err == nil {
+				data[name] = decoded
+				continue
+			}
+		}
+		if lists[name] {
+			var items []string
+			for _, item := range listSeparator.Split(trimmed, -1) {
+				if item != "" {
+					items = append(items, item)
+				}
+			}
+			data[name] = items
+			continue
+		}
+		data[name] = value
+	}
+	return data
+}
+
+// convertMustache rewrites the mustache-style tags of templates written for
+// earlier versions as Go template actions: {{name}} becomes {{.name}},
+// sections {{#list}}...{{/list}} become {{range .list}}...{{end}} and
+// inverted sections {{^list}} become {{if not .list}}. Go template actions
+// are left alone.
+func convertMustache(content string) string {
+	return mustacheTag.ReplaceAllStringFunc(content, func(tag string) string {
+		m := mustacheTag.FindStringSubmatch(tag)
+		switch {
+		case m[1] == "#":
+			return "{{range ." + m[2] + "}}"
+		case m[1] == "^":
+			return "{{if not ." + m[2] + "}}"
+		case m[1] == "/":
+			return "{{end}}"
+		case m[2] == "end" || m[2] == "else":
+			return tag
+		}
+		return "{{." + m[2] + "}}"
+	})
+}
+
 // ValidateVariables checks if all required variables are provided
 func (t *Template) ValidateVariables(variables map[string]string) []string {
 	var missing []string
@@ -199,55 +298,51 @@ func CreateDefaultTemplates(templatesDir string) error {
 			Description: "Feature development with Definition of Done",
 			Content: `## Definition Of Done
 
-* [ ] {{feature_name}}
-{{#modules}}  * [ ] {{name}}
-{{/modules}}
-
+* [ ] {{.feature_name}}
+{{range .modules}}  * [ ] {{.}}
+{{end}}
 ## Acceptance Criteria
-{{#acceptance_criteria}}* [ ] {{.}}
-{{/acceptance_criteria}}
-
+{{range .acceptance_criteria}}* [ ] {{.}}
+{{end}}
 ## Notes
-{{notes}}`,
+{{.notes}}`,
 			Variables: []string{"feature_name", "modules", "acceptance_criteria", "notes"},
 		},
 		{
 			Name:        "bug",
 			Description: "Bug report template",
 			Content: `## Bug Description
-{{description}}
+{{.description}}
 
 ## Steps to Reproduce
-{{#steps}}1. {{.}}
-{{/steps}}
-
+{{range .steps}}1. {{.}}
+{{end}}
 ## Expected Behavior
-{{expected}}
+{{.expected}}
 
 ## Actual Behavior
-{{actual}}
+{{.actual}}
 
 ## Environment
-- Version: {{version}}
-- Browser: {{browser}}
-- OS: {{os}}
+- Version: {{.version}}
+- Browser: {{.browser}}
+- OS: {{.os}}
 
 ## Notes
-{{notes}}`,
+{{.notes}}`,
 			Variables: []string{"description", "steps", "expected", "actual", "version", "browser", "os", "notes"},
 		},
 		{
 			Name:        "task",
 			Description: "Simple task template",
 			Content: `## Task Description
-{{description}}
+{{.description}}
 
 ## Checklist
-{{#checklist}}* [ ] {{.}}
-{{/checklist}}
-
+{{range .checklist}}* [ ] {{.}}
+{{end}}
 ## Notes
-{{notes}}`,
+{{.notes}}`,
 			Variables: []string{"description", "checklist", "notes"},
 		},
 	}